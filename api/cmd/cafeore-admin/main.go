@@ -0,0 +1,78 @@
+// api/cmd/cafeore-admin/main.go
+//
+// メニュー・セッションの管理とメンテナンス用のコマンド
+// サーバーと同じ DATABASE_URL に接続する。WebSocket への通知は行わないので、
+// 営業中に使った場合は画面を再読み込みする
+//
+//	go run ./cmd/cafeore-admin <command> [flags]
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/joho/godotenv"
+	"gorm.io/gorm"
+
+	"cafeore-pos/api/internal/database"
+)
+
+type command struct {
+	name  string
+	usage string
+	run   func(db *gorm.DB, args []string) error
+}
+
+var commands = []command{
+	{"migrate", "apply, roll back or show schema migrations", runMigrate},
+	{"seed-menu", "seed item types and items from a YAML/JSON menu file", runSeedMenu},
+	{"menu-plan", "show the difference between a menu file and the database", runMenuPlan},
+	{"menu-apply", "apply a menu file to the database", runMenuApply},
+	{"open-session", "open a new session", runOpenSession},
+	{"close-session", "close the open session", runCloseSession},
+	{"recompute", "recompute change and discount cups of orders", runRecompute},
+	{"purge-orders", "delete test orders and their related records", runPurgeOrders},
+	{"status", "print a status summary", runStatus},
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: cafeore-admin <command> [flags]")
+	fmt.Fprintln(os.Stderr, "")
+	fmt.Fprintln(os.Stderr, "commands:")
+	for _, c := range commands {
+		fmt.Fprintf(os.Stderr, "  %-14s %s\n", c.name, c.usage)
+	}
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	var cmd *command
+	for i := range commands {
+		if commands[i].name == os.Args[1] {
+			cmd = &commands[i]
+		}
+	}
+	if cmd == nil {
+		usage()
+		os.Exit(2)
+	}
+
+	// 環境変数読み込み
+	if err := godotenv.Load(); err != nil {
+		log.Println("Warning: .env file not found")
+	}
+
+	db, err := database.Open()
+	if err != nil {
+		log.Fatalf("Failed to connect database: %v", err)
+	}
+
+	if err := cmd.run(db, os.Args[2:]); err != nil {
+		log.Fatalf("%s: %v", cmd.name, err)
+	}
+}
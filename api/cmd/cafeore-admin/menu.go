@@ -0,0 +1,69 @@
+// api/cmd/cafeore-admin/menu.go
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"cafeore-pos/api/internal/menu"
+)
+
+func runSeedMenu(db *gorm.DB, args []string) error {
+	fs := flag.NewFlagSet("seed-menu", flag.ExitOnError)
+	file := fs.String("f", "menu.yaml", "menu file (YAML or JSON)")
+	fs.Parse(args)
+
+	m, err := menu.Load(*file)
+	if err != nil {
+		return err
+	}
+
+	result, err := menu.Seed(db, m)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("item types created: %d\n", result.ItemTypesCreated)
+	fmt.Printf("items created:      %d (already exists %d)\n", result.ItemsCreated, result.ItemsSkipped)
+	return nil
+}
+
+func runMenuPlan(db *gorm.DB, args []string) error {
+	fs := flag.NewFlagSet("menu-plan", flag.ExitOnError)
+	file := fs.String("f", "menu.yaml", "menu file (YAML or JSON)")
+	fs.Parse(args)
+
+	m, err := menu.Load(*file)
+	if err != nil {
+		return err
+	}
+
+	plan, err := menu.MakePlan(db, m)
+	if err != nil {
+		return err
+	}
+	fmt.Println(plan)
+	return nil
+}
+
+func runMenuApply(db *gorm.DB, args []string) error {
+	fs := flag.NewFlagSet("menu-apply", flag.ExitOnError)
+	file := fs.String("f", "menu.yaml", "menu file (YAML or JSON)")
+	fs.Parse(args)
+
+	m, err := menu.Load(*file)
+	if err != nil {
+		return err
+	}
+
+	plan, err := menu.Apply(db, m)
+	if err != nil {
+		return err
+	}
+	fmt.Println(plan)
+	if plan.HasChanges() {
+		fmt.Printf("applied %d changes\n", len(plan.Changes))
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+// api/cmd/cafeore-admin/migrate.go
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"cafeore-pos/api/internal/migrate"
+)
+
+// スキーマのマイグレーション
+//
+//	migrate up               未適用のものをすべて適用する
+//	migrate down [-steps n]  新しいものから n 個戻す
+//	migrate status           適用状況を表示する
+func runMigrate(db *gorm.DB, args []string) error {
+	fs := flag.NewFlagSet("migrate", flag.ExitOnError)
+	steps := fs.Int("steps", 1, "number of migrations to roll back (down only)")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: cafeore-admin migrate up | down [-steps n] | status")
+		fs.PrintDefaults()
+	}
+	if len(args) == 0 {
+		fs.Usage()
+		return errors.New("No subcommand")
+	}
+	sub := args[0]
+	fs.Parse(args[1:])
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		return err
+	}
+	migrator, err := migrate.New(sqlDB)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+
+	switch sub {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		for _, m := range applied {
+			fmt.Printf("applied %s\n", m)
+		}
+		if err != nil {
+			return err
+		}
+		if len(applied) == 0 {
+			fmt.Println("already up to date")
+		}
+	case "down":
+		if *steps < 1 {
+			return errors.New("-steps must be at least 1")
+		}
+		reverted, err := migrator.Down(ctx, *steps)
+		for _, m := range reverted {
+			fmt.Printf("reverted %s\n", m)
+		}
+		if err != nil {
+			return err
+		}
+		if len(reverted) == 0 {
+			fmt.Println("nothing to roll back")
+		}
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		for _, s := range statuses {
+			if s.AppliedAt == nil {
+				fmt.Printf("%-28s pending\n", s.Migration)
+				continue
+			}
+			fmt.Printf("%-28s applied at %s\n", s.Migration, s.AppliedAt.Format(time.RFC3339))
+		}
+	default:
+		fs.Usage()
+		return fmt.Errorf("Unknown subcommand %q", sub)
+	}
+	return nil
+}
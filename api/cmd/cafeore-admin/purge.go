@@ -0,0 +1,97 @@
+// api/cmd/cafeore-admin/purge.go
+package main
+
+import (
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"cafeore-pos/api/internal/models"
+)
+
+// テスト用のオーダーを関連するレコードごと削除する
+// -yes を付けない場合は対象の一覧を表示するだけ
+func runPurgeOrders(db *gorm.DB, args []string) error {
+	fs := flag.NewFlagSet("purge-orders", flag.ExitOnError)
+	id := fs.String("session", "", "session ID (default: the open session)")
+	numbers := fs.String("orders", "", "comma separated order numbers (default: all orders in the session)")
+	before := fs.String("before", "", "only orders created before this time (RFC3339)")
+	yes := fs.Bool("yes", false, "actually delete the orders")
+	fs.Parse(args)
+
+	session, err := resolveSession(db, *id)
+	if err != nil {
+		return err
+	}
+
+	query := db.Where("session_id = ?", session.ID)
+	if *numbers != "" {
+		ids := []int{}
+		for _, s := range strings.Split(*numbers, ",") {
+			n, err := strconv.Atoi(strings.TrimSpace(s))
+			if err != nil {
+				return fmt.Errorf("invalid order number %q", s)
+			}
+			ids = append(ids, n)
+		}
+		query = query.Where("order_id IN ?", ids)
+	}
+	if *before != "" {
+		t, err := time.Parse(time.RFC3339, *before)
+		if err != nil {
+			return fmt.Errorf("invalid -before: %w", err)
+		}
+		query = query.Where("created_at < ?", t)
+	}
+
+	var orders []models.Order
+	if err := query.Order("order_id").Find(&orders).Error; err != nil {
+		return err
+	}
+	if len(orders) == 0 {
+		fmt.Println("no orders matched")
+		return nil
+	}
+
+	orderIDs := make([]uuid.UUID, len(orders))
+	for i, o := range orders {
+		orderIDs[i] = o.ID
+		fmt.Printf("order %d (%s) created at %s, billing %d\n", o.OrderId, o.ID, o.CreatedAt.Format(time.RFC3339), o.BillingAmount)
+	}
+	if !*yes {
+		fmt.Printf("%d orders in session %q would be deleted; run again with -yes\n", len(orders), session.Name)
+		return nil
+	}
+
+	err = db.Transaction(func(tx *gorm.DB) error {
+		// 消すオーダーに使われたクーポンは使われていないことにする
+		if err := tx.Model(&models.Voucher{}).Where("redeemed_order_id IN ?", orderIDs).Updates(map[string]interface{}{
+			"redeemed_order_id": nil,
+			"redeemed_at":       nil,
+		}).Error; err != nil {
+			return err
+		}
+		refundIDs := tx.Model(&models.Refund{}).Select("id").Where("order_id IN ?", orderIDs)
+		if err := tx.Where("refund_id IN (?)", refundIDs).Delete(&models.RefundItem{}).Error; err != nil {
+			return err
+		}
+		// 発行した領収書は番号を使い回さないように残す（外部キーで order_id が空になる）
+		for _, m := range []interface{}{&models.Refund{}, &models.Payment{}, &models.Comment{}, &models.OrderItem{}, &models.PrintJob{}} {
+			if err := tx.Where("order_id IN ?", orderIDs).Delete(m).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Where("id IN ?", orderIDs).Delete(&models.Order{}).Error
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Printf("deleted %d orders from session %q\n", len(orders), session.Name)
+	fmt.Println("note: the cash drawer is not adjusted; record a cash movement if needed")
+	return nil
+}
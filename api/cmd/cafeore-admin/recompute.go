@@ -0,0 +1,76 @@
+// api/cmd/cafeore-admin/recompute.go
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"sort"
+
+	"gorm.io/gorm"
+
+	"cafeore-pos/api/internal/models"
+	"cafeore-pos/api/internal/repository"
+	"cafeore-pos/api/internal/service"
+)
+
+// お釣りと割引対象の杯数をオーダーの内容から計算し直す
+func runRecompute(db *gorm.DB, args []string) error {
+	fs := flag.NewFlagSet("recompute", flag.ExitOnError)
+	id := fs.String("session", "", "session ID (default: the open session)")
+	dryRun := fs.Bool("dry-run", false, "print changes without writing")
+	fs.Parse(args)
+
+	session, err := resolveSession(db, *id)
+	if err != nil {
+		return err
+	}
+
+	orders, err := repository.NewGorm(db).Orders.List(context.Background(), &session.ID)
+	if err != nil {
+		return err
+	}
+	sort.Slice(orders, func(i, j int) bool { return orders[i].OrderId < orders[j].OrderId })
+	byNumber := map[int]*models.Order{}
+	for i := range orders {
+		byNumber[orders[i].OrderId] = &orders[i]
+	}
+
+	updated := 0
+	return db.Transaction(func(tx *gorm.DB) error {
+		for _, order := range orders {
+			change := order.Received - service.CashDue(&order)
+			if change < 0 {
+				fmt.Printf("order %d: received %d is less than the cash amount, skipped\n", order.OrderId, order.Received)
+				change = order.Change
+			}
+
+			discountCups := order.DiscountOrderCups
+			if order.DiscountOrderId != 0 {
+				if discountOrder, ok := byNumber[order.DiscountOrderId]; ok {
+					discountCups = discountOrder.CoffeeCups()
+				} else {
+					fmt.Printf("order %d: discount order %d not found in the session\n", order.OrderId, order.DiscountOrderId)
+				}
+			}
+
+			if change == order.Change && discountCups == order.DiscountOrderCups {
+				continue
+			}
+			fmt.Printf("order %d: change %d -> %d, discount_order_cups %d -> %d\n",
+				order.OrderId, order.Change, change, order.DiscountOrderCups, discountCups)
+			updated++
+			if *dryRun {
+				continue
+			}
+			if err := tx.Model(&models.Order{}).Where("id = ?", order.ID).Updates(map[string]interface{}{
+				"change":              change,
+				"discount_order_cups": discountCups,
+			}).Error; err != nil {
+				return err
+			}
+		}
+		fmt.Printf("%d of %d orders updated in session %q\n", updated, len(orders), session.Name)
+		return nil
+	})
+}
@@ -0,0 +1,66 @@
+// api/cmd/cafeore-admin/session.go
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"cafeore-pos/api/internal/models"
+	"cafeore-pos/api/internal/repository"
+	"cafeore-pos/api/internal/service"
+)
+
+// サーバーの API と同じセッションの操作
+func sessionService(db *gorm.DB) *service.SessionService {
+	repos := repository.NewGorm(db)
+	return service.NewSessionService(repos.Sessions, repos.Orders, repos.Tx)
+}
+
+// -session の指定があればそのセッションを、なければ営業中のセッションを返す
+func resolveSession(db *gorm.DB, id string) (*models.Session, error) {
+	var requested *uuid.UUID
+	if id != "" {
+		sessionID, err := uuid.Parse(id)
+		if err != nil {
+			return nil, err
+		}
+		requested = &sessionID
+	}
+	return sessionService(db).GetOrCurrent(context.Background(), requested)
+}
+
+func runOpenSession(db *gorm.DB, args []string) error {
+	fs := flag.NewFlagSet("open-session", flag.ExitOnError)
+	name := fs.String("name", time.Now().Format("2006-01-02"), "session name")
+	float := fs.Int("float", 0, "opening float of the main register")
+	fs.Parse(args)
+
+	session, err := sessionService(db).Open(context.Background(), *name, *float)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("opened session %q (%s)\n", session.Name, session.ID)
+	return nil
+}
+
+func runCloseSession(db *gorm.DB, args []string) error {
+	fs := flag.NewFlagSet("close-session", flag.ExitOnError)
+	id := fs.String("session", "", "session ID (default: the open session)")
+	fs.Parse(args)
+
+	session, err := resolveSession(db, *id)
+	if err != nil {
+		return err
+	}
+	session, err = sessionService(db).Close(context.Background(), session.ID)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("closed session %q (%s)\n", session.Name, session.ID)
+	return nil
+}
@@ -0,0 +1,70 @@
+// api/cmd/cafeore-admin/status.go
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"cafeore-pos/api/internal/apierror"
+	"cafeore-pos/api/internal/models"
+)
+
+func runStatus(db *gorm.DB, args []string) error {
+	fs := flag.NewFlagSet("status", flag.ExitOnError)
+	fs.Parse(args)
+
+	var itemTypes, items int64
+	if err := db.Model(&models.ItemType{}).Count(&itemTypes).Error; err != nil {
+		return err
+	}
+	if err := db.Model(&models.Item{}).Count(&items).Error; err != nil {
+		return err
+	}
+	fmt.Printf("menu: %d item types, %d items\n", itemTypes, items)
+
+	session, err := sessionService(db).Current(context.Background())
+	if err != nil {
+		if apierror.From(err).Code == models.ErrorCodeNoOpenSession {
+			fmt.Println("session: none open")
+			return nil
+		}
+		return err
+	}
+	fmt.Printf("session: %q (%s) opened at %s\n", session.Name, session.ID, session.OpenedAt.Format(time.RFC3339))
+
+	var counts struct {
+		Total     int
+		Preparing int
+		Ready     int
+		Served    int
+		Billing   int
+	}
+	if err := db.Model(&models.Order{}).
+		Select(`COUNT(*) AS total,
+			COUNT(*) FILTER (WHERE ready_at IS NULL AND served_at IS NULL) AS preparing,
+			COUNT(*) FILTER (WHERE ready_at IS NOT NULL AND served_at IS NULL) AS ready,
+			COUNT(*) FILTER (WHERE served_at IS NOT NULL) AS served,
+			COALESCE(SUM(billing_amount), 0) AS billing`).
+		Where("session_id = ?", session.ID).
+		Scan(&counts).Error; err != nil {
+		return err
+	}
+	fmt.Printf("orders: %d total, %d preparing, %d ready, %d served\n", counts.Total, counts.Preparing, counts.Ready, counts.Served)
+	fmt.Printf("billing total: %d\n", counts.Billing)
+
+	var state models.MasterState
+	err = db.Where("session_id = ?", session.ID).Order("created_at DESC").First(&state).Error
+	switch err {
+	case nil:
+		fmt.Printf("master state: %s since %s\n", state.Type, state.CreatedAt.Format(time.RFC3339))
+	case gorm.ErrRecordNotFound:
+		fmt.Println("master state: none")
+	default:
+		return err
+	}
+	return nil
+}
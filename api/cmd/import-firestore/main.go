@@ -0,0 +1,91 @@
+// api/cmd/import-firestore/main.go
+//
+// download-orders.ts / download-master-state.ts で書き出した Firestore のデータを取り込む
+//
+//	go run ./cmd/import-firestore -orders orders.json -master-states order_stops.json -dry-run
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/joho/godotenv"
+
+	"cafeore-pos/api/internal/database"
+	"cafeore-pos/api/internal/importer"
+)
+
+func main() {
+	ordersFlag := flag.String("orders", "", "orders JSON written by download-orders.ts")
+	masterStatesFlag := flag.String("master-states", "", "master state JSON written by download-master-state.ts")
+	prefixFlag := flag.String("session-prefix", "firestore", "prefix of session names (one session per day)")
+	dryRunFlag := flag.Bool("dry-run", false, "report what would be imported without writing")
+	flag.Parse()
+
+	if *ordersFlag == "" && *masterStatesFlag == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	// 環境変数読み込み
+	if err := godotenv.Load(); err != nil {
+		log.Println("Warning: .env file not found")
+	}
+
+	var orders []importer.FirestoreOrder
+	if *ordersFlag != "" {
+		f, err := os.Open(*ordersFlag)
+		if err != nil {
+			log.Fatal(err)
+		}
+		orders, err = importer.DecodeOrders(f)
+		f.Close()
+		if err != nil {
+			log.Fatalf("Failed to read %s: %v", *ordersFlag, err)
+		}
+	}
+
+	var masterStates []importer.FirestoreMasterState
+	if *masterStatesFlag != "" {
+		f, err := os.Open(*masterStatesFlag)
+		if err != nil {
+			log.Fatal(err)
+		}
+		masterStates, err = importer.DecodeMasterStates(f)
+		f.Close()
+		if err != nil {
+			log.Fatalf("Failed to read %s: %v", *masterStatesFlag, err)
+		}
+	}
+
+	db, err := database.Open()
+	if err != nil {
+		log.Fatalf("Failed to connect database: %v", err)
+	}
+
+	report, err := importer.Import(db, orders, masterStates, importer.Options{
+		SessionPrefix: *prefixFlag,
+		DryRun:        *dryRunFlag,
+	})
+	if err != nil {
+		log.Fatalf("Import failed: %v", err)
+	}
+
+	if *dryRunFlag {
+		fmt.Println("dry run: nothing was written")
+	}
+	fmt.Printf("sessions created:      %d\n", report.SessionsCreated)
+	fmt.Printf("item types created:    %d\n", report.ItemTypesCreated)
+	fmt.Printf("items created:         %d\n", report.ItemsCreated)
+	fmt.Printf("items updated:         %d\n", report.ItemsUpdated)
+	fmt.Printf("orders created:        %d (skipped %d)\n", report.OrdersCreated, report.OrdersSkipped)
+	fmt.Printf("master states created: %d (skipped %d)\n", report.MasterStatesCreated, report.MasterStatesSkipped)
+	if len(report.Conflicts) > 0 {
+		fmt.Printf("conflicts: %d\n", len(report.Conflicts))
+		for _, c := range report.Conflicts {
+			fmt.Printf("  - %s\n", c)
+		}
+	}
+}
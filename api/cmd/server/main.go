@@ -2,13 +2,24 @@
 package main
 
 import (
+	"context"
+	"database/sql"
 	"log"
-	"net/http"
 	"os"
+	"strings"
 	"time"
 
+	"cafeore-pos/api/internal/callscreen"
+	"cafeore-pos/api/internal/escpos"
 	"cafeore-pos/api/internal/handlers"
-	"cafeore-pos/api/internal/models"
+	"cafeore-pos/api/internal/migrate"
+	"cafeore-pos/api/internal/payments"
+	"cafeore-pos/api/internal/printing"
+	"cafeore-pos/api/internal/promotions"
+	"cafeore-pos/api/internal/receipt"
+	"cafeore-pos/api/internal/repository"
+	"cafeore-pos/api/internal/service"
+	"cafeore-pos/api/internal/tracking"
 
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
@@ -17,13 +28,6 @@ import (
 	"gorm.io/gorm"
 )
 
-type StatusResponse struct {
-	Status    string    `json:"status"`
-	Timestamp time.Time `json:"timestamp"`
-	Version   string    `json:"version"`
-	Database  string    `json:"database"`
-}
-
 var db *gorm.DB
 
 func initDB() error {
@@ -34,14 +38,13 @@ func initDB() error {
 
 	var err error
 	db, err = gorm.Open(
-    postgres.New(postgres.Config{
-      DSN:                  dsn,
-      PreferSimpleProtocol: true,
-    }), 
-    &gorm.Config{
-      PrepareStmt: false,
-      DisableForeignKeyConstraintWhenMigrating: true,
-	})
+		postgres.New(postgres.Config{
+			DSN:                  dsn,
+			PreferSimpleProtocol: true,
+		}),
+		&gorm.Config{
+			PrepareStmt: false,
+		})
 	if err != nil {
 		return err
 	}
@@ -56,57 +59,53 @@ func initDB() error {
 		return err
 	}
 
-    err = db.AutoMigrate(
-        &models.ItemType{},
-        &models.Item{},
-        &models.Order{},
-        &models.Comment{},
-				&models.OrderItem{},
-				&models.MasterState{},
-    )
-    if err != nil {
-        panic(err)
-    }
+	// スキーマを最新にする（MIGRATE_ON_START=false なら cafeore-admin migrate up で手動で行う）
+	if os.Getenv("MIGRATE_ON_START") != "false" {
+		if err := migrateDB(sqlDB); err != nil {
+			return err
+		}
+	}
+
+	// 割引ルールがなければ、これまで POS で固定していたカップ返却の割引を作る
+	if err := promotions.SeedDefault(db); err != nil {
+		return err
+	}
 
 	log.Println("Database connected successfully")
 	return nil
 }
 
-func statusHandler(c *gin.Context) {
-	dbStatus := "connected"
-
-	// DB接続確認
-	sqlDB, err := db.DB()
-	if err != nil || sqlDB.Ping() != nil {
-		dbStatus = "disconnected"
+// 未適用のマイグレーションを適用する
+func migrateDB(sqlDB *sql.DB) error {
+	migrator, err := migrate.New(sqlDB)
+	if err != nil {
+		return err
 	}
-
-	response := StatusResponse{
-		Status:    "ok",
-		Timestamp: time.Now(),
-		Version:   "1.0.0",
-		Database:  dbStatus,
+	applied, err := migrator.Up(context.Background())
+	for _, m := range applied {
+		log.Printf("Applied migration %s", m)
 	}
-
-	c.JSON(http.StatusOK, response)
+	return err
 }
 
-func healthHandler(c *gin.Context) {
-	var result int
-	err := db.Raw("SELECT 1").Scan(&result).Error
-
+// 環境変数から時間を読み取る（未設定・不正な値なら既定値）
+func mockTerminalDuration(key string, fallback time.Duration) time.Duration {
+	d, err := time.ParseDuration(os.Getenv(key))
 	if err != nil {
-		c.JSON(http.StatusServiceUnavailable, gin.H{
-			"status": "unhealthy",
-			"error":  err.Error(),
-		})
-		return
+		return fallback
 	}
+	return d
+}
 
-	c.JSON(http.StatusOK, gin.H{
-		"status":   "healthy",
-		"database": "connected",
-	})
+// カンマ区切りの IP・CIDR（空なら nil）
+func trustedProxies(value string) []string {
+	var proxies []string
+	for _, p := range strings.Split(value, ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			proxies = append(proxies, p)
+		}
+	}
+	return proxies
 }
 
 func main() {
@@ -123,57 +122,97 @@ func main() {
 	// Ginルーター
 	r := gin.Default()
 
+	// X-Forwarded-For を信用するリバースプロキシ（未設定なら信用せず接続元の IP を使う）
+	// 回数制限はクライアントの IP ごとなので、ヘッダーで偽装できないようにする
+	if err := r.SetTrustedProxies(trustedProxies(os.Getenv("TRUSTED_PROXIES"))); err != nil {
+		log.Fatalf("Invalid TRUSTED_PROXIES: %v", err)
+	}
+
 	// CORS設定
 	r.Use(cors.New(cors.Config{
-    AllowOrigins:     []string{"*"},
-    AllowMethods:     []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"}, // PATCHを追加
-    AllowHeaders:     []string{"Origin", "Content-Type", "Authorization"},
-    ExposeHeaders:    []string{"Content-Length"},
-    AllowCredentials: true,
+		AllowOrigins:     []string{"*"},
+		AllowMethods:     []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"}, // PATCHを追加
+		AllowHeaders:     []string{"Origin", "Content-Type", "Authorization"},
+		ExposeHeaders:    []string{"Content-Length"},
+		AllowCredentials: true,
 	}))
 
+	// お客様向けの注文状況（認証なしで公開するので回数を制限する）
+	trackingConfig := tracking.ConfigFromEnv()
+	r.Use(handlers.RateLimitPrefix("/api/track", trackingConfig.RateLimit, time.Minute))
+
+	// リクエスト・レスポンスを OpenAPI の仕様で検証する
+	validator, err := handlers.OpenAPIValidator(handlers.ParseResponseValidation(os.Getenv("OPENAPI_RESPONSE_VALIDATION")))
+	if err != nil {
+		log.Fatalf("Failed to load OpenAPI spec: %v", err)
+	}
+	r.Use(validator)
+	// ハンドラーのエラーを ErrorResponse にする（検証より内側に置き、エラーのレスポンスも検証する）
+	r.Use(handlers.ErrorHandler())
+
 	hub := handlers.NewHub()
 	go hub.Run()
 
+	// 決済手段（キャッシュレスは開発用のモック端末）
+	paymentRegistry := payments.NewRegistry(
+		payments.NewCashProvider(),
+		payments.NewMockTerminal(mockTerminalDuration("MOCK_TERMINAL_LATENCY", 500*time.Millisecond), mockTerminalDuration("MOCK_TERMINAL_TIMEOUT", 5*time.Second)),
+	)
+
+	// ラベル・レシートのテンプレート
+	renderer, err := escpos.NewRenderer(escpos.ConfigFromEnv())
+	if err != nil {
+		log.Fatalf("Failed to load print templates: %v", err)
+	}
+
+	// ネットワークプリンターへの印刷ジョブの送信
+	spooler := printing.NewSpooler(db, renderer, printing.ConfigFromEnv())
+	go spooler.Run(context.Background())
+
+	// データの読み書きと業務ロジック
+	repos := repository.NewGorm(db)
+	orderService := service.NewOrderService(repos, paymentRegistry, spooler)
+	sessionService := service.NewSessionService(repos.Sessions, repos.Orders, repos.Tx)
+	masterStateService := service.NewMasterStateService(repos.MasterStates, repos.Sessions)
+
 	// ハンドラー初期化
-	itemHandler := handlers.NewItemHandler(db)
-	itemTypeHandler := handlers.NewItemTypeHandler(db)
-	orderHandler := handlers.NewOrderHandler(db, hub)
-	commentHandler := handlers.NewCommentHandler(db, hub)
-	masterStateHandler := handlers.NewMasterStateHandler(db)
-
-
-	// エンドポイント
-	r.GET("/status", statusHandler)
-	r.GET("/health", healthHandler)
-
-	// API エンドポイント
-	api := r.Group("/api")
-	{
-		api.GET("/items", itemHandler.GetItems)
-		api.POST("/items", itemHandler.CreateItem)
-		api.GET("/items/:id", itemHandler.GetItem)
-		api.PUT("/items/:id", itemHandler.UpdateItem)
-		api.DELETE("/items/:id", itemHandler.DeleteItem)
-		api.GET("/item-types", itemTypeHandler.GetItemTypes)
-		api.POST("/item-types", itemTypeHandler.CreateItemType)
-		api.GET("/item-types/:id", itemTypeHandler.GetItemType)
-		api.PUT("/item-types/:id", itemTypeHandler.UpdateItemType)
-		api.DELETE("/item-types/:id", itemTypeHandler.DeleteItemType)
-		api.GET("/orders", orderHandler.GetOrders)
-		api.GET("/ws/orders", orderHandler.WSHandler)
-		api.POST("/orders", orderHandler.CreateOrder)
-		api.GET("/orders/:id", orderHandler.GetOrder)
-		api.PUT("/orders/:id", orderHandler.UpdateOrder)
-		api.DELETE("/orders/:id", orderHandler.DeleteOrder)
-		api.PATCH("/orders/:id/ready", orderHandler.MarkOrderReady)
-		api.PATCH("/orders/:id/served", orderHandler.MarkOrderServed)
-		api.GET("/orders/:id/comments", commentHandler.GetOrderComments)
-		api.POST("/orders/:id/comments", commentHandler.CreateComment)
-		api.GET("/master-status", masterStateHandler.GetMasterStatus)
-		api.POST("/master-status", masterStateHandler.UpdateMasterStatus)
+	callscreenConfig := callscreen.ConfigFromEnv()
+	callscreenHandler := handlers.NewCallscreenHandler(sessionService, orderService, hub, callscreenConfig)
+	go callscreenHandler.Run()
+	orderHandler := handlers.NewOrderHandler(orderService, masterStateService, hub, callscreenHandler)
+	statusHandler := handlers.NewStatusHandler(db)
+	server := &handlers.Server{
+		StatusHandler:      statusHandler,
+		ItemHandler:        handlers.NewItemHandler(service.NewItemService(repos.Items, repos.Tx)),
+		ItemTypeHandler:    handlers.NewItemTypeHandler(service.NewItemTypeService(repos.ItemTypes)),
+		ModifierHandler:    handlers.NewModifierHandler(db, repos.Modifiers),
+		PromotionHandler:   handlers.NewPromotionHandler(db, orderService),
+		VoucherHandler:     handlers.NewVoucherHandler(db),
+		OrderHandler:       orderHandler,
+		CommentHandler:     handlers.NewCommentHandler(service.NewCommentService(repos.Comments, repos.Orders), orderService, hub),
+		RefundHandler:      handlers.NewRefundHandler(service.NewRefundService(repos), sessionService, orderService, hub),
+		PaymentHandler:     handlers.NewPaymentHandler(db, paymentRegistry),
+		LabelHandler:       handlers.NewLabelHandler(db, renderer),
+		ReceiptHandler:     handlers.NewReceiptHandler(service.NewReceiptService(repos.Orders, repos.Receipts), receipt.ConfigFromEnv()),
+		PrintJobHandler:    handlers.NewPrintJobHandler(db, spooler),
+		CallscreenHandler:  callscreenHandler,
+		QueueHandler:       handlers.NewQueueHandler(sessionService, orderService),
+		TrackingHandler:    handlers.NewTrackingHandler(orderService, hub, trackingConfig, callscreenConfig),
+		MasterStateHandler: handlers.NewMasterStateHandler(masterStateService, hub),
+		SessionHandler:     handlers.NewSessionHandler(sessionService, orderService, masterStateService, hub),
+		CashHandler:        handlers.NewCashHandler(db, sessionService, service.NewCashService(repos.CashMovements, repos.Sessions, repos.Tx), service.NewDrawerService(repos.Drawers, repos.Sessions, repos.Tx)),
+		ReportHandler:      handlers.NewReportHandler(db, sessionService),
+		ExportHandler:      handlers.NewExportHandler(db),
 	}
 
+	// エンドポイント（openapi/openapi.yaml の仕様どおり）
+	server.Register(r)
+
+	// 仕様にないエンドポイント
+	r.GET("/health", statusHandler.Health)
+	r.GET("/api/ws/orders", orderHandler.WSHandler)
+	r.GET("/api/ws/callscreen", callscreenHandler.WSHandler)
+
 	// サーバー起動
 	port := os.Getenv("PORT")
 	if port == "" {
@@ -188,6 +227,8 @@ func main() {
 	log.Printf("  GET  /api/item-types")
 	log.Printf("  GET  /api/orders")
 	log.Printf("  GET  /api/orders/:id/comments")
+	log.Printf("  GET  /api/sessions")
+	log.Printf("  GET  /api/reports/summary")
 
 	if err := r.Run(":" + port); err != nil {
 		log.Fatal(err)
@@ -0,0 +1,209 @@
+// api/internal/apierror/apierror.go
+//
+// API のエラー（openapi/openapi.yaml の ErrorResponse）
+// クライアントは code で表示するメッセージを選ぶので、message は開発者向けの英語のままにする
+package apierror
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"gorm.io/gorm"
+
+	"cafeore-pos/api/internal/models"
+)
+
+// エラーの種類（値は仕様の ErrorCode）
+type Code = models.ErrorCode
+
+// 種類ごとの HTTP のステータス（ない場合は 500）
+var statuses = map[Code]int{
+	models.ErrorCodeValidationFailed:      http.StatusBadRequest,
+	models.ErrorCodeItemUnavailable:       http.StatusBadRequest,
+	models.ErrorCodeDiscountOrderNotFound: http.StatusBadRequest,
+	models.ErrorCodeVoucherExpired:        http.StatusBadRequest,
+	models.ErrorCodeVoucherNotApplicable:  http.StatusBadRequest,
+	models.ErrorCodeBillingAmountMismatch: http.StatusBadRequest,
+	models.ErrorCodeInsufficientPayment:   http.StatusBadRequest,
+
+	models.ErrorCodeNotFound:              http.StatusNotFound,
+	models.ErrorCodeOrderNotFound:         http.StatusNotFound,
+	models.ErrorCodeItemNotFound:          http.StatusNotFound,
+	models.ErrorCodeItemTypeNotFound:      http.StatusNotFound,
+	models.ErrorCodeModifierGroupNotFound: http.StatusNotFound,
+	models.ErrorCodePriceNotFound:         http.StatusNotFound,
+	models.ErrorCodePromotionNotFound:     http.StatusNotFound,
+	models.ErrorCodeVoucherNotFound:       http.StatusNotFound,
+	models.ErrorCodeVoucherBatchNotFound:  http.StatusNotFound,
+	models.ErrorCodeSessionNotFound:       http.StatusNotFound,
+	models.ErrorCodeCloseoutNotFound:      http.StatusNotFound,
+	models.ErrorCodePrintJobNotFound:      http.StatusNotFound,
+
+	models.ErrorCodeNoOpenSession:          http.StatusConflict,
+	models.ErrorCodeSessionAlreadyOpen:     http.StatusConflict,
+	models.ErrorCodeSessionAlreadyClosed:   http.StatusConflict,
+	models.ErrorCodeCloseoutExists:         http.StatusConflict,
+	models.ErrorCodeOrderNumberInUse:       http.StatusConflict,
+	models.ErrorCodeOrderNotServed:         http.StatusConflict,
+	models.ErrorCodeOrderAlreadyServed:     http.StatusConflict,
+	models.ErrorCodeOrderAlreadyCalled:     http.StatusConflict,
+	models.ErrorCodeOrderNotCalled:         http.StatusConflict,
+	models.ErrorCodePriceAlreadyEffective:  http.StatusConflict,
+	models.ErrorCodePrintJobNotFailed:      http.StatusConflict,
+	models.ErrorCodeDiscountAlreadyUsed:    http.StatusConflict,
+	models.ErrorCodeVoucherAlreadyRedeemed: http.StatusConflict,
+
+	models.ErrorCodeRateLimited: http.StatusTooManyRequests,
+}
+
+// 種類ごとの HTTP のステータス
+func StatusOf(code Code) int {
+	if status, ok := statuses[code]; ok {
+		return status
+	}
+	return http.StatusInternalServerError
+}
+
+// API のエラー
+type Error struct {
+	Code    Code
+	Status  int
+	Message string
+	// 項目ごとの理由（VALIDATION_FAILED の場合）
+	Details []models.FieldError
+	// 元のエラー（レスポンスには含めない）
+	Err error
+}
+
+func New(code Code, message string) *Error {
+	return &Error{Code: code, Status: StatusOf(code), Message: message}
+}
+
+func Newf(code Code, format string, args ...any) *Error {
+	return New(code, fmt.Sprintf(format, args...))
+}
+
+// err のメッセージをそのまま使う
+func Wrap(code Code, err error) *Error {
+	e := New(code, err.Error())
+	e.Err = err
+	return e
+}
+
+// 予期しないエラー（メッセージはレスポンスに含めない）
+func Internal(err error) *Error {
+	e := New(models.ErrorCodeInternal, "Internal server error")
+	e.Err = err
+	return e
+}
+
+// リクエストの内容が正しくない
+func Validation(message string, details ...models.FieldError) *Error {
+	e := New(models.ErrorCodeValidationFailed, message)
+	e.Details = details
+	return e
+}
+
+// 一つの項目が正しくない（field はパラメーター名、またはリクエストボディの JSON Pointer）
+func Invalid(field, message string) *Error {
+	return Validation(message, models.FieldError{Field: field, Message: message})
+}
+
+// ステータスを変える（同じ種類でもエンドポイントによって返すステータスが違う場合）
+func (e *Error) WithStatus(status int) *Error {
+	e.Status = status
+	return e
+}
+
+func (e *Error) Error() string {
+	if e.Err != nil && e.Err.Error() != e.Message {
+		return fmt.Sprintf("%s: %s: %v", e.Code, e.Message, e.Err)
+	}
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// レスポンスの本文
+func (e *Error) Response() models.ErrorResponse {
+	resp := models.ErrorResponse{Code: e.Code, Error: e.Message}
+	if len(e.Details) > 0 {
+		details := e.Details
+		resp.Details = &details
+	}
+	return resp
+}
+
+// 任意のエラーを API のエラーにする
+// *Error 以外は、レコードがなければ NOT_FOUND、それ以外は INTERNAL
+func From(err error) *Error {
+	var e *Error
+	if errors.As(err, &e) {
+		return e
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		e = New(models.ErrorCodeNotFound, "Not found")
+		e.Err = err
+		return e
+	}
+	return Internal(err)
+}
+
+// リクエストボディの読み込み（ShouldBindJSON）のエラー
+// JSON の型が違う場合は項目の JSON Pointer を details に入れる
+func Binding(err error) *Error {
+	var e *Error
+	var typeErr *json.UnmarshalTypeError
+	var syntaxErr *json.SyntaxError
+	switch {
+	case errors.As(err, &typeErr):
+		field := ""
+		if typeErr.Field != "" {
+			field = "/" + strings.ReplaceAll(typeErr.Field, ".", "/")
+		}
+		e = Validation("Invalid request body", models.FieldError{
+			Field:   field,
+			Message: "must be " + jsonType(typeErr.Type),
+		})
+	case errors.As(err, &syntaxErr):
+		e = Validation("Invalid request body", models.FieldError{
+			Field:   "",
+			Message: fmt.Sprintf("is not valid JSON (offset %d)", syntaxErr.Offset),
+		})
+	case errors.Is(err, io.EOF):
+		e = Validation("Request body is required")
+	default:
+		e = Validation(err.Error())
+	}
+	e.Err = err
+	return e
+}
+
+// Go の型に対応する JSON の型の名前
+func jsonType(t reflect.Type) string {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return "a boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "an integer"
+	case reflect.Float32, reflect.Float64:
+		return "a number"
+	case reflect.String:
+		return "a string"
+	case reflect.Slice, reflect.Array:
+		return "an array"
+	default:
+		return "an object"
+	}
+}
@@ -0,0 +1,190 @@
+// api/internal/callscreen/callscreen.go
+//
+// 呼び出し画面のオーダー番号の呼び出し
+// 呼び出しは最後に呼び出してから Expiry の間だけ有効で、受け取られなければ期限切れになる
+package callscreen
+
+import (
+	"errors"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"cafeore-pos/api/internal/models"
+)
+
+var (
+	ErrServed        = errors.New("Order already served")
+	ErrAlreadyCalled = errors.New("Order already called")
+	ErrNotCalled     = errors.New("Order not called yet")
+)
+
+// 呼び出し画面の設定
+type Config struct {
+	// 呼び出しが期限切れになるまでの時間
+	Expiry time.Duration
+	// 最近提供したオーダー・次に呼ばれるオーダーを出す件数
+	RecentLimit int
+	NextUpLimit int
+}
+
+// 環境変数から設定を読む
+//
+//	CALLSCREEN_EXPIRY
+func ConfigFromEnv() Config {
+	cfg := Config{
+		Expiry:      10 * time.Minute,
+		RecentLimit: 10,
+		NextUpLimit: 20,
+	}
+	if d, err := time.ParseDuration(os.Getenv("CALLSCREEN_EXPIRY")); err == nil && d > 0 {
+		cfg.Expiry = d
+	}
+	return cfg
+}
+
+// 呼び出しが期限切れになる時刻
+func (c Config) ExpiresAt(order *models.Order) time.Time {
+	if order.LastCalledAt == nil {
+		return time.Time{}
+	}
+	return order.LastCalledAt.Add(c.Expiry)
+}
+
+// 呼び出し中か
+func (c Config) Calling(order *models.Order, now time.Time) bool {
+	return order.CalledAt != nil && order.ServedAt == nil && now.Before(c.ExpiresAt(order))
+}
+
+// オーダーを呼び出す（保存は呼び出し側で行う）
+func Call(order *models.Order, now time.Time) error {
+	if order.ServedAt != nil {
+		return ErrServed
+	}
+	if order.CalledAt != nil {
+		return ErrAlreadyCalled
+	}
+	order.CalledAt = &now
+	order.LastCalledAt = &now
+	order.RecallCount = 0
+	return nil
+}
+
+// 呼び出し済みのオーダーをもう一度呼び出す（保存は呼び出し側で行う）
+func Recall(order *models.Order, now time.Time) error {
+	if order.ServedAt != nil {
+		return ErrServed
+	}
+	if order.CalledAt == nil {
+		return ErrNotCalled
+	}
+	order.LastCalledAt = &now
+	order.RecallCount++
+	return nil
+}
+
+// 呼び出しを取り消す（準備中に戻したとき）
+func Clear(order *models.Order) {
+	order.CalledAt = nil
+	order.LastCalledAt = nil
+	order.RecallCount = 0
+}
+
+// 呼び出し画面に出す内容
+// NextExpiry は次に呼び出しが期限切れになる時刻（呼び出し中がなければゼロ値）
+type Snapshot struct {
+	Response   models.CallscreenResponse
+	NextExpiry time.Time
+}
+
+// 呼び出し画面に出すオーダー
+type Orders struct {
+	// 呼び出し済みで未提供（呼び出した順）
+	Called []models.Order
+	// 提供済み（新しい順に RecentLimit 件まで）
+	Served []models.Order
+	// 呼び出し前の未提供（注文順に NextUpLimit 件まで）
+	NextUp []models.Order
+}
+
+// セッションの呼び出し画面に出すオーダーを読み込む（sessionID が nil の場合は絞り込まない）
+func Load(db *gorm.DB, sessionID *uuid.UUID, cfg Config) (Orders, error) {
+	scope := func() *gorm.DB {
+		q := db.Model(&models.Order{})
+		if sessionID != nil {
+			q = q.Where("session_id = ?", *sessionID)
+		}
+		return q
+	}
+
+	var orders Orders
+	if err := scope().
+		Select("order_id", "called_at", "last_called_at", "recall_count").
+		Where("served_at IS NULL AND called_at IS NOT NULL").
+		Order("called_at, order_id").
+		Find(&orders.Called).Error; err != nil {
+		return Orders{}, err
+	}
+	if err := scope().
+		Select("order_id", "served_at").
+		Where("served_at IS NOT NULL").
+		Order("served_at DESC").
+		Limit(cfg.RecentLimit).
+		Find(&orders.Served).Error; err != nil {
+		return Orders{}, err
+	}
+	if err := scope().
+		Select("order_id").
+		Where("served_at IS NULL AND called_at IS NULL").
+		Order("created_at, order_id").
+		Limit(cfg.NextUpLimit).
+		Find(&orders.NextUp).Error; err != nil {
+		return Orders{}, err
+	}
+	return orders, nil
+}
+
+// 呼び出し画面の内容を作る
+func Build(orders Orders, cfg Config, now time.Time) Snapshot {
+	deadline := now.Add(-cfg.Expiry)
+
+	snap := Snapshot{Response: models.CallscreenResponse{
+		Calling:           []models.CallscreenCall{},
+		Expired:           []int{},
+		RecentlyServed:    []models.CallscreenServed{},
+		NextUp:            []int{},
+		CallExpirySeconds: int(cfg.Expiry / time.Second),
+		GeneratedAt:       now,
+	}}
+
+	for i := range orders.Called {
+		o := &orders.Called[i]
+		if !o.LastCalledAt.After(deadline) {
+			snap.Response.Expired = append(snap.Response.Expired, o.OrderId)
+			continue
+		}
+		expiresAt := cfg.ExpiresAt(o)
+		snap.Response.Calling = append(snap.Response.Calling, models.CallscreenCall{
+			OrderNumber:  o.OrderId,
+			CalledAt:     *o.CalledAt,
+			LastCalledAt: *o.LastCalledAt,
+			RecallCount:  o.RecallCount,
+			ExpiresAt:    expiresAt,
+		})
+		if snap.NextExpiry.IsZero() || expiresAt.Before(snap.NextExpiry) {
+			snap.NextExpiry = expiresAt
+		}
+	}
+	for _, o := range orders.Served {
+		snap.Response.RecentlyServed = append(snap.Response.RecentlyServed, models.CallscreenServed{
+			OrderNumber: o.OrderId,
+			ServedAt:    *o.ServedAt,
+		})
+	}
+	for _, o := range orders.NextUp {
+		snap.Response.NextUp = append(snap.Response.NextUp, o.OrderId)
+	}
+	return snap
+}
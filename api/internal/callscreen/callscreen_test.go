@@ -0,0 +1,126 @@
+// api/internal/callscreen/callscreen_test.go
+package callscreen
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"cafeore-pos/api/internal/models"
+)
+
+var base = time.Date(2025, 11, 1, 10, 0, 0, 0, time.UTC)
+
+func called(number int, lastCalledAt time.Time) models.Order {
+	calledAt := lastCalledAt.Add(-time.Minute)
+	return models.Order{OrderId: number, CalledAt: &calledAt, LastCalledAt: &lastCalledAt}
+}
+
+func TestExpiryBoundary(t *testing.T) {
+	cfg := Config{Expiry: 10 * time.Minute}
+	order := called(1, base)
+	expiresAt := base.Add(cfg.Expiry)
+
+	if got := cfg.ExpiresAt(&order); !got.Equal(expiresAt) {
+		t.Errorf("ExpiresAt = %v, want %v", got, expiresAt)
+	}
+	// 期限ちょうどで期限切れにする（Calling と Build で同じ境界）
+	tests := []struct {
+		now     time.Time
+		calling bool
+	}{
+		{expiresAt.Add(-time.Nanosecond), true},
+		{expiresAt, false},
+		{expiresAt.Add(time.Second), false},
+	}
+	for _, tt := range tests {
+		if got := cfg.Calling(&order, tt.now); got != tt.calling {
+			t.Errorf("Calling at %v = %v, want %v", tt.now, got, tt.calling)
+		}
+		snap := Build(Orders{Called: []models.Order{order}}, cfg, tt.now)
+		if got := len(snap.Response.Calling) == 1; got != tt.calling {
+			t.Errorf("Build at %v: calling %v, expired %v", tt.now, snap.Response.Calling, snap.Response.Expired)
+		}
+		if got := len(snap.Response.Expired) == 1; got == tt.calling {
+			t.Errorf("Build at %v: expired %v", tt.now, snap.Response.Expired)
+		}
+	}
+
+	// 提供済み・呼び出し前のオーダーは呼び出し中ではない
+	served := called(2, base)
+	served.ServedAt = &base
+	if cfg.Calling(&served, base) || cfg.Calling(&models.Order{}, base) {
+		t.Error("served or uncalled order is calling")
+	}
+	if !cfg.ExpiresAt(&models.Order{}).IsZero() {
+		t.Error("uncalled order has an expiry")
+	}
+}
+
+func TestBuild(t *testing.T) {
+	cfg := Config{Expiry: 10 * time.Minute}
+	now := base.Add(15 * time.Minute)
+	servedAt := base.Add(5 * time.Minute)
+	orders := Orders{
+		Called: []models.Order{
+			called(1, base),                     // 期限切れ
+			called(2, base.Add(8*time.Minute)),  // 10:18 まで
+			called(3, base.Add(12*time.Minute)), // 10:22 まで
+		},
+		Served: []models.Order{{OrderId: 4, ServedAt: &servedAt}},
+		NextUp: []models.Order{{OrderId: 5}, {OrderId: 6}},
+	}
+	snap := Build(orders, cfg, now)
+	r := snap.Response
+
+	if len(r.Calling) != 2 || r.Calling[0].OrderNumber != 2 || r.Calling[1].OrderNumber != 3 {
+		t.Errorf("calling = %+v", r.Calling)
+	}
+	if len(r.Expired) != 1 || r.Expired[0] != 1 {
+		t.Errorf("expired = %v", r.Expired)
+	}
+	// 次に期限切れになるのは一番早いもの
+	if want := base.Add(18 * time.Minute); !snap.NextExpiry.Equal(want) {
+		t.Errorf("next expiry = %v, want %v", snap.NextExpiry, want)
+	}
+	if len(r.RecentlyServed) != 1 || r.RecentlyServed[0].OrderNumber != 4 || len(r.NextUp) != 2 || r.CallExpirySeconds != 600 {
+		t.Errorf("response = %+v", r)
+	}
+
+	// 呼び出し中がなければ NextExpiry はゼロ値で、一覧は空でも nil にしない
+	empty := Build(Orders{}, cfg, now)
+	if !empty.NextExpiry.IsZero() || empty.Response.Calling == nil || empty.Response.Expired == nil || empty.Response.NextUp == nil {
+		t.Errorf("empty snapshot = %+v", empty)
+	}
+}
+
+func TestCallRecall(t *testing.T) {
+	var order models.Order
+	if err := Recall(&order, base); !errors.Is(err, ErrNotCalled) {
+		t.Errorf("Recall before Call = %v, want ErrNotCalled", err)
+	}
+	if err := Call(&order, base); err != nil {
+		t.Fatal(err)
+	}
+	if err := Call(&order, base); !errors.Is(err, ErrAlreadyCalled) {
+		t.Errorf("second Call = %v, want ErrAlreadyCalled", err)
+	}
+
+	later := base.Add(time.Minute)
+	if err := Recall(&order, later); err != nil {
+		t.Fatal(err)
+	}
+	if !order.CalledAt.Equal(base) || !order.LastCalledAt.Equal(later) || order.RecallCount != 1 {
+		t.Errorf("recalled order = %+v", order)
+	}
+
+	Clear(&order)
+	if order.CalledAt != nil || order.LastCalledAt != nil || order.RecallCount != 0 {
+		t.Errorf("cleared order = %+v", order)
+	}
+
+	order.ServedAt = &later
+	if err := Call(&order, later); !errors.Is(err, ErrServed) {
+		t.Errorf("Call on served order = %v, want ErrServed", err)
+	}
+}
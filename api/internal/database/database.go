@@ -0,0 +1,43 @@
+// api/internal/database/database.go
+package database
+
+import (
+	"errors"
+	"os"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+var ErrNoDatabaseURL = errors.New("DATABASE_URL environment variable is not set")
+
+// DATABASE_URL のデータベースに接続する（コマンドラインツール用）
+func Open() (*gorm.DB, error) {
+	dsn := os.Getenv("DATABASE_URL")
+	if dsn == "" {
+		return nil, ErrNoDatabaseURL
+	}
+
+	db, err := gorm.Open(
+		postgres.New(postgres.Config{
+			DSN:                  dsn,
+			PreferSimpleProtocol: true,
+		}),
+		&gorm.Config{
+			PrepareStmt: false,
+			Logger:      logger.Default.LogMode(logger.Warn),
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, err
+	}
+	if err := sqlDB.Ping(); err != nil {
+		return nil, err
+	}
+	return db, nil
+}
@@ -0,0 +1,156 @@
+// api/internal/escpos/data.go
+package escpos
+
+import (
+	"io"
+	"time"
+
+	"cafeore-pos/api/internal/models"
+	"cafeore-pos/api/internal/payments"
+)
+
+// 一杯ごとのラベル
+type ItemLabel struct {
+	OrderID  int
+	Index    int
+	Total    int
+	Name     string
+	Assignee string
+}
+
+// 引換券に貼る明細のラベル
+type SummaryLabel struct {
+	OrderID    int
+	Total      int
+	Assigned   []AssignedItem
+	Unassigned []string
+	// お客様が注文の状況を確認するためのコード
+	TrackingToken string
+}
+
+type AssignedItem struct {
+	Name     string
+	Assignee string
+}
+
+// labels.tmpl に渡すデータ
+type Labels struct {
+	Items   []ItemLabel
+	Summary SummaryLabel
+}
+
+// お客様控えのレシート
+type Receipt struct {
+	ShopName   string
+	Footer     string
+	OrderID    int
+	CreatedAt  time.Time
+	Lines      []ReceiptLine
+	Subtotal   int
+	Promotions models.OrderPromotions
+	Total      int
+	Payments   []ReceiptPayment
+	Received   int
+	Change     int
+}
+
+type ReceiptLine struct {
+	Name      string
+	Modifiers []string
+	Price     int
+}
+
+type ReceiptPayment struct {
+	Label  string
+	Amount int
+}
+
+func assigneeOf(p *models.PrepItem) string {
+	if p.Assignee == nil {
+		return ""
+	}
+	return *p.Assignee
+}
+
+// オーダーのラベルの内容
+// 一杯ごとのラベルはコーヒーだけ（セット商品は構成アイテムに展開する）
+// order.OrderItems と Item.ItemType がロードされている必要がある
+func NewLabels(order *models.Order) Labels {
+	prepItems := order.PrepItems()
+
+	var coffees []models.PrepItem
+	for _, p := range prepItems {
+		if p.IsCoffee() {
+			coffees = append(coffees, p)
+		}
+	}
+	labels := Labels{
+		Summary: SummaryLabel{OrderID: order.OrderId, Total: order.BillingAmount, TrackingToken: order.TrackingToken},
+	}
+	for i, p := range coffees {
+		labels.Items = append(labels.Items, ItemLabel{
+			OrderID:  order.OrderId,
+			Index:    i + 1,
+			Total:    len(coffees),
+			Name:     p.Name,
+			Assignee: assigneeOf(&p),
+		})
+	}
+	for _, p := range prepItems {
+		if p.Assignee != nil && *p.Assignee != "" {
+			labels.Summary.Assigned = append(labels.Summary.Assigned, AssignedItem{Name: p.Name, Assignee: *p.Assignee})
+		} else {
+			labels.Summary.Unassigned = append(labels.Summary.Unassigned, p.Name)
+		}
+	}
+	return labels
+}
+
+func paymentLabel(method string) string {
+	switch method {
+	case payments.MethodCash:
+		return "現金"
+	case payments.MethodTerminal:
+		return "キャッシュレス"
+	}
+	return method
+}
+
+// オーダーのレシートの内容
+func (r *Renderer) NewReceipt(order *models.Order) Receipt {
+	receipt := Receipt{
+		ShopName:   r.cfg.ShopName,
+		Footer:     r.cfg.Footer,
+		OrderID:    order.OrderId,
+		CreatedAt:  order.CreatedAt,
+		Promotions: order.AppliedPromotions,
+		Total:      order.BillingAmount,
+		Received:   order.Received,
+		Change:     order.Change,
+	}
+	for _, oi := range order.OrderItems {
+		line := ReceiptLine{Name: oi.Name, Price: oi.UnitPrice}
+		for _, m := range oi.Modifiers {
+			line.Modifiers = append(line.Modifiers, m.Name)
+		}
+		receipt.Lines = append(receipt.Lines, line)
+		receipt.Subtotal += oi.UnitPrice
+	}
+	for _, p := range order.Payments {
+		if p.Status != string(payments.StatusApproved) {
+			continue
+		}
+		receipt.Payments = append(receipt.Payments, ReceiptPayment{Label: paymentLabel(p.Method), Amount: p.Amount})
+	}
+	return receipt
+}
+
+// 一杯ごとのラベルと明細を書き出す
+func (r *Renderer) RenderLabels(w io.Writer, order *models.Order) error {
+	return r.Render(w, TemplateLabels, NewLabels(order))
+}
+
+// レシートを書き出す
+func (r *Renderer) RenderReceipt(w io.Writer, order *models.Order) error {
+	return r.Render(w, TemplateReceipt, r.NewReceipt(order))
+}
@@ -0,0 +1,138 @@
+// api/internal/escpos/escpos.go
+package escpos
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/width"
+)
+
+// ESC/POS のコマンド
+// すべて ASCII の範囲なので、Shift_JIS に変換してもそのまま残る
+const (
+	esc = "\x1b"
+	gs  = "\x1d"
+	fs  = "\x1c"
+)
+
+// プリンターを初期化し、漢字モード（Shift_JIS）と日本の国際文字セットを選ぶ
+func Init() string {
+	return esc + "@" + esc + "R\x08" + fs + "&" + fs + "C\x01"
+}
+
+// 文字の倍率（幅・高さとも 1〜8）
+func Size(w, h int) string {
+	return gs + "!" + string(rune(clamp(w)-1)<<4|rune(clamp(h)-1))
+}
+
+func clamp(n int) int {
+	if n < 1 {
+		return 1
+	}
+	if n > 8 {
+		return 8
+	}
+	return n
+}
+
+// 文字揃え（left / center / right）
+func Align(a string) string {
+	switch a {
+	case "center":
+		return esc + "a\x01"
+	case "right":
+		return esc + "a\x02"
+	}
+	return esc + "a\x00"
+}
+
+func Bold(on bool) string {
+	if on {
+		return esc + "E\x01"
+	}
+	return esc + "E\x00"
+}
+
+// n 行送る
+func Feed(n int) string {
+	if n < 0 {
+		n = 0
+	}
+	if n > 255 {
+		n = 255
+	}
+	return esc + "d" + string(rune(n))
+}
+
+// 紙を送ってカットする
+func Cut() string {
+	return gs + "V\x42\x00"
+}
+
+// 表示幅（半角 1、全角 2）
+func Width(s string) int {
+	w := 0
+	for _, r := range s {
+		w += runeWidth(r)
+	}
+	return w
+}
+
+func runeWidth(r rune) int {
+	switch width.LookupRune(r).Kind() {
+	case width.EastAsianWide, width.EastAsianFullwidth, width.EastAsianAmbiguous:
+		return 2
+	}
+	return 1
+}
+
+// 表示幅が cols を超えないように切り詰める
+func Truncate(s string, cols int) string {
+	w := 0
+	for i, r := range s {
+		rw := runeWidth(r)
+		if w+rw > cols {
+			return s[:i]
+		}
+		w += rw
+	}
+	return s
+}
+
+// 表示幅が cols になるまで右に空白を足す（超える場合は切り詰める）
+func PadRight(s string, cols int) string {
+	s = Truncate(s, cols)
+	return s + strings.Repeat(" ", cols-Width(s))
+}
+
+// 表示幅が cols になるまで左に空白を足す（超える場合は切り詰める）
+func PadLeft(s string, cols int) string {
+	s = Truncate(s, cols)
+	return strings.Repeat(" ", cols-Width(s)) + s
+}
+
+// 金額を3桁ごとにカンマで区切る
+func Comma(n int) string {
+	sign := ""
+	if n < 0 {
+		sign = "-"
+		n = -n
+	}
+	s := fmt.Sprint(n)
+	for i := len(s) - 3; i > 0; i -= 3 {
+		s = s[:i] + "," + s[i:]
+	}
+	return sign + s
+}
+
+// Shift_JIS に変換する。変換できない文字は ? にする
+func Encode(s string) ([]byte, error) {
+	if !utf8.ValidString(s) {
+		return nil, fmt.Errorf("invalid UTF-8 text")
+	}
+	return encoding.ReplaceUnsupported(japanese.ShiftJIS.NewEncoder()).Bytes([]byte(s))
+}
@@ -0,0 +1,181 @@
+// api/internal/escpos/escpos_test.go
+package escpos
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"cafeore-pos/api/internal/models"
+	"cafeore-pos/api/internal/payments"
+)
+
+func TestCommands(t *testing.T) {
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{"init", Init(), "\x1b@\x1bR\x08\x1c&\x1cC\x01"},
+		{"size 2x2", Size(2, 2), "\x1d!\x11"},
+		{"size 1x2", Size(1, 2), "\x1d!\x01"},
+		{"size clamped", Size(0, 9), "\x1d!\x07"},
+		{"align center", Align("center"), "\x1ba\x01"},
+		{"align unknown", Align("justify"), "\x1ba\x00"},
+		{"bold", Bold(true) + Bold(false), "\x1bE\x01\x1bE\x00"},
+		{"feed", Feed(3), "\x1bd\x03"},
+		{"feed clamped", Feed(-1), "\x1bd\x00"},
+		{"cut", Cut(), "\x1dVB\x00"},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s = %q, want %q", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
+func TestWidth(t *testing.T) {
+	if w := Width("No.1 ブレンド"); w != 13 {
+		t.Errorf("Width = %d, want 13", w)
+	}
+	// 全角の途中では切らない
+	if s := Truncate("ブレンド", 5); s != "ブレ" {
+		t.Errorf("Truncate = %q", s)
+	}
+	if s := PadRight("ブ", 4); s != "ブ  " {
+		t.Errorf("PadRight = %q", s)
+	}
+	if s := PadLeft("400", 5); s != "  400" {
+		t.Errorf("PadLeft = %q", s)
+	}
+	for n, want := range map[int]string{0: "0", 999: "999", 1000: "1,000", -1234567: "-1,234,567"} {
+		if got := Comma(n); got != want {
+			t.Errorf("Comma(%d) = %s, want %s", n, got, want)
+		}
+	}
+}
+
+func TestEncode(t *testing.T) {
+	got, err := Encode("\x1b@ブ￥")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// コマンドはそのまま、全角は Shift_JIS の2バイトになる
+	if want := []byte{0x1b, '@', 0x83, 0x75, 0x81, 0x8f}; !bytes.Equal(got, want) {
+		t.Errorf("Encode = % x, want % x", got, want)
+	}
+	if _, err := Encode("\xff"); err == nil {
+		t.Error("Encode accepted invalid UTF-8")
+	}
+}
+
+func testOrder() *models.Order {
+	hot := models.ItemType{Name: "hot"}
+	milk := models.ItemType{Name: "milk"}
+	nagai := "ながい"
+	return &models.Order{
+		ID:            uuid.New(),
+		OrderId:       7,
+		CreatedAt:     time.Date(2025, 11, 1, 1, 30, 0, 0, time.UTC),
+		BillingAmount: 1200,
+		Received:      2000,
+		Change:        800,
+		TrackingToken: "TOKEN",
+		OrderItems: []models.OrderItem{
+			{Name: "ブレンド", UnitPrice: 400, Assignee: &nagai, Item: models.Item{ItemType: hot}},
+			{Name: "ペアセット", UnitPrice: 900, Components: models.OrderItemComponents{
+				{Name: "ブレンド", ItemTypeName: "hot", Quantity: 2},
+				{Name: "ミルク", ItemTypeName: "milk", Quantity: 1},
+			}},
+			{Name: "クッキー", UnitPrice: 0, Item: models.Item{ItemType: milk}},
+		},
+		AppliedPromotions: models.OrderPromotions{{Name: "セット割", Amount: 100}},
+		Payments: []models.Payment{
+			{Method: payments.MethodCash, Amount: 1200, Status: string(payments.StatusApproved)},
+			{Method: payments.MethodTerminal, Amount: 1200, Status: string(payments.StatusDeclined)},
+		},
+	}
+}
+
+func TestNewLabels(t *testing.T) {
+	labels := NewLabels(testOrder())
+
+	// 一杯ごとのラベルはコーヒーだけ（セット商品は構成アイテムに展開する）
+	if len(labels.Items) != 3 {
+		t.Fatalf("items = %+v, want 3 coffees", labels.Items)
+	}
+	if l := labels.Items[0]; l.Index != 1 || l.Total != 3 || l.Assignee != "ながい" {
+		t.Errorf("first label = %+v", l)
+	}
+	s := labels.Summary
+	if s.OrderID != 7 || s.Total != 1200 || s.TrackingToken != "TOKEN" || len(s.Assigned) != 1 || len(s.Unassigned) != 4 {
+		t.Errorf("summary = %+v", s)
+	}
+}
+
+func TestRenderReceipt(t *testing.T) {
+	r, err := NewRenderer(Config{Columns: 24, ShopName: "珈琲・俺"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	receipt := r.NewReceipt(testOrder())
+	if receipt.Subtotal != 1300 || len(receipt.Payments) != 1 || receipt.Payments[0].Label != "現金" {
+		t.Errorf("receipt = %+v", receipt)
+	}
+
+	var buf bytes.Buffer
+	if err := r.RenderReceipt(&buf, testOrder()); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.Bytes()
+	for _, want := range []string{
+		Init() + Align("center") + Size(2, 2) + "珈琲・俺\n",
+		// 日本時間で印字する
+		"2025/11/01 10:30\n",
+		"ブレンド" + strings.Repeat(" ", 11) + "￥400\n",
+		"セット割" + strings.Repeat(" ", 10) + "￥-100\n",
+		Bold(true) + Size(1, 2) + "合計" + strings.Repeat(" ", 13) + "￥1,200\n",
+		"お釣り" + strings.Repeat(" ", 13) + "￥800\n",
+		Feed(3) + Cut(),
+	} {
+		encoded, err := Encode(want)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Contains(out, encoded) {
+			t.Errorf("receipt does not contain %q", want)
+		}
+	}
+	// 承認されなかった支払いは載せない
+	if declined, _ := Encode("キャッシュレス"); bytes.Contains(out, declined) {
+		t.Error("receipt contains a declined payment")
+	}
+}
+
+func TestTemplateDir(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, TemplateReceipt), []byte(`{{.OrderID}}{{cut}}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	r, err := NewRenderer(Config{TemplateDir: dir})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := r.RenderReceipt(&buf, testOrder()); err != nil {
+		t.Fatal(err)
+	}
+	if got := buf.String(); got != "7"+Cut() {
+		t.Errorf("receipt = %q", got)
+	}
+	// 置き換えていないテンプレートは埋め込みのものを使う
+	buf.Reset()
+	if err := r.RenderLabels(&buf, testOrder()); err != nil || !bytes.HasPrefix(buf.Bytes(), []byte(Init())) {
+		t.Errorf("labels = %q, %v", buf.String(), err)
+	}
+}
@@ -0,0 +1,154 @@
+// api/internal/escpos/render.go
+package escpos
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+	"time"
+
+	"cafeore-pos/api/internal/jst"
+)
+
+//go:embed templates/*.tmpl
+var defaultTemplates embed.FS
+
+// テンプレートの名前
+const (
+	TemplateLabels  = "labels.tmpl"
+	TemplateItem    = "item.tmpl"
+	TemplateSummary = "summary.tmpl"
+	TemplateReceipt = "receipt.tmpl"
+)
+
+var templateNames = []string{TemplateLabels, TemplateItem, TemplateSummary, TemplateReceipt}
+
+// 印刷の設定
+type Config struct {
+	// テンプレートを置き換えるディレクトリ（同じ名前のファイルがあればそちらを使う）
+	TemplateDir string
+	// レシートの一行の文字数（半角）
+	Columns  int
+	ShopName string
+	Footer   string
+}
+
+// 環境変数から設定を読む
+//
+//	PRINT_TEMPLATE_DIR, RECEIPT_COLUMNS, RECEIPT_SHOP_NAME, RECEIPT_FOOTER
+func ConfigFromEnv() Config {
+	cfg := Config{
+		TemplateDir: os.Getenv("PRINT_TEMPLATE_DIR"),
+		Columns:     48,
+		ShopName:    "珈琲・俺",
+		Footer:      os.Getenv("RECEIPT_FOOTER"),
+	}
+	if v := os.Getenv("RECEIPT_SHOP_NAME"); v != "" {
+		cfg.ShopName = v
+	}
+	var columns int
+	if _, err := fmt.Sscan(os.Getenv("RECEIPT_COLUMNS"), &columns); err == nil && columns > 0 {
+		cfg.Columns = columns
+	}
+	return cfg
+}
+
+// テンプレートから ESC/POS のバイト列を作る
+type Renderer struct {
+	cfg       Config
+	templates *template.Template
+}
+
+func NewRenderer(cfg Config) (*Renderer, error) {
+	if cfg.Columns <= 0 {
+		cfg.Columns = 48
+	}
+	r := &Renderer{cfg: cfg}
+	t := template.New("").Funcs(r.funcs())
+	for _, name := range templateNames {
+		src, err := r.loadTemplate(name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := t.New(name).Parse(src); err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+	}
+	r.templates = t
+	return r, nil
+}
+
+func (r *Renderer) loadTemplate(name string) (string, error) {
+	if r.cfg.TemplateDir != "" {
+		b, err := os.ReadFile(filepath.Join(r.cfg.TemplateDir, name))
+		if err == nil {
+			return string(b), nil
+		}
+		if !errors.Is(err, os.ErrNotExist) {
+			return "", err
+		}
+	}
+	b, err := defaultTemplates.ReadFile("templates/" + name)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func (r *Renderer) funcs() template.FuncMap {
+	return template.FuncMap{
+		"init":    Init,
+		"size":    Size,
+		"align":   Align,
+		"bold":    Bold,
+		"feed":    Feed,
+		"cut":     Cut,
+		"trunc":   Truncate,
+		"pad":     PadRight,
+		"padLeft": PadLeft,
+		"width":   Width,
+		"comma":   Comma,
+		"neg":     func(n int) int { return -n },
+		"yen":     func(n int) string { return "￥" + Comma(n) },
+		"date":    func(t time.Time) string { return t.In(jst.Location).Format("2006/01/02 15:04") },
+		// 左右に分けて一行に収める（左は切り詰める）
+		"row": func(left, right string) string {
+			return PadRight(left, r.cfg.Columns-Width(right)-1) + " " + right
+		},
+		// 区切り線
+		"rule": func() string { return strings.Repeat("-", r.cfg.Columns) },
+		// 二つずつに分ける
+		"pairs": func(s []string) [][]string {
+			var out [][]string
+			for i := 0; i < len(s); i += 2 {
+				out = append(out, s[i:min(i+2, len(s))])
+			}
+			return out
+		},
+	}
+}
+
+// テンプレート name を data で実行し、Shift_JIS に変換して w に書き出す
+func (r *Renderer) Render(w io.Writer, name string, data interface{}) error {
+	var buf bytes.Buffer
+	if err := r.templates.ExecuteTemplate(&buf, name, data); err != nil {
+		return err
+	}
+	b, err := Encode(buf.String())
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(b)
+	return err
+}
+
+// レシートに載せる店名などの設定
+func (r *Renderer) Config() Config {
+	return r.cfg
+}
@@ -0,0 +1,230 @@
+// api/internal/export/export.go
+package export
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// 出力するデータの種類
+type Dataset string
+
+const (
+	DatasetOrders       Dataset = "orders"
+	DatasetOrderItems   Dataset = "order-items"
+	DatasetComments     Dataset = "comments"
+	DatasetMasterStates Dataset = "master-states"
+)
+
+// 出力形式
+type Format string
+
+const (
+	FormatCSV    Format = "csv"
+	FormatNDJSON Format = "ndjson"
+	FormatXLSX   Format = "xlsx"
+)
+
+var (
+	ErrUnknownDataset = errors.New("Unknown dataset")
+	ErrUnknownFormat  = errors.New("Unknown format")
+)
+
+// 出力対象の条件（未指定の条件では絞り込まない）
+type Filter struct {
+	SessionID *uuid.UUID
+	From      *time.Time
+	To        *time.Time
+}
+
+// table の session_id と created_at に対する WHERE 句
+func (f Filter) where(table string) (string, []interface{}) {
+	conds := []string{"TRUE"}
+	args := []interface{}{}
+	if f.SessionID != nil {
+		conds = append(conds, table+".session_id = ?")
+		args = append(args, *f.SessionID)
+	}
+	if f.From != nil {
+		conds = append(conds, table+".created_at >= ?")
+		args = append(args, *f.From)
+	}
+	if f.To != nil {
+		conds = append(conds, table+".created_at < ?")
+		args = append(args, *f.To)
+	}
+	return strings.Join(conds, " AND "), args
+}
+
+type dataset struct {
+	columns []string
+	// 絞り込みに使うテーブル
+	table string
+	// %s に WHERE 句が入る。UUID は文字列にして返す
+	query string
+}
+
+var datasets = map[Dataset]dataset{
+	DatasetOrders: {
+		columns: []string{"id", "session_id", "order_id", "register", "created_at", "ready_at", "served_at", "billing_amount", "received", "change", "discount_order_id", "discount_order_cups", "discount", "item_count", "refunded_amount"},
+		table:   "orders",
+		query: `
+SELECT
+	orders.id::text, orders.session_id::text, orders.order_id, orders.register,
+	orders.created_at, orders.ready_at, orders.served_at,
+	orders.billing_amount, orders.received, orders.change,
+	COALESCE(orders.discount_order_id, 0), COALESCE(orders.discount_order_cups, 0), orders.discount,
+	(SELECT COUNT(*) FROM order_items WHERE order_items.order_id = orders.id),
+	(SELECT COALESCE(SUM(refunds.amount), 0) FROM refunds WHERE refunds.order_id = orders.id)
+FROM orders
+WHERE %s
+ORDER BY orders.created_at`,
+	},
+	DatasetOrderItems: {
+		columns: []string{"order_uuid", "order_id", "created_at", "item_id", "item_name", "item_abbr", "item_key", "item_type", "unit_price", "assignee"},
+		table:   "orders",
+		query: `
+SELECT
+	orders.id::text, orders.order_id, orders.created_at,
+	items.id::text, order_items.name, order_items.abbr, items.key, item_types.name,
+	order_items.unit_price, order_items.assignee
+FROM order_items
+JOIN orders ON orders.id = order_items.order_id
+JOIN items ON items.id = order_items.item_id
+JOIN item_types ON item_types.id = items.item_type_id
+WHERE %s
+ORDER BY orders.created_at, orders.id`,
+	},
+	DatasetComments: {
+		columns: []string{"order_uuid", "order_id", "author", "text", "created_at"},
+		table:   "orders",
+		query: `
+SELECT
+	orders.id::text, orders.order_id, comments.author, comments.text, comments.created_at
+FROM comments
+JOIN orders ON orders.id = comments.order_id
+WHERE %s
+ORDER BY orders.created_at, comments.created_at`,
+	},
+	DatasetMasterStates: {
+		columns: []string{"created_at", "type", "session_id"},
+		table:   "master_states",
+		query: `
+SELECT master_states.created_at, master_states.type, master_states.session_id::text
+FROM master_states
+WHERE %s
+ORDER BY master_states.created_at`,
+	},
+}
+
+func ParseDataset(s string) (Dataset, error) {
+	d := Dataset(s)
+	if _, ok := datasets[d]; !ok {
+		return "", ErrUnknownDataset
+	}
+	return d, nil
+}
+
+func ParseFormat(s string) (Format, error) {
+	switch f := Format(s); f {
+	case FormatCSV, FormatNDJSON, FormatXLSX:
+		return f, nil
+	}
+	return "", ErrUnknownFormat
+}
+
+// 形式ごとの Content-Type
+func (f Format) ContentType() string {
+	switch f {
+	case FormatCSV:
+		return "text/csv; charset=utf-8"
+	case FormatNDJSON:
+		return "application/x-ndjson"
+	case FormatXLSX:
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+	return "application/octet-stream"
+}
+
+// 形式ごとのファイル名
+func Filename(d Dataset, f Format) string {
+	return fmt.Sprintf("%s.%s", d, f)
+}
+
+// 1行ずつ書き出す
+type rowWriter interface {
+	WriteRow(values []interface{}) error
+	Close() error
+}
+
+func newRowWriter(w io.Writer, f Format, sheet string, columns []string) (rowWriter, error) {
+	switch f {
+	case FormatCSV:
+		return newCSVWriter(w, columns)
+	case FormatNDJSON:
+		return newNDJSONWriter(w, columns), nil
+	case FormatXLSX:
+		return newXLSXWriter(w, sheet, columns)
+	}
+	return nil, ErrUnknownFormat
+}
+
+// データを w に書き出す
+// 行はカーソルで1行ずつ読み出すので、件数が多くてもメモリ使用量は増えない
+func Write(ctx context.Context, db *gorm.DB, w io.Writer, d Dataset, f Format, filter Filter) error {
+	ds, ok := datasets[d]
+	if !ok {
+		return ErrUnknownDataset
+	}
+	where, args := filter.where(ds.table)
+
+	rows, err := db.WithContext(ctx).Raw(fmt.Sprintf(ds.query, where), args...).Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	out, err := newRowWriter(w, f, string(d), ds.columns)
+	if err != nil {
+		return err
+	}
+
+	values := make([]interface{}, len(ds.columns))
+	dest := make([]interface{}, len(ds.columns))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	for rows.Next() {
+		if err := rows.Scan(dest...); err != nil {
+			return err
+		}
+		if err := out.WriteRow(values); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	return out.Close()
+}
+
+// 読み込み済みの行を w に書き出す（sheet は xlsx のシート名）
+func WriteRows(w io.Writer, f Format, sheet string, columns []string, rows [][]interface{}) error {
+	out, err := newRowWriter(w, f, sheet, columns)
+	if err != nil {
+		return err
+	}
+	for _, row := range rows {
+		if err := out.WriteRow(row); err != nil {
+			return err
+		}
+	}
+	return out.Close()
+}
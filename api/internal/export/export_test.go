@@ -0,0 +1,117 @@
+// api/internal/export/export_test.go
+package export
+
+import (
+	"archive/zip"
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	testColumns = []string{"order_id", "name", "created_at", "served_at"}
+	testRows    = [][]interface{}{
+		{int64(1), "ブレンド, ホット", time.Date(2025, 11, 1, 10, 0, 0, 0, time.UTC), nil},
+		{int64(2), []byte(`"<アイス>"`), time.Date(2025, 11, 1, 10, 5, 0, 0, time.UTC), time.Date(2025, 11, 1, 10, 9, 0, 0, time.UTC)},
+	}
+)
+
+func TestWriteRowsCSV(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteRows(&buf, FormatCSV, "orders", testColumns, testRows); err != nil {
+		t.Fatal(err)
+	}
+	want := `order_id,name,created_at,served_at
+1,"ブレンド, ホット",2025-11-01T10:00:00Z,
+2,"""<アイス>""",2025-11-01T10:05:00Z,2025-11-01T10:09:00Z
+`
+	if buf.String() != want {
+		t.Errorf("csv =\n%s\nwant\n%s", buf.String(), want)
+	}
+}
+
+func TestWriteRowsNDJSON(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteRows(&buf, FormatNDJSON, "orders", testColumns, testRows); err != nil {
+		t.Fatal(err)
+	}
+	want := `{"order_id":1,"name":"ブレンド, ホット","created_at":"2025-11-01T10:00:00Z","served_at":null}
+{"order_id":2,"name":"\"\u003cアイス\u003e\"","created_at":"2025-11-01T10:05:00Z","served_at":"2025-11-01T10:09:00Z"}
+`
+	if buf.String() != want {
+		t.Errorf("ndjson =\n%s\nwant\n%s", buf.String(), want)
+	}
+}
+
+func TestWriteRowsXLSX(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteRows(&buf, FormatXLSX, "order-items", testColumns, testRows); err != nil {
+		t.Fatal(err)
+	}
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := map[string]string{}
+	for _, f := range zr.File {
+		r, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, err := io.ReadAll(r)
+		r.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		files[f.Name] = string(body)
+	}
+
+	if !strings.Contains(files["xl/workbook.xml"], `<sheet name="order-items"`) {
+		t.Errorf("workbook = %s", files["xl/workbook.xml"])
+	}
+	sheet := files["xl/worksheets/sheet1.xml"]
+	for _, want := range []string{
+		`<row r="1"><c t="inlineStr"><is><t xml:space="preserve">order_id</t></is></c>`,
+		`<row r="2"><c t="n"><v>1</v></c>`,
+		// 文字列はエスケープし、NULL は空のセルにする
+		`<t xml:space="preserve">&#34;&lt;アイス&gt;&#34;</t>`,
+		`2025-11-01T10:00:00Z</t></is></c><c/></row>`,
+		`</sheetData></worksheet>`,
+	} {
+		if !strings.Contains(sheet, want) {
+			t.Errorf("sheet does not contain %s:\n%s", want, sheet)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	if d, err := ParseDataset("order-items"); err != nil || d != DatasetOrderItems {
+		t.Errorf("ParseDataset(order-items) = %q, %v", d, err)
+	}
+	if _, err := ParseDataset("payments"); !errors.Is(err, ErrUnknownDataset) {
+		t.Errorf("ParseDataset(payments) err = %v, want ErrUnknownDataset", err)
+	}
+	if _, err := ParseFormat("pdf"); !errors.Is(err, ErrUnknownFormat) {
+		t.Errorf("ParseFormat(pdf) err = %v, want ErrUnknownFormat", err)
+	}
+	if got := Filename(DatasetOrders, FormatXLSX); got != "orders.xlsx" {
+		t.Errorf("Filename = %s", got)
+	}
+}
+
+func TestFilterWhere(t *testing.T) {
+	sessionID := uuid.New()
+	to := time.Date(2025, 11, 2, 0, 0, 0, 0, time.UTC)
+	where, args := Filter{SessionID: &sessionID, To: &to}.where("master_states")
+	if where != "TRUE AND master_states.session_id = ? AND master_states.created_at < ?" {
+		t.Errorf("where = %q", where)
+	}
+	if len(args) != 2 || args[0] != sessionID || args[1] != to {
+		t.Errorf("args = %v", args)
+	}
+}
@@ -0,0 +1,94 @@
+// api/internal/export/writer.go
+package export
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+// セルの値を文字列にする（NULL は空文字）
+func formatValue(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case time.Time:
+		return v.Format(time.RFC3339)
+	case []byte:
+		return string(v)
+	case string:
+		return v
+	case int64:
+		return strconv.FormatInt(v, 10)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+type csvWriter struct {
+	w   *csv.Writer
+	row []string
+}
+
+func newCSVWriter(w io.Writer, columns []string) (*csvWriter, error) {
+	cw := &csvWriter{w: csv.NewWriter(w), row: make([]string, len(columns))}
+	if err := cw.w.Write(columns); err != nil {
+		return nil, err
+	}
+	return cw, nil
+}
+
+func (cw *csvWriter) WriteRow(values []interface{}) error {
+	for i, v := range values {
+		cw.row[i] = formatValue(v)
+	}
+	return cw.w.Write(cw.row)
+}
+
+func (cw *csvWriter) Close() error {
+	cw.w.Flush()
+	return cw.w.Error()
+}
+
+// 1行を1つの JSON オブジェクトとして書き出す（キーは列の順）
+type ndjsonWriter struct {
+	w       *bufio.Writer
+	columns [][]byte
+}
+
+func newNDJSONWriter(w io.Writer, columns []string) *ndjsonWriter {
+	keys := make([][]byte, len(columns))
+	for i, c := range columns {
+		keys[i], _ = json.Marshal(c)
+	}
+	return &ndjsonWriter{w: bufio.NewWriter(w), columns: keys}
+}
+
+func (nw *ndjsonWriter) WriteRow(values []interface{}) error {
+	nw.w.WriteByte('{')
+	for i, v := range values {
+		if i > 0 {
+			nw.w.WriteByte(',')
+		}
+		nw.w.Write(nw.columns[i])
+		nw.w.WriteByte(':')
+		if b, ok := v.([]byte); ok {
+			v = string(b)
+		}
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		nw.w.Write(encoded)
+	}
+	nw.w.WriteByte('}')
+	return nw.w.WriteByte('\n')
+}
+
+func (nw *ndjsonWriter) Close() error {
+	return nw.w.Flush()
+}
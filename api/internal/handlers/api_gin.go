@@ -4,9 +4,18 @@
 package handlers
 
 import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
 	"fmt"
 	"net/http"
+	"net/url"
+	"path"
+	"strings"
 
+	. "cafeore-pos/api/internal/models"
+
+	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/gin-gonic/gin"
 	"github.com/oapi-codegen/runtime"
 	openapi_types "github.com/oapi-codegen/runtime/types"
@@ -14,6 +23,36 @@ import (
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// 呼び出し画面の表示内容取得
+	// (GET /api/callscreen)
+	GetCallscreen(c *gin.Context, params GetCallscreenParams)
+	// 締め処理レポート一覧取得
+	// (GET /api/cash/closeouts)
+	GetCashCloseouts(c *gin.Context, params GetCashCloseoutsParams)
+	// 締め処理（金種別の実査と差異の記録）
+	// (POST /api/cash/closeouts)
+	CreateCashCloseout(c *gin.Context)
+	// idから締め処理レポート取得
+	// (GET /api/cash/closeouts/{id})
+	GetCashCloseout(c *gin.Context, id openapi_types.UUID)
+	// レジ内の金種別在庫取得
+	// (GET /api/cash/drawer)
+	GetDrawerStock(c *gin.Context, params GetDrawerStockParams)
+	// レジ内の金種別在庫を登録（実査・補充）
+	// (PUT /api/cash/drawer)
+	UpdateDrawerStock(c *gin.Context)
+	// 入出金一覧取得
+	// (GET /api/cash/movements)
+	GetCashMovements(c *gin.Context, params GetCashMovementsParams)
+	// 入出金登録
+	// (POST /api/cash/movements)
+	CreateCashMovement(c *gin.Context)
+	// レジごとの現金集計取得
+	// (GET /api/cash/summary)
+	GetCashSummary(c *gin.Context, params GetCashSummaryParams)
+	// オーダー・アイテム・コメント・マスターステート履歴のエクスポート
+	// (GET /api/export/{dataset})
+	ExportDataset(c *gin.Context, dataset ExportDatasetParamsDataset, params ExportDatasetParams)
 	// アイテムタイプ一覧取得
 	// (GET /api/item-types)
 	GetItemTypes(c *gin.Context)
@@ -44,15 +83,42 @@ type ServerInterface interface {
 	// アイテム情報更新
 	// (PUT /api/items/{id})
 	UpdateItem(c *gin.Context, id openapi_types.UUID)
+	// アイテムで選べる選択肢グループ取得
+	// (GET /api/items/{id}/modifier-groups)
+	GetItemModifierGroups(c *gin.Context, id openapi_types.UUID)
+	// アイテムの価格履歴・予定取得
+	// (GET /api/items/{id}/prices)
+	GetItemPrices(c *gin.Context, id openapi_types.UUID)
+	// 価格変更の登録
+	// (POST /api/items/{id}/prices)
+	CreateItemPrice(c *gin.Context, id openapi_types.UUID)
+	// 予定された価格変更の取り消し
+	// (DELETE /api/items/{id}/prices/{price_id})
+	DeleteItemPrice(c *gin.Context, id openapi_types.UUID, priceId openapi_types.UUID)
 	// マスターステート取得
 	// (GET /api/master-status)
-	GetMasterState(c *gin.Context)
+	GetMasterStatus(c *gin.Context, params GetMasterStatusParams)
 	// マスターステート更新
 	// (POST /api/master-status)
-	UpdateMasterState(c *gin.Context)
+	UpdateMasterStatus(c *gin.Context)
+	// 選択肢グループ一覧取得
+	// (GET /api/modifier-groups)
+	GetModifierGroups(c *gin.Context)
+	// 選択肢グループ作成
+	// (POST /api/modifier-groups)
+	CreateModifierGroup(c *gin.Context)
+	// 選択肢グループ削除
+	// (DELETE /api/modifier-groups/{id})
+	DeleteModifierGroup(c *gin.Context, id openapi_types.UUID)
+	// 選択肢グループ取得
+	// (GET /api/modifier-groups/{id})
+	GetModifierGroup(c *gin.Context, id openapi_types.UUID)
+	// 選択肢グループ更新
+	// (PUT /api/modifier-groups/{id})
+	UpdateModifierGroup(c *gin.Context, id openapi_types.UUID)
 	// オーダー一覧取得
 	// (GET /api/orders)
-	GetOrders(c *gin.Context)
+	GetOrders(c *gin.Context, params GetOrdersParams)
 	// オーダー作成
 	// (POST /api/orders)
 	CreateOrder(c *gin.Context)
@@ -65,18 +131,132 @@ type ServerInterface interface {
 	// オーダー情報更新
 	// (PUT /api/orders/{id})
 	UpdateOrder(c *gin.Context, id openapi_types.UUID)
+	// オーダーを呼び出す
+	// (POST /api/orders/{id}/call)
+	CallOrder(c *gin.Context, id openapi_types.UUID)
 	// 特定オーダーのコメント一覧取得
 	// (GET /api/orders/{id}/comments)
 	GetOrderComments(c *gin.Context, id openapi_types.UUID)
 	// オーダーにコメント追加
 	// (POST /api/orders/{id}/comments)
 	CreateOrderComment(c *gin.Context, id openapi_types.UUID)
+	// ラベル・レシートの印刷データ取得
+	// (GET /api/orders/{id}/labels)
+	GetOrderLabels(c *gin.Context, id openapi_types.UUID, params GetOrderLabelsParams)
+	// 特定オーダーの支払い一覧取得
+	// (GET /api/orders/{id}/payments)
+	GetOrderPayments(c *gin.Context, id openapi_types.UUID)
 	// オーダーを準備完了にする
 	// (PATCH /api/orders/{id}/ready)
 	MarkOrderReady(c *gin.Context, id openapi_types.UUID)
+	// オーダーを再呼び出しする
+	// (POST /api/orders/{id}/recall)
+	RecallOrder(c *gin.Context, id openapi_types.UUID)
+	// 領収書の発行
+	// (GET /api/orders/{id}/receipt)
+	GetOrderReceipt(c *gin.Context, id openapi_types.UUID, params GetOrderReceiptParams)
+	// 特定オーダーの返金・作り直し一覧取得
+	// (GET /api/orders/{id}/refunds)
+	GetOrderRefunds(c *gin.Context, id openapi_types.UUID)
+	// 返金・作り直しの記録
+	// (POST /api/orders/{id}/refunds)
+	CreateOrderRefund(c *gin.Context, id openapi_types.UUID)
 	// オーダーを提供完了にする
 	// (PATCH /api/orders/{id}/served)
-	MarkOrderServe(c *gin.Context, id openapi_types.UUID)
+	MarkOrderServed(c *gin.Context, id openapi_types.UUID)
+	// 利用できる決済手段の一覧取得
+	// (GET /api/payment-methods)
+	GetPaymentMethods(c *gin.Context)
+	// 印刷ジョブ一覧取得
+	// (GET /api/print-jobs)
+	GetPrintJobs(c *gin.Context, params GetPrintJobsParams)
+	// 再印刷
+	// (POST /api/print-jobs)
+	CreatePrintJob(c *gin.Context)
+	// 印刷ジョブ取得
+	// (GET /api/print-jobs/{id})
+	GetPrintJob(c *gin.Context, id openapi_types.UUID)
+	// 失敗した印刷ジョブの再試行
+	// (POST /api/print-jobs/{id}/retry)
+	RetryPrintJob(c *gin.Context, id openapi_types.UUID)
+	// 割引ルール一覧取得
+	// (GET /api/promotions)
+	GetPromotions(c *gin.Context)
+	// 割引ルール作成
+	// (POST /api/promotions)
+	CreatePromotion(c *gin.Context)
+	// 割引の計算
+	// (POST /api/promotions/evaluate)
+	EvaluatePromotions(c *gin.Context)
+	// 割引ルール削除
+	// (DELETE /api/promotions/{id})
+	DeletePromotion(c *gin.Context, id openapi_types.UUID)
+	// 割引ルール取得
+	// (GET /api/promotions/{id})
+	GetPromotion(c *gin.Context, id openapi_types.UUID)
+	// 割引ルール更新
+	// (PUT /api/promotions/{id})
+	UpdatePromotion(c *gin.Context, id openapi_types.UUID)
+	// 提供待ちの列の取得
+	// (GET /api/queue)
+	GetQueue(c *gin.Context, params GetQueueParams)
+	// 返金・作り直し一覧取得
+	// (GET /api/refunds)
+	GetRefunds(c *gin.Context, params GetRefundsParams)
+	// アイテム種別ごとの売上取得
+	// (GET /api/reports/item-types)
+	GetItemTypeSalesReport(c *gin.Context, params GetItemTypeSalesReportParams)
+	// アイテム別売上取得
+	// (GET /api/reports/items)
+	GetItemSalesReport(c *gin.Context, params GetItemSalesReportParams)
+	// 売上サマリー取得
+	// (GET /api/reports/summary)
+	GetSalesSummaryReport(c *gin.Context, params GetSalesSummaryReportParams)
+	// 提供時間の統計取得
+	// (GET /api/reports/throughput)
+	GetThroughputReport(c *gin.Context, params GetThroughputReportParams)
+	// 時間帯別売上取得
+	// (GET /api/reports/timeline)
+	GetSalesTimelineReport(c *gin.Context, params GetSalesTimelineReportParams)
+	// セッション一覧取得
+	// (GET /api/sessions)
+	GetSessions(c *gin.Context)
+	// セッション開始
+	// (POST /api/sessions)
+	OpenSession(c *gin.Context)
+	// 営業中のセッション取得
+	// (GET /api/sessions/current)
+	GetCurrentSession(c *gin.Context)
+	// idからセッション情報取得
+	// (GET /api/sessions/{id})
+	GetSession(c *gin.Context, id openapi_types.UUID)
+	// セッション終了
+	// (PATCH /api/sessions/{id}/close)
+	CloseSession(c *gin.Context, id openapi_types.UUID)
+	// お客様向けの注文状況取得
+	// (GET /api/track/{order_number})
+	TrackOrder(c *gin.Context, orderNumber int, params TrackOrderParams)
+	// お客様向けの注文状況の購読
+	// (GET /api/track/{order_number}/events)
+	TrackOrderEvents(c *gin.Context, orderNumber int, params TrackOrderEventsParams)
+	// クーポンのバッチ一覧取得
+	// (GET /api/voucher-batches)
+	GetVoucherBatches(c *gin.Context)
+	// クーポンのバッチ作成
+	// (POST /api/voucher-batches)
+	CreateVoucherBatch(c *gin.Context)
+	// クーポンのバッチ取得
+	// (GET /api/voucher-batches/{id})
+	GetVoucherBatch(c *gin.Context, id openapi_types.UUID)
+	// クーポンコードのエクスポート
+	// (GET /api/voucher-batches/{id}/export)
+	ExportVoucherBatch(c *gin.Context, id openapi_types.UUID, params ExportVoucherBatchParams)
+	// バッチのクーポン一覧取得
+	// (GET /api/voucher-batches/{id}/vouchers)
+	GetBatchVouchers(c *gin.Context, id openapi_types.UUID)
+	// クーポンコードの確認
+	// (GET /api/vouchers/{code})
+	GetVoucher(c *gin.Context, code string)
 	// サーバーステータス取得
 	// (GET /status)
 	GetStatus(c *gin.Context)
@@ -91,8 +271,21 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(c *gin.Context)
 
-// GetItemTypes operation middleware
-func (siw *ServerInterfaceWrapper) GetItemTypes(c *gin.Context) {
+// GetCallscreen operation middleware
+func (siw *ServerInterfaceWrapper) GetCallscreen(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCallscreenParams
+
+	// ------------- Optional query parameter "session_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "session_id", c.Request.URL.Query(), &params.SessionId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter session_id: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -101,11 +294,24 @@ func (siw *ServerInterfaceWrapper) GetItemTypes(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetItemTypes(c)
+	siw.Handler.GetCallscreen(c, params)
 }
 
-// CreateItemType operation middleware
-func (siw *ServerInterfaceWrapper) CreateItemType(c *gin.Context) {
+// GetCashCloseouts operation middleware
+func (siw *ServerInterfaceWrapper) GetCashCloseouts(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCashCloseoutsParams
+
+	// ------------- Optional query parameter "session_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "session_id", c.Request.URL.Query(), &params.SessionId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter session_id: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -114,11 +320,24 @@ func (siw *ServerInterfaceWrapper) CreateItemType(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.CreateItemType(c)
+	siw.Handler.GetCashCloseouts(c, params)
 }
 
-// DeleteItemType operation middleware
-func (siw *ServerInterfaceWrapper) DeleteItemType(c *gin.Context) {
+// CreateCashCloseout operation middleware
+func (siw *ServerInterfaceWrapper) CreateCashCloseout(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateCashCloseout(c)
+}
+
+// GetCashCloseout operation middleware
+func (siw *ServerInterfaceWrapper) GetCashCloseout(c *gin.Context) {
 
 	var err error
 
@@ -138,20 +357,30 @@ func (siw *ServerInterfaceWrapper) DeleteItemType(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeleteItemType(c, id)
+	siw.Handler.GetCashCloseout(c, id)
 }
 
-// GetItemType operation middleware
-func (siw *ServerInterfaceWrapper) GetItemType(c *gin.Context) {
+// GetDrawerStock operation middleware
+func (siw *ServerInterfaceWrapper) GetDrawerStock(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDrawerStockParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	// ------------- Optional query parameter "session_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "session_id", c.Request.URL.Query(), &params.SessionId)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter session_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "register" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "register", c.Request.URL.Query(), &params.Register)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter register: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -162,20 +391,43 @@ func (siw *ServerInterfaceWrapper) GetItemType(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetItemType(c, id)
+	siw.Handler.GetDrawerStock(c, params)
 }
 
-// UpdateItemType operation middleware
-func (siw *ServerInterfaceWrapper) UpdateItemType(c *gin.Context) {
+// UpdateDrawerStock operation middleware
+func (siw *ServerInterfaceWrapper) UpdateDrawerStock(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateDrawerStock(c)
+}
+
+// GetCashMovements operation middleware
+func (siw *ServerInterfaceWrapper) GetCashMovements(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCashMovementsParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	// ------------- Optional query parameter "session_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "session_id", c.Request.URL.Query(), &params.SessionId)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter session_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "register" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "register", c.Request.URL.Query(), &params.Register)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter register: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -186,11 +438,11 @@ func (siw *ServerInterfaceWrapper) UpdateItemType(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.UpdateItemType(c, id)
+	siw.Handler.GetCashMovements(c, params)
 }
 
-// GetItems operation middleware
-func (siw *ServerInterfaceWrapper) GetItems(c *gin.Context) {
+// CreateCashMovement operation middleware
+func (siw *ServerInterfaceWrapper) CreateCashMovement(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -199,11 +451,32 @@ func (siw *ServerInterfaceWrapper) GetItems(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetItems(c)
+	siw.Handler.CreateCashMovement(c)
 }
 
-// CreateItem operation middleware
-func (siw *ServerInterfaceWrapper) CreateItem(c *gin.Context) {
+// GetCashSummary operation middleware
+func (siw *ServerInterfaceWrapper) GetCashSummary(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCashSummaryParams
+
+	// ------------- Optional query parameter "session_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "session_id", c.Request.URL.Query(), &params.SessionId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter session_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "register" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "register", c.Request.URL.Query(), &params.Register)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter register: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -212,20 +485,55 @@ func (siw *ServerInterfaceWrapper) CreateItem(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.CreateItem(c)
+	siw.Handler.GetCashSummary(c, params)
 }
 
-// DeleteItem operation middleware
-func (siw *ServerInterfaceWrapper) DeleteItem(c *gin.Context) {
+// ExportDataset operation middleware
+func (siw *ServerInterfaceWrapper) ExportDataset(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
+	// ------------- Path parameter "dataset" -------------
+	var dataset ExportDatasetParamsDataset
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "dataset", c.Param("dataset"), &dataset, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter dataset: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportDatasetParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", c.Request.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter format: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "session_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "session_id", c.Request.URL.Query(), &params.SessionId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter session_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -236,11 +544,37 @@ func (siw *ServerInterfaceWrapper) DeleteItem(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeleteItem(c, id)
+	siw.Handler.ExportDataset(c, dataset, params)
 }
 
-// GetItem operation middleware
-func (siw *ServerInterfaceWrapper) GetItem(c *gin.Context) {
+// GetItemTypes operation middleware
+func (siw *ServerInterfaceWrapper) GetItemTypes(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetItemTypes(c)
+}
+
+// CreateItemType operation middleware
+func (siw *ServerInterfaceWrapper) CreateItemType(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateItemType(c)
+}
+
+// DeleteItemType operation middleware
+func (siw *ServerInterfaceWrapper) DeleteItemType(c *gin.Context) {
 
 	var err error
 
@@ -260,11 +594,11 @@ func (siw *ServerInterfaceWrapper) GetItem(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetItem(c, id)
+	siw.Handler.DeleteItemType(c, id)
 }
 
-// UpdateItem operation middleware
-func (siw *ServerInterfaceWrapper) UpdateItem(c *gin.Context) {
+// GetItemType operation middleware
+func (siw *ServerInterfaceWrapper) GetItemType(c *gin.Context) {
 
 	var err error
 
@@ -284,11 +618,22 @@ func (siw *ServerInterfaceWrapper) UpdateItem(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.UpdateItem(c, id)
+	siw.Handler.GetItemType(c, id)
 }
 
-// GetMasterState operation middleware
-func (siw *ServerInterfaceWrapper) GetMasterState(c *gin.Context) {
+// UpdateItemType operation middleware
+func (siw *ServerInterfaceWrapper) UpdateItemType(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -297,11 +642,11 @@ func (siw *ServerInterfaceWrapper) GetMasterState(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetMasterState(c)
+	siw.Handler.UpdateItemType(c, id)
 }
 
-// UpdateMasterState operation middleware
-func (siw *ServerInterfaceWrapper) UpdateMasterState(c *gin.Context) {
+// GetItems operation middleware
+func (siw *ServerInterfaceWrapper) GetItems(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -310,11 +655,11 @@ func (siw *ServerInterfaceWrapper) UpdateMasterState(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.UpdateMasterState(c)
+	siw.Handler.GetItems(c)
 }
 
-// GetOrders operation middleware
-func (siw *ServerInterfaceWrapper) GetOrders(c *gin.Context) {
+// CreateItem operation middleware
+func (siw *ServerInterfaceWrapper) CreateItem(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -323,11 +668,22 @@ func (siw *ServerInterfaceWrapper) GetOrders(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetOrders(c)
+	siw.Handler.CreateItem(c)
 }
 
-// CreateOrder operation middleware
-func (siw *ServerInterfaceWrapper) CreateOrder(c *gin.Context) {
+// DeleteItem operation middleware
+func (siw *ServerInterfaceWrapper) DeleteItem(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -336,11 +692,11 @@ func (siw *ServerInterfaceWrapper) CreateOrder(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.CreateOrder(c)
+	siw.Handler.DeleteItem(c, id)
 }
 
-// DeleteOrder operation middleware
-func (siw *ServerInterfaceWrapper) DeleteOrder(c *gin.Context) {
+// GetItem operation middleware
+func (siw *ServerInterfaceWrapper) GetItem(c *gin.Context) {
 
 	var err error
 
@@ -360,11 +716,11 @@ func (siw *ServerInterfaceWrapper) DeleteOrder(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeleteOrder(c, id)
+	siw.Handler.GetItem(c, id)
 }
 
-// GetOrder operation middleware
-func (siw *ServerInterfaceWrapper) GetOrder(c *gin.Context) {
+// UpdateItem operation middleware
+func (siw *ServerInterfaceWrapper) UpdateItem(c *gin.Context) {
 
 	var err error
 
@@ -384,11 +740,11 @@ func (siw *ServerInterfaceWrapper) GetOrder(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetOrder(c, id)
+	siw.Handler.UpdateItem(c, id)
 }
 
-// UpdateOrder operation middleware
-func (siw *ServerInterfaceWrapper) UpdateOrder(c *gin.Context) {
+// GetItemModifierGroups operation middleware
+func (siw *ServerInterfaceWrapper) GetItemModifierGroups(c *gin.Context) {
 
 	var err error
 
@@ -408,11 +764,11 @@ func (siw *ServerInterfaceWrapper) UpdateOrder(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.UpdateOrder(c, id)
+	siw.Handler.GetItemModifierGroups(c, id)
 }
 
-// GetOrderComments operation middleware
-func (siw *ServerInterfaceWrapper) GetOrderComments(c *gin.Context) {
+// GetItemPrices operation middleware
+func (siw *ServerInterfaceWrapper) GetItemPrices(c *gin.Context) {
 
 	var err error
 
@@ -432,11 +788,11 @@ func (siw *ServerInterfaceWrapper) GetOrderComments(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetOrderComments(c, id)
+	siw.Handler.GetItemPrices(c, id)
 }
 
-// CreateOrderComment operation middleware
-func (siw *ServerInterfaceWrapper) CreateOrderComment(c *gin.Context) {
+// CreateItemPrice operation middleware
+func (siw *ServerInterfaceWrapper) CreateItemPrice(c *gin.Context) {
 
 	var err error
 
@@ -456,11 +812,11 @@ func (siw *ServerInterfaceWrapper) CreateOrderComment(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.CreateOrderComment(c, id)
+	siw.Handler.CreateItemPrice(c, id)
 }
 
-// MarkOrderReady operation middleware
-func (siw *ServerInterfaceWrapper) MarkOrderReady(c *gin.Context) {
+// DeleteItemPrice operation middleware
+func (siw *ServerInterfaceWrapper) DeleteItemPrice(c *gin.Context) {
 
 	var err error
 
@@ -473,6 +829,15 @@ func (siw *ServerInterfaceWrapper) MarkOrderReady(c *gin.Context) {
 		return
 	}
 
+	// ------------- Path parameter "price_id" -------------
+	var priceId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "price_id", c.Param("price_id"), &priceId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter price_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -480,20 +845,22 @@ func (siw *ServerInterfaceWrapper) MarkOrderReady(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.MarkOrderReady(c, id)
+	siw.Handler.DeleteItemPrice(c, id, priceId)
 }
 
-// MarkOrderServe operation middleware
-func (siw *ServerInterfaceWrapper) MarkOrderServe(c *gin.Context) {
+// GetMasterStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetMasterStatus(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetMasterStatusParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	// ------------- Optional query parameter "session_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "session_id", c.Request.URL.Query(), &params.SessionId)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter session_id: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -504,11 +871,11 @@ func (siw *ServerInterfaceWrapper) MarkOrderServe(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.MarkOrderServe(c, id)
+	siw.Handler.GetMasterStatus(c, params)
 }
 
-// GetStatus operation middleware
-func (siw *ServerInterfaceWrapper) GetStatus(c *gin.Context) {
+// UpdateMasterStatus operation middleware
+func (siw *ServerInterfaceWrapper) UpdateMasterStatus(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -517,36 +884,1371 @@ func (siw *ServerInterfaceWrapper) GetStatus(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetStatus(c)
-}
-
-// GinServerOptions provides options for the Gin server.
-type GinServerOptions struct {
-	BaseURL      string
-	Middlewares  []MiddlewareFunc
-	ErrorHandler func(*gin.Context, error, int)
+	siw.Handler.UpdateMasterStatus(c)
 }
 
-// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
-func RegisterHandlers(router gin.IRouter, si ServerInterface) {
-	RegisterHandlersWithOptions(router, si, GinServerOptions{})
-}
+// GetModifierGroups operation middleware
+func (siw *ServerInterfaceWrapper) GetModifierGroups(c *gin.Context) {
 
-// RegisterHandlersWithOptions creates http.Handler with additional options
-func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
-	errorHandler := options.ErrorHandler
-	if errorHandler == nil {
-		errorHandler = func(c *gin.Context, err error, statusCode int) {
-			c.JSON(statusCode, gin.H{"msg": err.Error()})
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
 		}
 	}
 
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
+	siw.Handler.GetModifierGroups(c)
+}
+
+// CreateModifierGroup operation middleware
+func (siw *ServerInterfaceWrapper) CreateModifierGroup(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateModifierGroup(c)
+}
+
+// DeleteModifierGroup operation middleware
+func (siw *ServerInterfaceWrapper) DeleteModifierGroup(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteModifierGroup(c, id)
+}
+
+// GetModifierGroup operation middleware
+func (siw *ServerInterfaceWrapper) GetModifierGroup(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetModifierGroup(c, id)
+}
+
+// UpdateModifierGroup operation middleware
+func (siw *ServerInterfaceWrapper) UpdateModifierGroup(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateModifierGroup(c, id)
+}
+
+// GetOrders operation middleware
+func (siw *ServerInterfaceWrapper) GetOrders(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetOrdersParams
+
+	// ------------- Optional query parameter "session_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "session_id", c.Request.URL.Query(), &params.SessionId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter session_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetOrders(c, params)
+}
+
+// CreateOrder operation middleware
+func (siw *ServerInterfaceWrapper) CreateOrder(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateOrder(c)
+}
+
+// DeleteOrder operation middleware
+func (siw *ServerInterfaceWrapper) DeleteOrder(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteOrder(c, id)
+}
+
+// GetOrder operation middleware
+func (siw *ServerInterfaceWrapper) GetOrder(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetOrder(c, id)
+}
+
+// UpdateOrder operation middleware
+func (siw *ServerInterfaceWrapper) UpdateOrder(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateOrder(c, id)
+}
+
+// CallOrder operation middleware
+func (siw *ServerInterfaceWrapper) CallOrder(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CallOrder(c, id)
+}
+
+// GetOrderComments operation middleware
+func (siw *ServerInterfaceWrapper) GetOrderComments(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetOrderComments(c, id)
+}
+
+// CreateOrderComment operation middleware
+func (siw *ServerInterfaceWrapper) CreateOrderComment(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateOrderComment(c, id)
+}
+
+// GetOrderLabels operation middleware
+func (siw *ServerInterfaceWrapper) GetOrderLabels(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetOrderLabelsParams
+
+	// ------------- Optional query parameter "kind" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "kind", c.Request.URL.Query(), &params.Kind)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter kind: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetOrderLabels(c, id, params)
+}
+
+// GetOrderPayments operation middleware
+func (siw *ServerInterfaceWrapper) GetOrderPayments(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetOrderPayments(c, id)
+}
+
+// MarkOrderReady operation middleware
+func (siw *ServerInterfaceWrapper) MarkOrderReady(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.MarkOrderReady(c, id)
+}
+
+// RecallOrder operation middleware
+func (siw *ServerInterfaceWrapper) RecallOrder(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RecallOrder(c, id)
+}
+
+// GetOrderReceipt operation middleware
+func (siw *ServerInterfaceWrapper) GetOrderReceipt(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetOrderReceiptParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", c.Request.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter format: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "addressee" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "addressee", c.Request.URL.Query(), &params.Addressee)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter addressee: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetOrderReceipt(c, id, params)
+}
+
+// GetOrderRefunds operation middleware
+func (siw *ServerInterfaceWrapper) GetOrderRefunds(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetOrderRefunds(c, id)
+}
+
+// CreateOrderRefund operation middleware
+func (siw *ServerInterfaceWrapper) CreateOrderRefund(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateOrderRefund(c, id)
+}
+
+// MarkOrderServed operation middleware
+func (siw *ServerInterfaceWrapper) MarkOrderServed(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.MarkOrderServed(c, id)
+}
+
+// GetPaymentMethods operation middleware
+func (siw *ServerInterfaceWrapper) GetPaymentMethods(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetPaymentMethods(c)
+}
+
+// GetPrintJobs operation middleware
+func (siw *ServerInterfaceWrapper) GetPrintJobs(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPrintJobsParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "order_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order_id", c.Request.URL.Query(), &params.OrderId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter order_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetPrintJobs(c, params)
+}
+
+// CreatePrintJob operation middleware
+func (siw *ServerInterfaceWrapper) CreatePrintJob(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreatePrintJob(c)
+}
+
+// GetPrintJob operation middleware
+func (siw *ServerInterfaceWrapper) GetPrintJob(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetPrintJob(c, id)
+}
+
+// RetryPrintJob operation middleware
+func (siw *ServerInterfaceWrapper) RetryPrintJob(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RetryPrintJob(c, id)
+}
+
+// GetPromotions operation middleware
+func (siw *ServerInterfaceWrapper) GetPromotions(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetPromotions(c)
+}
+
+// CreatePromotion operation middleware
+func (siw *ServerInterfaceWrapper) CreatePromotion(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreatePromotion(c)
+}
+
+// EvaluatePromotions operation middleware
+func (siw *ServerInterfaceWrapper) EvaluatePromotions(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.EvaluatePromotions(c)
+}
+
+// DeletePromotion operation middleware
+func (siw *ServerInterfaceWrapper) DeletePromotion(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeletePromotion(c, id)
+}
+
+// GetPromotion operation middleware
+func (siw *ServerInterfaceWrapper) GetPromotion(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetPromotion(c, id)
+}
+
+// UpdatePromotion operation middleware
+func (siw *ServerInterfaceWrapper) UpdatePromotion(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdatePromotion(c, id)
+}
+
+// GetQueue operation middleware
+func (siw *ServerInterfaceWrapper) GetQueue(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetQueueParams
+
+	// ------------- Optional query parameter "session_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "session_id", c.Request.URL.Query(), &params.SessionId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter session_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetQueue(c, params)
+}
+
+// GetRefunds operation middleware
+func (siw *ServerInterfaceWrapper) GetRefunds(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetRefundsParams
+
+	// ------------- Optional query parameter "session_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "session_id", c.Request.URL.Query(), &params.SessionId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter session_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetRefunds(c, params)
+}
+
+// GetItemTypeSalesReport operation middleware
+func (siw *ServerInterfaceWrapper) GetItemTypeSalesReport(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetItemTypeSalesReportParams
+
+	// ------------- Optional query parameter "session_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "session_id", c.Request.URL.Query(), &params.SessionId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter session_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetItemTypeSalesReport(c, params)
+}
+
+// GetItemSalesReport operation middleware
+func (siw *ServerInterfaceWrapper) GetItemSalesReport(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetItemSalesReportParams
+
+	// ------------- Optional query parameter "session_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "session_id", c.Request.URL.Query(), &params.SessionId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter session_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetItemSalesReport(c, params)
+}
+
+// GetSalesSummaryReport operation middleware
+func (siw *ServerInterfaceWrapper) GetSalesSummaryReport(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSalesSummaryReportParams
+
+	// ------------- Optional query parameter "session_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "session_id", c.Request.URL.Query(), &params.SessionId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter session_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSalesSummaryReport(c, params)
+}
+
+// GetThroughputReport operation middleware
+func (siw *ServerInterfaceWrapper) GetThroughputReport(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetThroughputReportParams
+
+	// ------------- Optional query parameter "session_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "session_id", c.Request.URL.Query(), &params.SessionId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter session_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "interval" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "interval", c.Request.URL.Query(), &params.Interval)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter interval: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetThroughputReport(c, params)
+}
+
+// GetSalesTimelineReport operation middleware
+func (siw *ServerInterfaceWrapper) GetSalesTimelineReport(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSalesTimelineReportParams
+
+	// ------------- Optional query parameter "session_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "session_id", c.Request.URL.Query(), &params.SessionId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter session_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "interval" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "interval", c.Request.URL.Query(), &params.Interval)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter interval: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSalesTimelineReport(c, params)
+}
+
+// GetSessions operation middleware
+func (siw *ServerInterfaceWrapper) GetSessions(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSessions(c)
+}
+
+// OpenSession operation middleware
+func (siw *ServerInterfaceWrapper) OpenSession(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.OpenSession(c)
+}
+
+// GetCurrentSession operation middleware
+func (siw *ServerInterfaceWrapper) GetCurrentSession(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetCurrentSession(c)
+}
+
+// GetSession operation middleware
+func (siw *ServerInterfaceWrapper) GetSession(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetSession(c, id)
+}
+
+// CloseSession operation middleware
+func (siw *ServerInterfaceWrapper) CloseSession(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CloseSession(c, id)
+}
+
+// TrackOrder operation middleware
+func (siw *ServerInterfaceWrapper) TrackOrder(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "order_number" -------------
+	var orderNumber int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "order_number", c.Param("order_number"), &orderNumber, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter order_number: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TrackOrderParams
+
+	// ------------- Required query parameter "token" -------------
+
+	if paramValue := c.Query("token"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument token is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "token", c.Request.URL.Query(), &params.Token)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter token: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.TrackOrder(c, orderNumber, params)
+}
+
+// TrackOrderEvents operation middleware
+func (siw *ServerInterfaceWrapper) TrackOrderEvents(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "order_number" -------------
+	var orderNumber int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "order_number", c.Param("order_number"), &orderNumber, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter order_number: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TrackOrderEventsParams
+
+	// ------------- Required query parameter "token" -------------
+
+	if paramValue := c.Query("token"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument token is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "token", c.Request.URL.Query(), &params.Token)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter token: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.TrackOrderEvents(c, orderNumber, params)
+}
+
+// GetVoucherBatches operation middleware
+func (siw *ServerInterfaceWrapper) GetVoucherBatches(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetVoucherBatches(c)
+}
+
+// CreateVoucherBatch operation middleware
+func (siw *ServerInterfaceWrapper) CreateVoucherBatch(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateVoucherBatch(c)
+}
+
+// GetVoucherBatch operation middleware
+func (siw *ServerInterfaceWrapper) GetVoucherBatch(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetVoucherBatch(c, id)
+}
+
+// ExportVoucherBatch operation middleware
+func (siw *ServerInterfaceWrapper) ExportVoucherBatch(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportVoucherBatchParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", c.Request.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter format: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ExportVoucherBatch(c, id, params)
+}
+
+// GetBatchVouchers operation middleware
+func (siw *ServerInterfaceWrapper) GetBatchVouchers(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetBatchVouchers(c, id)
+}
+
+// GetVoucher operation middleware
+func (siw *ServerInterfaceWrapper) GetVoucher(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "code" -------------
+	var code string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "code", c.Param("code"), &code, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter code: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetVoucher(c, code)
+}
+
+// GetStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetStatus(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetStatus(c)
+}
+
+// GinServerOptions provides options for the Gin server.
+type GinServerOptions struct {
+	BaseURL      string
+	Middlewares  []MiddlewareFunc
+	ErrorHandler func(*gin.Context, error, int)
+}
+
+// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
+func RegisterHandlers(router gin.IRouter, si ServerInterface) {
+	RegisterHandlersWithOptions(router, si, GinServerOptions{})
+}
+
+// RegisterHandlersWithOptions creates http.Handler with additional options
+func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
+	errorHandler := options.ErrorHandler
+	if errorHandler == nil {
+		errorHandler = func(c *gin.Context, err error, statusCode int) {
+			c.JSON(statusCode, gin.H{"msg": err.Error()})
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
 		HandlerMiddlewares: options.Middlewares,
 		ErrorHandler:       errorHandler,
 	}
 
+	router.GET(options.BaseURL+"/api/callscreen", wrapper.GetCallscreen)
+	router.GET(options.BaseURL+"/api/cash/closeouts", wrapper.GetCashCloseouts)
+	router.POST(options.BaseURL+"/api/cash/closeouts", wrapper.CreateCashCloseout)
+	router.GET(options.BaseURL+"/api/cash/closeouts/:id", wrapper.GetCashCloseout)
+	router.GET(options.BaseURL+"/api/cash/drawer", wrapper.GetDrawerStock)
+	router.PUT(options.BaseURL+"/api/cash/drawer", wrapper.UpdateDrawerStock)
+	router.GET(options.BaseURL+"/api/cash/movements", wrapper.GetCashMovements)
+	router.POST(options.BaseURL+"/api/cash/movements", wrapper.CreateCashMovement)
+	router.GET(options.BaseURL+"/api/cash/summary", wrapper.GetCashSummary)
+	router.GET(options.BaseURL+"/api/export/:dataset", wrapper.ExportDataset)
 	router.GET(options.BaseURL+"/api/item-types", wrapper.GetItemTypes)
 	router.POST(options.BaseURL+"/api/item-types", wrapper.CreateItemType)
 	router.DELETE(options.BaseURL+"/api/item-types/:id", wrapper.DeleteItemType)
@@ -557,16 +2259,363 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 	router.DELETE(options.BaseURL+"/api/items/:id", wrapper.DeleteItem)
 	router.GET(options.BaseURL+"/api/items/:id", wrapper.GetItem)
 	router.PUT(options.BaseURL+"/api/items/:id", wrapper.UpdateItem)
-	router.GET(options.BaseURL+"/api/master-status", wrapper.GetMasterState)
-	router.POST(options.BaseURL+"/api/master-status", wrapper.UpdateMasterState)
+	router.GET(options.BaseURL+"/api/items/:id/modifier-groups", wrapper.GetItemModifierGroups)
+	router.GET(options.BaseURL+"/api/items/:id/prices", wrapper.GetItemPrices)
+	router.POST(options.BaseURL+"/api/items/:id/prices", wrapper.CreateItemPrice)
+	router.DELETE(options.BaseURL+"/api/items/:id/prices/:price_id", wrapper.DeleteItemPrice)
+	router.GET(options.BaseURL+"/api/master-status", wrapper.GetMasterStatus)
+	router.POST(options.BaseURL+"/api/master-status", wrapper.UpdateMasterStatus)
+	router.GET(options.BaseURL+"/api/modifier-groups", wrapper.GetModifierGroups)
+	router.POST(options.BaseURL+"/api/modifier-groups", wrapper.CreateModifierGroup)
+	router.DELETE(options.BaseURL+"/api/modifier-groups/:id", wrapper.DeleteModifierGroup)
+	router.GET(options.BaseURL+"/api/modifier-groups/:id", wrapper.GetModifierGroup)
+	router.PUT(options.BaseURL+"/api/modifier-groups/:id", wrapper.UpdateModifierGroup)
 	router.GET(options.BaseURL+"/api/orders", wrapper.GetOrders)
 	router.POST(options.BaseURL+"/api/orders", wrapper.CreateOrder)
 	router.DELETE(options.BaseURL+"/api/orders/:id", wrapper.DeleteOrder)
 	router.GET(options.BaseURL+"/api/orders/:id", wrapper.GetOrder)
 	router.PUT(options.BaseURL+"/api/orders/:id", wrapper.UpdateOrder)
+	router.POST(options.BaseURL+"/api/orders/:id/call", wrapper.CallOrder)
 	router.GET(options.BaseURL+"/api/orders/:id/comments", wrapper.GetOrderComments)
 	router.POST(options.BaseURL+"/api/orders/:id/comments", wrapper.CreateOrderComment)
+	router.GET(options.BaseURL+"/api/orders/:id/labels", wrapper.GetOrderLabels)
+	router.GET(options.BaseURL+"/api/orders/:id/payments", wrapper.GetOrderPayments)
 	router.PATCH(options.BaseURL+"/api/orders/:id/ready", wrapper.MarkOrderReady)
-	router.PATCH(options.BaseURL+"/api/orders/:id/served", wrapper.MarkOrderServe)
+	router.POST(options.BaseURL+"/api/orders/:id/recall", wrapper.RecallOrder)
+	router.GET(options.BaseURL+"/api/orders/:id/receipt", wrapper.GetOrderReceipt)
+	router.GET(options.BaseURL+"/api/orders/:id/refunds", wrapper.GetOrderRefunds)
+	router.POST(options.BaseURL+"/api/orders/:id/refunds", wrapper.CreateOrderRefund)
+	router.PATCH(options.BaseURL+"/api/orders/:id/served", wrapper.MarkOrderServed)
+	router.GET(options.BaseURL+"/api/payment-methods", wrapper.GetPaymentMethods)
+	router.GET(options.BaseURL+"/api/print-jobs", wrapper.GetPrintJobs)
+	router.POST(options.BaseURL+"/api/print-jobs", wrapper.CreatePrintJob)
+	router.GET(options.BaseURL+"/api/print-jobs/:id", wrapper.GetPrintJob)
+	router.POST(options.BaseURL+"/api/print-jobs/:id/retry", wrapper.RetryPrintJob)
+	router.GET(options.BaseURL+"/api/promotions", wrapper.GetPromotions)
+	router.POST(options.BaseURL+"/api/promotions", wrapper.CreatePromotion)
+	router.POST(options.BaseURL+"/api/promotions/evaluate", wrapper.EvaluatePromotions)
+	router.DELETE(options.BaseURL+"/api/promotions/:id", wrapper.DeletePromotion)
+	router.GET(options.BaseURL+"/api/promotions/:id", wrapper.GetPromotion)
+	router.PUT(options.BaseURL+"/api/promotions/:id", wrapper.UpdatePromotion)
+	router.GET(options.BaseURL+"/api/queue", wrapper.GetQueue)
+	router.GET(options.BaseURL+"/api/refunds", wrapper.GetRefunds)
+	router.GET(options.BaseURL+"/api/reports/item-types", wrapper.GetItemTypeSalesReport)
+	router.GET(options.BaseURL+"/api/reports/items", wrapper.GetItemSalesReport)
+	router.GET(options.BaseURL+"/api/reports/summary", wrapper.GetSalesSummaryReport)
+	router.GET(options.BaseURL+"/api/reports/throughput", wrapper.GetThroughputReport)
+	router.GET(options.BaseURL+"/api/reports/timeline", wrapper.GetSalesTimelineReport)
+	router.GET(options.BaseURL+"/api/sessions", wrapper.GetSessions)
+	router.POST(options.BaseURL+"/api/sessions", wrapper.OpenSession)
+	router.GET(options.BaseURL+"/api/sessions/current", wrapper.GetCurrentSession)
+	router.GET(options.BaseURL+"/api/sessions/:id", wrapper.GetSession)
+	router.PATCH(options.BaseURL+"/api/sessions/:id/close", wrapper.CloseSession)
+	router.GET(options.BaseURL+"/api/track/:order_number", wrapper.TrackOrder)
+	router.GET(options.BaseURL+"/api/track/:order_number/events", wrapper.TrackOrderEvents)
+	router.GET(options.BaseURL+"/api/voucher-batches", wrapper.GetVoucherBatches)
+	router.POST(options.BaseURL+"/api/voucher-batches", wrapper.CreateVoucherBatch)
+	router.GET(options.BaseURL+"/api/voucher-batches/:id", wrapper.GetVoucherBatch)
+	router.GET(options.BaseURL+"/api/voucher-batches/:id/export", wrapper.ExportVoucherBatch)
+	router.GET(options.BaseURL+"/api/voucher-batches/:id/vouchers", wrapper.GetBatchVouchers)
+	router.GET(options.BaseURL+"/api/vouchers/:code", wrapper.GetVoucher)
 	router.GET(options.BaseURL+"/status", wrapper.GetStatus)
 }
+
+// Base64 encoded, gzipped, json marshaled Swagger object
+var swaggerSpec = []string{
+
+	"H4sIAAAAAAAC/+x9W1PbyLbwX3H5+743E8icPafO5i0XMps5ScgJZM45tWfKJewmeMe2PJKcgZpKFZIn",
+	"YG4JIQGGQO4XCOyYZHIjwAw/RsiXp/yFr/oiqSV1SzJgLrP9FGJJ3atXr7V69br+HE2ImZyYBVlFjrb/",
+	"HJUT/SAjoD9P5XLpFEheksSMqKTELPwtJ4k5ICkpgN4QMmI+q8C/kkBOSKkcfi1qjP5mbM3UnkxGY1Fl",
+	"MAei7dFUVgFXgRS9EYtmhQyA35AnsiKlslfhg5w5UzyVhC/0iVJGUKLt0Xw+lYzGvB/gH36O/l8J9EXb",
+	"o/+n1V5LK1lIqwV+D3z5xo1YVAI/5lMSSEbb/+6ckoBGxo2Zy/vBmlns/QdIKHDm0/lsMg3OmNNdBj/m",
+	"gax4MZRSQCbsan7MC1klpQxifPYJ+bQSbT8Zi2ZS2VQmn0F/u7HpWo45XSiQ5ZyYlQFjV3t7Jeb+1LMY",
+	"9C78Nc7dbu4DGhEhF2xtHQLeMz01Jgs1Z4R0Wk5IAGThX16MJIR0GiTjguJYelJQQIuSsimGXgMYyKUk",
+	"INf1TVqQlfgu5hKlJJDi2XymF0gsnEGUwWHjCZNdA7DqGDBGLd8Do2tox7r9Mc2nPzQeGmcwLoOEmE3K",
+	"DBFzZ0tX3xkjG7o6p6sT5cVHtfkpoziiaxO6uqqrK7o2rqt/6OqSrpYqS9PlmTdMYQQng2j0nWBn/fWX",
+	"rWJ5ccgoPtDVVefcj2qPh79sjRKik4PkkYvWLCkWFSRJGLRJJxm05ke6OmHcntPVO8btWV0bRSu/r6ur",
+	"DFyoz+D72ope2NILQ3phqzKzYtz+RMPsRY0brqsgCyRBqZM4s2BAiedz3tWg3XnsXNNLXf0FwfuLF1i4",
+	"A++Wy7MjXnwHwy6BBMgqaUhQ0nUWbsuLQ9XtO+XbUzt/LBD0UhDAuWffoN9/2e12d+OZPcC5eM8kSJsO",
+	"vNDbWI0xucW1V/6M2G1hxMmGwVIFQ1MHNfiLGXs4NsBy/5m0KAMxr5yRgKAA7qGLZBFIxnsHmcdLEmTF",
+	"TCorwJ23DunAjTxLfXUGTsAms6spWQGS4wiPZoRUlsUaMpBlW9tx0mNlUa3MvCjPa7q6Zsx+LL94vbP+",
+	"WldLurapFwq69kkvLOmFd9FY0FHsJi8bN25MBGHdT2LL/XFZSAMON5JJfR/ydiuB9ro+mdPAHQYDOZDg",
+	"riWkciTmQDaVvRrvS4uCwh4pJwzGU1mZ/1DMKzLvsO/LZ5PchzaFBhBk4DKuC1JKyCYA46QqPSo/2qw9",
+	"mYy0RCpTw9XVWWPo+Zetol54qGvP9cKYrn3W1bWd9cnqx3dYmgYpesmoAz5qJW58xmh6tDFJoc3GEbWh",
+	"NplSK4v5MYyDOHncc0G8DjIgGySz7KsUGBAyuTSItn/d1tbGUlmEvNIvsjdQAoIsZjmP6hFN5s0KZOHF",
+	"4+9RE7MYmTYuoz94PnZtnPMuZUEfhC6f60mGp8X6omY3ciQkH4TE+l7Zbf/2xJeZ3BtGVmchNxTVd+cz",
+	"GUEaDHtkuDXDsdrjKV0d17Wx2sid2pNJ9Pco/H3kma6N6dq0sTWDdMVHldt/1EbuGM9+21kfY2r4tMR2",
+	"zVP4p66tIxVZQ9eFNaRAl/CQzMEOTHK7tAEEEb7MVLfv8aDbP2pzEUxDBS+ThsRMGKHJZ3cFDCiMB651",
+	"WSSNXvcBxEcc7a/IwTpxWIkQapHWkDHXegM52asRsXVtx7F18isWadInp/N15innWoLja3ImsyGWhJ+A",
+	"1K2IiWtBAAfD6H0jLf4UV/olIPeL6QClvTZyp7Jc0tV7urqsq6Xy3FOjdN8Yeh5tyGI7soo0GGZ3vm6r",
+	"d3di9eNBV+/CJT+8X555o2vTO+vjxsJDJGGXq69fGnfGdHVe18ajMXuir3ZJBG5YAvDE5+Nd3hncWxBw",
+	"KdyzDiAqQppNmz8JEpTF9cL+3/izQLsE7whwq8QYQgqcgB25kkv6ifg9b8u+3NV9KNFvgSZuGyyEvK9k",
+	"gCwLV4GDlaMn29qM4eHKrVFoLy1B5erkV+WH95FWMV/XmrncZ8/MREpeQp9fAhK0Z6WYyl95dKj6Ui3P",
+	"a7XZu1+2ipWl6S9bo9DwUbgDzXHQ/PFO17bRNXJVH9KMtT+qb5/o6gS2GxqP3xtTRV1di2TzaUiHoRGf",
+	"+7oNPoCfCb0QYYqUB9YqiJUKvvfXsO/9NcR7LPMME3kdkiRKZ8Qk47ata8t64RXEjlqqLJdqTx5+n22J",
+	"fHfqfOfZUz2dXRfj5051nu842x7RCyu6tgZf1z7rhaKulozhm0bpMySI18+QefM2RuOXrWISKEIqLUd0",
+	"dbX2+GZlwT7LKlPDlXtvv2yNwmkudvXEz3VduXi2PWJtRfXluK4+N5V2OB58s+vy2Y7Lcep92sLK/aqz",
+	"p+OC86OnaO+H9cJj/496/vdSB+/LynLJKL7gfn+h62znuc6Oy/FvLndduUQPUlPXy2MPq3CoN5AAIfxz",
+	"3HEuXe4844Bh548n5cdbxvPR8sJ7n6+6LnShjaORi3yq5pSr3I+/67py5m9uPK+hjx5A1gn67vSpnjN/",
+	"439d0gtT0ABZULkjdXd0d7uAd1ktuZ+eOd/V3dF1pcfxLbqlVT491jXVD9EXe+Lfdp12oGzyjVH8BK94",
+	"cNZZ7scXu+Jdlzouxgng7REfg6slaOiVnjp/uePU2f9Fo7RHEGExvrNGZX2KVs7C1Frlg7azMVxeL+rq",
+	"tgNHHf/T2d3T3R7B2pZ5mV1zjWAM34QSnkKiPRThyCsXTndcjndejF/p7miPeD0vcM2sQXd+39a128jL",
+	"hBw32riTzbs7Ln/X4eVz7PgxXS0z9vcuSWGihj3MGh7AvRgLn6fOn2d8RLubPHjo6uF8NuHxVbGgxsxu",
+	"AtBx7lzHmZ7O7zrcTL8GlV945q6WF0eNsc+Ui47CoougiQD3UPSa8fxteWbO5TyzROCVi6e+O9V5/tTp",
+	"8x3tEew9Q1NPmk42hyw1plbhQl37ebaz+0zXlYs9cY/8JiJJXYWEoA6HEufWaCaernR3BA21tvP7duXe",
+	"sr1fpqwyx7jccbaj40KHR1hxP+z4n0udl73vl/COYAcqREhxxI0NWryeunTpfOcZjFyXjCU86VjGak19",
+	"Vbm3bBKPiZHTnefPd178Jn7qAsLLhc7uC1AEt0eqq+Pltxqyfk3o2gc00hQaaam6XKyU5oj7mVjIlnfW",
+	"h6oj79GPFhFc7L5y7lznmc6Oiz3xS6f+90LHxZ72CG1f09UJ07hUKt9bK49Cs5pRHNbV1erHd+gFc7DL",
+	"p3o64uc7L3T2MHWJCeP5fUTatwiqOi/2dFy+eOp8e4SG3hi+WSssI+QQreX7bDRmGTU9Wks0FrVILhqL",
+	"uogwGos6FQTzB+fhH41FeUd6NBZ1ndLoF88JHI1FPUcr9Zvr2IzGop5jMBqLes83PL377EKLdhxK1ID0",
+	"acP4GZ8k9GT4oLCRR8t7B0qxpLV+cgpgz89YVjoGsH7iyELvas09douraCzKEzz0I1qKULvhFgzUI8L6",
+	"ru20WTkai3I4EkLJ4KdoLEpzBnoLEz7U4+3rl3cVzgtXLDrQArmg5bogwaAlGbKDpfV/J6RTSXR7Oiek",
+	"0shJZT27KCrnxHzW8VuXlAQS60GnAjK832GIHOvZBTGZ6ksB6RtJzOdYL1ySUgnAfkDi61gPvxPziX4g",
+	"+Tw6LSiJftbzbmyNYD0y3dUcOLPKt2Iv69lFsSsHsmRgxlyn0hIQkoPwJf5TNDkTnI6BlKzI9BO8Rege",
+	"2Jm9IgPW9nWbAR/OJ2Q2/6dnUJQWa1TvE7SB5LuOvj6QUFLXAQ9zHgKEtHMlK1wXUviqSz06m5LRnZZL",
+	"j+YLZPIrMmARAnl6GSQByDDf6LCiZRj0hUJZE27YTqdQqM0p5Om6kJIzkNoc68rK+b6+VCIFssolYRA6",
+	"AujHlwUFnE9lUooLG1kFSFkhHf3BvLbTVkf+1Z3cxYc0pEu8gpqZ9hSqEwVkz0iISRCBZ/+T5crzDWxB",
+	"1QtPkFq+iUwj67o2XVPXdfUjw+6RDIyUtRaAzVDo9u+FmGMLKHoO7ghcErLG1BEudS4F0kkECDP4Aj3w",
+	"gjQ7XpnfqA7dNKbu6OoddBly4OXLVrE6/ra68hBDQslkSJWRrKhE+ghd+hvBMAAxjE6WlYaC32P064PP",
+	"+AgtGVOTxugkjJLAelVhRS9sQrOX9tm0f71CC9uC9q/CljE1qQ+p6GryCF4rXApZYVEvjOjaM10tRb7t",
+	"7roYuSSmIGW6cdDai9kgbnl8PVZnpjkxk5eVSC+ICNmIaUULQh/GgL+REMqSILcfL0DZlkC0Tddhd+sV",
+	"xTQQsjjwiA58d/Mluu8WisbMsHFXhcrx0ni5OEXfmKB1ko7SIvc7on7rQ2rl1Ubt5qRRnIN3LnNAXZuu",
+	"Lj2rzT+vgys40eYMDrHjnkM6FK6BwfqCs3PwpAgRQ+yMx8Zf4elcUPKIoDPbJzL2XpZTV7MA+JhWbVj9",
+	"dhjfhsvzWgWzV4gN3/V2EenP2a+g4SAy6DEyRBsL7w7pBmnkajf1OBYkxEtsh/i7GcK+yiLrT8lpPCh1",
+	"noVSFsUm6IXNnd8XdW2sQvihZFqGS+WJEaN037rnYx6oLxbBCShBYcwmDD96woJlj1RVTxqEuVfxFCus",
+	"Ax7W2l1dfWxblSEiaToLGRXEcdrZWApED9IAAwQvMDXDeJ8kZljrgQaO2uy4sTRenoNy0S0jJ9+hHx27",
+	"7hsPYYkb6+D5C9slbQdhWW9GkRYFD8OT2J+ErUyBFIbn9EWUT2DTLoI+vHjd1/i0egiWK959w9ygoEnm",
+	"08wgK2Q6dZq+bEvlzkYRyYMStpFCm5T6ChoA1fGo98xmhbHZJG6eMS5s0tAFRrw4ZO2uFQ96/X1CWga2",
+	"LgyZ4NlvujZm2hZX0IKXvMbZaIN0Fs9rS2i+OwiaN5VXG3tRTHxOujrzxsKcitBeQc/ZMGWGkWHG0Wii",
+	"NDHwKKwbRshdBjlRUhqefnfI+XqxKCfIE8tiY3QSsgYverPubL9U0vFfd/KfCQ1vYyBBBZyByZScSwuD",
+	"daOJrR47RvODyidwKQigkBQQDm4a+6GB96X3ukn1iNChTW+7obKgsKejvakB0Dcv6PwLeoPk9xE4+niX",
+	"+QuCrMBgPEHxkWK7UZv9YycD73GmlhGQMGcDRj4JWGMAb4SblT8R7YoJLT74YcoR+EpEV5eNqQld/TXg",
+	"+uAchzyAX0doKoDaLtJqnyDX//jO+lB59rOuTZu3fxL8W/eOBXFF4AAZYSAuI2OIu8gCI4YylWW9y7x8",
+	"1m+SMffRR1TUpUjYEISgmrCqhH2TLq+/MjZeNuYOevDb7rbBqJ91bRzGqqulnfWx2vzUl61im/POVvyI",
+	"QkJWdHWOna/oJpj9JRL+vcq7VwrI5IAkKHkpOAWbe/I7luPAYFha4won/yMpngRpRQhiORYT+APDo/k6",
+	"FSkbzUTT0D5HYyGWQpMciQf7VHJnxGqacXvN5Z/5ui1WzxFNT8xCCPJ4BVxxXA4hnsr8xwTMRrOClIY0",
+	"OsxHV5fcEavatGkFYoYtzejqgisp5Gu2qS+Bc7LC8xEzm4zBS0nii45jG3Min5ODZb/rI0xPHJFEfUYE",
+	"YvhFuGzYfBP+7qsJQDvUP6GZnsR9atPlW08qMyvedB3miZnDLnKWs8WMLMNu7uryO5duTsW6lYyby/AP",
+	"bdrKejQ/h/4CfUgzSadkTBWry0Xn5wx6CoVe4t/nY1cCCZCyaofYFBFEreZ3cU8qCz/r1WIRlELGRhmd",
+	"GVu+tWwpVnVcW/Ze08Il2Lbuo/2AwOOIY2Nqklk5AEdmxBPMfAYctYlX44ir1N6hv0eRFZMOrSzt/L4I",
+	"L39IjUXoWaVDP1GIrY0b/yPR4w63Np5iWq5o9TEe43JqcavkmMxzoGAEusRn2D31VG1jbKlXwLMLI1n3",
+	"MX4dosq9zdqDp5BHOdWRyvOaUdzk+XuCXbj9QvYqYHMLygVnV3VCX8V7JSBcS4o/ZX2+19US5jOLyWjS",
+	"wnSFKKqkq9v7zVsJsa8PAOqg8R6zlPfULGtF2GAak375wVp55g3LsM8wicDM+alVXRviKbG7PVv9VNRd",
+	"lXAhp6pPcUHrAIiGOJZNFO/b+Z1EGX5xOuuSXV2ghOmsNvYalzuBShNKgt0/UvNP5ISwpnI5IMXlXDql",
+	"sCAdRcFGBTPHbvrfdjY2djZf7KyPVZ5u7Gw/wNLT9FH5+ujquhHWr/2wluetm+ep7YWUVbZo+rJVNIYn",
+	"nY+WygvvUcEv5K80z43dSbAsULiaNKW3wGMch09g5ccqcIF/YdI4TbH+6lg9KpAfK+ckkIsrgnxNZh3b",
+	"i0g6aegaPVR9ucQSSmsMoaSuGm9narPjdSswlySQ6xHkayxQf8yDPIjnRDllptK6qALlDxl/3IR2KrVE",
+	"rMBqqfZ4uDKz8mWreBJvCrrYBLAp01meHPSTeIF0467Z6JKCbpItGQsPeUUOadWVV3UEJPlUisjSVKVK",
+	"PgRJFTAJtX+X0ft+BBeQPB9UgC4Qy3u1IUtC4hpUpRTxGmCrGUbpaXnpV5hii8RnZexj+Z0KbzdPN6or",
+	"k0TLheUGVVTc5ffqpycORRcGfc6jjM9Vkv4VWpX1lGuxK4E4zNp+Ki9RwLxk4pBsJr9y9eIAq3gYjbTR",
+	"J3p9lsxGXdwbJEp8+H/PXMSiOorUdn2hcl7JQ5ZMY1pmgdIvsop+vt0orxfLo+Pl0ocvW8VvOnoirUIu",
+	"1UpOzhb8HUyBXzJuzxp/zJH4HPOUc4dSw/JHTEdVKpNPkzW4Va+nyACzhkGprK6VF1ehMN9erLy+53KZ",
+	"fNkq4lB3vbBZ+fS+sj1dubdMYCDpc0IuJ4kocyMJEulUFv4FNzFUWTCCJt/C12RPcA4I/7JrRerbyCFf",
+	"Rn4SZBRxT0BlR6FxLUnG1C+0ManyYar8cBGWZx3drq5MWmcU3h9o7YK1ccfKH4suXa5OhQgaPYKCLc3k",
+	"AAt4H/ztrrzdrmILB3A2SlwCfUACzHKNbuK7PWtszVglgnfr8qGyBhjPTI4M5p66SmLJiqDkZbpMHkVn",
+	"hCeSFFNAW1Qq6ahCFlKsubnFmjswyNBJVnVRQDicHiBWuFhgLtxU1+uIGKknMrsXhWH4BbG7LiNQvzev",
+	"HsjmRvx9jHQAFJa9r6G2uw+5220RfN8gcDPDL8A5dC2VTQZfy1JZ5T/hi/Wybw5+CVg5XkMqDJq9iTan",
+	"MIfsFe9wHtSXrWK/KCvtOVFSYFAPZSPH1XCsZLXq8mujdD+M5mwB7YcpHyGuQCcs6/yqDak7209gjNGr",
+	"l+ioeuRzb2tgzdK6txEZWTgJeLaBxa5G8QihfJJO9OeWhyfoYhtvoENq1Y02bdwy3qB7ftKdbdiAaovu",
+	"guj8BBn30UkbZRCB17ele2UKt5p68q9fnTj57/9xou3E123tfz3Z1uZ/kAaSyLdibzd++0YsmkcXvT1U",
+	"hXefs64q8YhwbZxQp67FdK7bLQWRHzd3Wwt2aZzumisleIW/iUpcYNprj2DyxLYkbE+svnpZfTKBf6GN",
+	"7vArBHsqe9X8jpQESopZYP5klyzpQ5p2e8Qak9R60TS0+ziDYBx3eHBU0sCwmajCFQbgFJA50JiMwz0W",
+	"tXne4e5LC70gLUdjTNxURl85Ko/hl9sjphkDOipgDNiDNUsSU1aN5fKvtyrv38AP0cUwp5CaT5/Qx0XH",
+	"oiw4yKucNRDfV8d1IZ0PCLNtrElh/20FbtdpKNWAp4458cTu8cR0WzbQFRki1sTX4cMxcuR7rdqZ/ABw",
+	"rmnThVZrNGpahqGDgTvffeAHVON6DGGCpmlE+oWt9OYH48yOU8y3k0IqPRgHWYZS/be/tV+4AG/gcy/K",
+	"i/80Cze6Tpx/b0enTKAGj+eRFUFSdjnT1yFnAtmkvCejWp8EQB0YPNwASBjL5+AAP1D5AXq4cKd7hIww",
+	"QBqUQfOb1a6sjaP/iFIohCEi2NsW7b0/HN0QLoB1uXcBi3f9mDWYQQOYcu/MdiSYaK9m+aPBX7vjqSBu",
+	"cZxab26jELdfTEsnNGXUHg9TZeZIzN4R5SxWv0Wz6pC1bMqsZOLJxRhuEnLthS/P9pBFMMNu7EKrlGab",
+	"w0piXAJKXspCDXcVmYnmqtv3jMn3sCYm0XM15NIbi2D4I8bwMBxUncRFEtC77iZjViwP7ltHLgt9qQGQ",
+	"jIt9fe0R481tFF43EaHXCMfGsRooPGjUMyUBHGIPjhO3qL3dk7VhlsUtuWKGzO8j/y9ijwn3IRu/CpR4",
+	"xq796/mS3q2IMTRe/e0Tih1Zjjg2Dj6q/PKkPDsP70+lURdlE2T0C7ncYLxfzEvtkfLarfLciwglxPSh",
+	"RUskwtXUZu/qQyoXMvea6GI6O+tDqHglbxsdlxInVUCSNPfMplsn5gkVE+xFY1F7YcwbzX/Buxynxr9w",
+	"FfDbJNohOzATp1T5MFFTb/m1Q9yFxYkdvUZHg0DKnnlTbzQIL7Ril+Ya70D82BBuMIhvuAc3xuT2WrXw",
+	"u7fOa/giBy4biAW4y9JBk4IJE9kfliBEVGWlPZNddKXl+d2IAzJU6Ux+t63MW4mblL8KNg9RPOTKWPFf",
+	"KF9D211jSzGdBDDuzMExLh5YH6rMrNRmPunqbWQKovbeJfjNakQ+sbI8CH4SUgpfAoSHgRYOUAhDHpio",
+	"vNogx0obj/YRcbJkz+KKWeS55G6myWnk6adOUDKQYUeASIB3b7Yw8uNDT7tRVNmaKby8VAvNWfWuwcFx",
+	"QU5lglzXAtl7H6LtJw64Ct2LjhUHVnsy6U6sxiessx6UWdCFxOjyiMenfVJ9AaJ4Zbh+CzfBhxcIgldG",
+	"olydQSFuRrWDD1ap+uePrICQ77M0avhfl+h59CGt+nwEZyA6frdzX555tUUT6iW4B9s3a4+L+pD2fdaq",
+	"52w+f4yKIk5YAcnG4rKxAS2v+M3awjBSK5EzFGo34zhQAus4ge55VuElUpsdJWKgUthbWN1lf0/nl7B2",
+	"xZFO4qI9F0rwa0wPn31pcc6C49oiMIsIzzekSiAjXINZ0Wt0HTO7vhmVBmrqgHgUdOrCb0P3RXR31+Mz",
+	"LU3anGa9e6zeZhqfoe4ccYy49zptQevipmfW4VD3IGHvheX8QT9OTSp3L035wblB4hQF2TvFqbMwoMly",
+	"ZlMNdNwuRaCo4fBwXR7bQ2rMWbcscLs8A1pz2jLDNI7YUThmgz+8y4GhSKgyj9Wsk1OQ6jqQ4M1CSSWu",
+	"AYZacNIpg8mVGZ4zn98ZD0YYXhu7SZLpMuH4ZhxpofxcH3j55sTJh06WWmMmS6EjuagPqZlU+hoqdCEq",
+	"/UCCIaFrpF0G6Zc06p+F5Ot8ohcINW9PSaJIS8SLiqA5oQkCuzX5suiqJMpyPHRpJJcWHFLHywKFN4V3",
+	"VXCpVhqM764HLg7zIRf1RGtlqFQBUzPPDdMJaNOiE7seWuBulJsrXAuh8RlzMyeXx3tSGQCj/E7nTSZm",
+	"B+D7mLB70ae2vyC0dchvjwI20Z2bS4MQ88G8czlMrLhr4nrNApKYz/HtG1Rp172VLXPVighAAT1tjIYx",
+	"dAkI0rEg4BLoLXhxsjz3orJQCtXQfj9qdxA4fWpGoWYLe/Jj7Lr6hw8yUEgbvxYDs5UWjnIzqy2QSPWQ",
+	"IWUQ+fXarrz75SqoDyv4zmt09mp5Y9bQ5nHraQsP/9YWpn0v7euxoY1RG+htJe3EIpM+UMCWTzUjQRF6",
+	"Bdm1cQkxmzUb/vvFSVsfiNdYb0LMyoqQyYVH+nUgye7+utGTJ9pOtAX33zZj3Oxp7fFi9kpZaOrpl8T8",
+	"1f5cXuHK/l0JdghT4D3CnrwbvR4g0PGY/qvgqal4KNn/8kuyN+ew9WDJmNiAdXiRcaXy4S0+7kNdkDxY",
+	"ZaV1wbM5nd4rjsxhYtYS/RHUbW4Mu8KeIsYtb4VvVjmjWyt1RVXEOE4c2+U4CIY9jsIp1Wet0DsLC34m",
+	"Nklep0+Gk6ykMmgkOj8v0OysjVcWSsi/WbIimLFkxRV57AwEM7e4nkhmP29XYD40BYW7usr6a5c7rDqy",
+	"ApvEkcBWVVdf2tnS/sthhiOEiDQ2d2QfI41dXjVLyAYEDLsg4ef8Wu1oTC8sFTmck0BOkFAQsIUv+DvM",
+	"+Ua/0tnd5BkitPYITVXwtuhMA8fNC0l1cVfgMSZ4cwQzxpj2ZJtQRXEBGPyXyUwefrHJj+7XVYd3Yf8C",
+	"FDnXf9gX6MkEMeyjfvRRZ7yaI2KNWdUKoMZSzVjBg48V3LfwvQTd2IjF0jT57s6oGxytt5cqODwbhlkp",
+	"ixFKFLJMTkOoe/dBfLKcB0k+GyODssnGR5xv9isGUDJ73nF238p59kFMY8L19haa54pdIVtPrddD/j6c",
+	"y2faXsjTYW00+GXuPpk5F/b17dTpM2dbOs598zd26vXeecvExv4MwrdK+EgSy/QQnO/imgiheTe8lJfZ",
+	"zVTKiysmoEtE0Rm+SeJT4FDB4VRoC2M2VTj23JrYS2lwnBTpSOaSTbeLlanfYM+r7Y1LXd3QsKN9xvZx",
+	"XS2dutS5szlTXkKVtVMKIpozQh/okkDkUld35NSlTuoqb9oDiIlGyKWi7dF/O3ESmQhygtKPSBrVqIDq",
+	"mZyQAK46cxUE1OmD6nhhs7w4VN2+Y0blYL/6JrY9oZff8AxPMPNy+x7Uo2CEgVPlXOPU13qJbwQ760NG",
+	"6b6ZrVF0Fom1U3KN23O6egeXazD9gaQnTQQzEvTRr1aWNiGACApUsZx0zVTXIv8NertFeEH+slVEKPpJ",
+	"prAES4eqpYj9Q8TdPBP2ANCM56PGxCwCQCOFedR3OAEV3m7swAgR1VhOidnOZLQ9+g1Qzlgjo72ShAxQ",
+	"UFzU3wMsgJ1nXTENVIVWEo5aWXhf3b7jLdhKQo/goD/mgTRoyuh2pwsRi/gwHvEfILdgUYpI7au2tihq",
+	"H5pVyAEl4FaqcCmt/yDeVXt830qBFoJsn/KNG+6UwnJxyhh7BDngL/s4t7MNK2Nad3tNdWJnfbL8+hm6",
+	"985jcP5ygOC4C/PS7fTHEF0s6NpdJN5k7L7lFOYs4X6xmE1wFRu478JVGYlDm2h/gGMR0SL3tyZI32KZ",
+	"Ei8Mmpf7z1gv7h/ZH3k6D1cak8IOP4ziCLGAu6P/MWSByqfHuqYaIy8rU8MwGKzwAGcR44KEDPqX+1Gf",
+	"5pwoM0gcmzLofYxidQLIymkxObhv2KCncJUpd2owUMu54SHekw0BxG93iCG9San1UCqE668HCJd61wqJ",
+	"hKGS6jxC0CrNI+btESOOy0qw9NjIHTPquWSUHpUfbcJy259KFRS1Wl3+tTbxGzHxOpmLfay0/pxK3gh7",
+	"tniPFnQWQI3YPgpItBTNKUdH9QnHVU1+Misnm3I7pNhPJfFlgyf+eYLfQZu4pLMfTeJKy92KmLh2nLSd",
+	"GLs2tTeaOgLL+vOnp0IR7ckPko8o/DfZqDEKFCENFCRuiXycMeCjPeUZ/IJLvjpZphG6EzWDs8psKNWp",
+	"SZw2cR6gbuLbhQYbX3ZDorCu8vwm0kSKREkpbFafLRo3bwbrJhnxOrDKjvqpJResF/+Mh4Bxc5lOozm0",
+	"gyD0HdvcjuYd+4DMTDdfGCMbtZE7e71UmxvXwEu1OcWhX6q9RHoML9XH5ICwCBSfBQFi3/rOX+iTLJmm",
+	"yD8KIt9KWWpK/IO7FFjVG+m84aCbNRjIiZLS+jOMUZaBcoPrrSw/eLKz+REqcIgwnQWZsfHqM3IqohC3",
+	"2bswuG1kwxhbIC00hjRcDB6+jFFdwF7MR3Cd2htdXcIuTI/zrgOBeBYDGMTfjjkLI9hdFI2xDFJJa0S+",
+	"VcqMvLPy/NEfLVb6ntmOKxbNoBbTLbIiKI7e8nxux6Aavz81tm5zuJpIEBoiu+hnQr5OxQbi/2WT/8C5",
+	"hwNpeSAUFB6B2GAZh1dNUhhQoDdOO6XiviegZVSb2Nl8UZuf5Iu8PknMsGHxjTFlA1T5oO1sDPsDpGtF",
+	"WM931AcmRawfovqk8vVs8oSYA9mBTBqPLreIfX2pBEiKiTwkxhNyTgJCUu4HQMmkT6B/nRLKgqo3lcVH",
+	"pgdJjikHWghZOUbxfqOAAaUV0qHve8fFpeyUsHTMTWHTkYYK//sOjfMOibVN1DT4My70bAabICvn2xfl",
+	"1+9JyW0Ni0FiAKUENJbIlIiGwqYFYtH34muWLcGlhRp9yJuz7eaEdyGWTujdRn/POS4uARcVE5IGXVLM",
+	"4Q/1guLF9rHRn0JsNhK1UQa5Wz6oJEgDBXgp4Cz6naKAw/JCuROzx2rzz5sKbZa35WHVWta3GLkoOT5A",
+	"Eh53r+Qx5vpjRGSma5I5Rrlw03j8ljqI+I6UA6e6xp11h+qmaVL9QYtWTOS4pa7zGA5UOA9O2dxXRbNe",
+	"/bKBuuWh65V/Jp2SpUrWo0U2NcijLubql27h9MU/g67YPDEbQEos9bBOrfCYa4SHrg026brBItJHAUSH",
+	"Z6tZ1KoF1bOSuT4iJxzLrIrMq5X3U7r6Qldv19T18tjDKnznjdnOYc5KZGOlcEFiMOuBfYMhCfT22hDY",
+	"no0jJdVDacCOVTe9qgfMH7q6VFPXoX9TG2dSrcfJajKMHP2BxU+oBFzg5eoSfutfhMStFTfJ+8DJm1SD",
+	"J/6pwubORtEo3fdQNd5HOnLMCQfo6wOoR1AcumYjsD7r4kr5wQurmBIqsoQ88+oyaufygH4EixaNz8Dy",
+	"/qRu6QQqkzNWXsB13Mc9Z4J9Q0eUc1RYpTF6GFrioZsLXCx6xILkLMo5bvxIGPD5aBmW3i55AuNM1uMe",
+	"Jq0/o3/joW0dR4VjPPEYGBW82cxVNm0wB0nRFl8dlcxJkiqJe+lZJXZMMBlmQnykkdqt6iMXv+G6FuWP",
+	"RV2d8+E6KsYr76u+XUAvkgp0zbx712XGQk5T1zuwAFFORJJbxZMHZQVkfLIDsDXIRd+NUHnsKXbjjzzZ",
+	"CDiaaWONTRtj0yixSzFo1BLLXPOUVzC7zUfHwHbjwBLT/sBJ9KGtEP5OTgeMjWJoJx4Oh5nZe3HEbjFm",
+	"zSw/tyebDhDUAXYoF7eEdI+6KcRXpWECd7DGqaYuzwOHbXcPp0cwv8XI5cqdUKL4GFDUwQqgJq02hlYD",
+	"zsg8q92GNZC6hrvg6upS5eYSynyag3ZMN9JWza6luEHpGuYQq+U7U6M+0txwlLSBw2BGpIIeLW3guHOi",
+	"R61n6Sp272LeKdKF32jaWJz7idDStK4ckHWF7mBTR2gp2qQG3bbQ2IfqLXKR4NEhOXWs9ngK76jZTHCC",
+	"anS4Wv34zmvd/mrfALwkDGZAVjknpNIg6U+Mr/XCM5MeX6Ak78+4X6uuTpRHt6srk6ZBm8CK2AZ1cj5q",
+	"lh+kDw2pVslkRg3rwiZuikBad6vDnqTLNfTHAzyms7x+QM6mOzIYHywh774mnzZjg4+evKZL4dctrINj",
+	"gw976//ckvjYE5MdHWx/Wkd08MFSV4OUjEOND26S9kHISXaAMHWGokYOEEp2YBir8P0qs4sFegd2sfD0",
+	"5kPdMGB5m0ld06xQMWpk0pXVGyQmpNMcRuMj6RiaFpussI+scMDaM93xj9aSy3NPXX1bQuq7kJXsr+aD",
+	"Wko4WNmsaRRkdTljvnj8+SpcYTO83qZV5QBOn8roZxiu5fiwRJfZ4dW2NGnyhzC2F7KjR4WAG1BiEy/w",
+	"cKtrutmm2a2igaoaUqxsNqlu/26MPWYzCEv4p4VekPZL8HIyJKyfNa8XVlHjsHfo0TQ2gpYfrFn1CK3X",
+	"dHW5/Outyvs3qNuXaQmCdq1PpJ2ANv19tqP7TCvsvYa+nELxx0WjOPdlq9jdn+pT4t92dqPvl6yWZyhW",
+	"6B10SBT+SQZS15idzKDKSII+l5AqyekWhsTDeYyMw5cOsZ+ZDohrqazT9eDfPzKVVf4TflHvcSkmFKC0",
+	"yIoEhEy9heSOyolYWS7Vnjw8jrxt8U5h08EpasmYfGMUP1lFLj1nYQ7uONwGNqvnsB06WM+7ZL74L6Ln",
+	"kfU29bzD0vPK99bKozO6+gtHybMol03YuB96+8+Q9BL9Xrq+IEjXyGVZSA42jbtN2gyhVmnT5Y1ZQ5s3",
+	"ShM7G8NWImOUQ4Hh7WFW8oprOhQ8NAy7s268pK/yX7aKpLUuTI6cgOcANRpskepqK6uulouwKSsOZHCy",
+	"wmUEaNM61mST42gdQ3889nQ1/iUkQxvDk85vSXheaEuZBBIglVPC3pZqj381bt8qL6zr2nTkbz0Xzkes",
+	"lUQunT0XoW8032eN4gNj4SHKBcXN9ZdqQ/eh/RybyqnhLON5+daTyswKRsPO9gPj9a84PvErY+FhZaGE",
+	"62mjIEbYmdkcaInY5Rfem1PjGatDN2H36bmn6NpUMkoLxtSkXtisLN+q/rGFO9dWl3/dl5vWZYLJI3vV",
+	"8i3E3q9k0lQldvLfXLIvXBl4hFhXQBreAutsIMgfUum+266t4QeqCcmkBGQZgH1sDgFXV3dRcVQgHKFn",
+	"VxXCY9F+IJgBgf/TQoim5WI+04v7ArpCFp0M4rv4Gwce1YnK/h9DrciWYqZoCn/llEBfPpsMvnFeJu/9",
+	"i1w48XKb983Dum9Wt+/VRu7Auie/L+raGDoJeTl1JgmH8i/gjf3zuhfw+g7Vu+DmnSPmXMCkdTRC+I+w",
+	"bo9yapw3YErP30tWMZO3rYbYTMZmnVwykK6DZBijUjd+s2lVap5AoaxKmP55ViVi6mzJAKVf9NeciNn6",
+	"AnlzvxQXMCBkcmkQbcedwzxkuMs8dqMIK7dYd0Mc1l4eHS+XPsBCLqENv0jjbPmH2Mt3WpZn3yCe/6X2",
+	"GKK4vDhkPF+KpFOZlBLZ2fzIrzeJXGbfwqHZ3OzOBzLLYdThkPtW7CVVNJituViKgWtWJKV20ZWLNRbC",
+	"CfuSe7KtLRbNCAOpDLzkfo3+l8ri/520JkhlFXAVSAfmqSEoPBaqcx3dpohnT1tHh9wsjxvsyxavIh/P",
+	"X892vqsl18S6No2OzXFkFkLzASnC6wFo+lix13/VNAORikvIO7+Cev1t4ziF2pDKtgphhdLc3AYlSJnD",
+	"H6r26iXg4xIcQ5th3Ts74dx6l0n26B/R0DCMGMHfsmEfPVYeEfdstmnZv3ulk/+OoZ8lDEU3dUfGXoel",
+	"TedXYYI+XHTaKgFFGvRxTj5/W56ZIwH3NnSl6quX1ScTxsLD8swb6J8srJC7WKFIPC+UxxLlLr7Act64",
+	"+YITmn8ZQtLkjSZv7Jo3DjrLlc0bS8gZ6dvynPrQs7iSMTyJuSuIkcWMCCHxvwfabx2MDk6m23NpM5L9",
+	"SwpErHK1Xmt5QTZYC7SGqZDW0g9Je/Sg/hhWMnNvu7uGmWPDvazQCq4L6byAs7dD3ILIlWZUV+9De8uQ",
+	"Wl4cNcY+6+qKCxBdm8blZTHX2hUCtOnqcrFSmuPU8+kg8Lj4sIHkZ854SDmYbjgg0o/OyeZjez8mhTHN",
+	"qgglTHZ1sEa48ga0mPTXwJwM0izod0SUJZfcCnmRcH7lKeLnOmcDtY0jTTwHd9w2SXL/SDJI9eMXVjiK",
+	"VHlU9M+DZohm7bx94QZPvTzm4f9jHuQB3xO2uIL9jd5w8/K75fLsCPaQ6UNq7fEwCiVdrnyYqKm3yvNa",
+	"bfYuAnnSGab6k5CCt9R4Ip+TIzBj3V23YUVXn5k2YFwUk5pXXSL+BVIps1SeeYNyCb2N4+6RnkGmzcmY",
+	"GTbuqnDGpfFyccrZc2XVeDtTm4Uh7+yg0/9CaNq/ooHEEl5ZeF/dvnMMagj6kTPCzZE84vw9aMeiVCBh",
+	"jz9u6uoTaPgpzuHuI86DjpS9tNk6ROBk6JjJf7FKmM3gyoOk792FUtpknhMlRUbdrVrgNgX2SOwZzIFu",
+	"IQ3ky+jT3VA/7FXXqogRXdOYbu2D5AhPCEhtYbi6XKzNjhtL4+U5yKPoeHQXNsSPkCXhrq5N4EwPPnRw",
+	"yWy4oPLcoqQyIDxwlQ/azsZweOB0rahrY8aoD3yKWD90B9ak0k1yxzfm5JgU1+XogmrJePbbzvoYQ64g",
+	"KcKRK4EipSlOmuLkAMVJU5Qciigxii/qFh/WSHwBgnazG7/XlCFNGdKwWzqD0JrSYl+lBRYPKLf5IYpv",
+	"3KpDVCj9kpi/2k9M1Dxp0WO91ZQV/+KyggMdzPOfvVu7f+/LVvEbEZZEi8ABTpzNY0qKkDziIfWkURze",
+	"2XwBSXZIgzAv/tO02pYibRG4BG26+uFTRfsM7aMTG6h+yDh/FSjY+7qQ5iS9n4TuETs55OTXmQOWgB7m",
+	"acq/BpgqLRqqfHhbXS7WIwJTGZBOZUGgutRDXmzKwKYMbMrA3d0kHYx0Op+4Bpq3yUbLR0RZxvpaPVdJ",
+	"IlR8jVDd5jsHQjh4sj0H77pwyDH5W8vnh+525UCWANWguEky+qEmfnnQfgyLIh9g+CSMNoAzr4aOo5z3",
+	"p098HLMp082rrYm8JJE18nj2DH6FJtxGXfyDKYemmb8ckxhX/rd+QsSzVUGJePYGHevyDHUSQdOLXd/R",
+	"brd1cnzt6OwUnh5bE2lRBj61Q87A503KbFLmUUhx88C1Zh2++JroLLMZcM7iT/yZRZGExLXWn3Ehiywq",
+	"5ncjVE1Ns4LlcnX79+qnJ2YHAtgaAIczQrPF2MfyzXHYWxLFXMEAx8ImI0hRG68slIzSKIxBnNcMWDF3",
+	"mq7GeXtOV+8Yt2dRNOMjs2/oiq6+0tU19OKM2eRS67wUga6mwihu2WlVRLByZ43ix9r8FM4fYgQo9kCE",
+	"1F2U16pyyBAYNG59RYenjofnTu7C9ZetovF8qTw7Yryeg1h+c5v8Ddui30buthlMRui6jS0zEE9+poNr",
+	"IBsGyoMxM8LNSGWvNiXa7istjBLWUMf1IdXDrBM1dYYSJ7HoX77662Ei0Xh+H4m8WxiiL1tFlKfecqoP",
+	"Vj+pLE0bf0xAbjaTdjEfQ3p2iUJ1zCg9LS/9akzdgUVb1RKWSlAkvVM9aoRCCC1AMraC666uDc71oPpj",
+	"Uks3yCqRDvRqxJKHeGaY2IhKryBT33NUEQb2ijGmJiMmEIh9R0zzzFrEzQWwAQuUi649x0Hqds0X3K9P",
+	"XYZFAUr3sRUPVTJW4Rk3pDoqO9vR4o90bdQ8bAJlJF5kU1Luk6RENXoRjTFbvhzVBi9NWXiMZSHMbX23",
+	"VV157S8Or4v5RD+QWnrhNQrwRSCqRF7QC6oVKUjKac+8QZHJqG84+hvn2CBVrmRMFaGzwVT7WGkj32EA",
+	"TpP5D8I+S0+5D0ZauoN6ycITx1pL8C37FBizcpNJppU2XZ4YQbWf0CVhSE2I+awSKT+Edcod01s0r00j",
+	"2lrWNVVXX5rV7pmp9dhkS6OkQSZieopDtROzt/8YVnngkp673ANFdBy+DzTyuQgkQC9gw3UM85PD0krz",
+	"hHYdEiGDR9mE4ic1/Qi4FQygcAfeIYarBKHyI6sUrFwhiiryT1rtfl01SdBcR5gvdtN2IyFfp7pu4P9l",
+	"k4hMYtGBtDwQ/WGvDHg9mzwh5kB2IJPGoMgtYl9fKgGSYiKfAVnlhJyTgJCU+wFQMukT6N/6+2LQUw60",
+	"kDX4qt2klwZc9LFQz49Iu4u98LytpJeQEFtDcuwB+rG4C/633uObOmkWJJos1l6x2vxlq4j/axYko+8c",
+	"y2Y4z6ivSouEwXcmKH+uw7IeBbuZINpwZuIcYsGXDxcbya0/J8Qk8PMR2Pfpnd+3dbUISwyo48hYP4yg",
+	"nK483aiuTJrXVYuP1lxWF55uWRenUBYgp6VnmW0Q8rMAORkM4uHIWMo9nNTkHJ5xiBajezqJMBnz2YbU",
+	"pPeLiMBvNNIljGYIQxauJVNd5eC+DRNztPbZ64IflBWQgWuGQyALOIsra7PjlfmNyvQb42khGovmpTTq",
+	"3qbk2ltb02JCSPeLstL+H23/0Ra98cON/z8ALViH0HyHAQA=",
+}
+
+// GetSwagger returns the content of the embedded swagger specification file
+// or error if failed to decode
+func decodeSpec() ([]byte, error) {
+	zipped, err := base64.StdEncoding.DecodeString(strings.Join(swaggerSpec, ""))
+	if err != nil {
+		return nil, fmt.Errorf("error base64 decoding spec: %w", err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(zipped))
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(zr)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+var rawSpec = decodeSpecCached()
+
+// a naive cached of a decoded swagger spec
+func decodeSpecCached() func() ([]byte, error) {
+	data, err := decodeSpec()
+	return func() ([]byte, error) {
+		return data, err
+	}
+}
+
+// Constructs a synthetic filesystem for resolving external references when loading openapi specifications.
+func PathToRawSpec(pathToFile string) map[string]func() ([]byte, error) {
+	res := make(map[string]func() ([]byte, error))
+	if len(pathToFile) > 0 {
+		res[pathToFile] = rawSpec
+	}
+
+	return res
+}
+
+// GetSwagger returns the Swagger specification corresponding to the generated code
+// in this file. The external references of Swagger specification are resolved.
+// The logic of resolving external references is tightly connected to "import-mapping" feature.
+// Externally referenced files must be embedded in the corresponding golang packages.
+// Urls can be supported but this task was out of the scope.
+func GetSwagger() (swagger *openapi3.T, err error) {
+	resolvePath := PathToRawSpec("")
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	loader.ReadFromURIFunc = func(loader *openapi3.Loader, url *url.URL) ([]byte, error) {
+		pathToFile := url.String()
+		pathToFile = path.Clean(pathToFile)
+		getSpec, ok := resolvePath[pathToFile]
+		if !ok {
+			err1 := fmt.Errorf("path not found: %s", pathToFile)
+			return nil, err1
+		}
+		return getSpec()
+	}
+	var specData []byte
+	specData, err = rawSpec()
+	if err != nil {
+		return
+	}
+	swagger, err = loader.LoadFromData(specData)
+	if err != nil {
+		return
+	}
+	return
+}
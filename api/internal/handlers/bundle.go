@@ -0,0 +1,47 @@
+// api/internal/handlers/bundle.go
+package handlers
+
+import (
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	"cafeore-pos/api/internal/models"
+)
+
+func toBundleComponentResponses(components []models.BundleComponent) []models.BundleComponentResponse {
+	responses := make([]models.BundleComponentResponse, len(components))
+	for i, c := range components {
+		responses[i] = models.BundleComponentResponse{
+			ItemId:       openapi_types.UUID(c.ComponentItemID),
+			Name:         c.ComponentItem.Name,
+			Abbr:         c.ComponentItem.Abbr,
+			ItemTypeName: c.ComponentItem.ItemType.Name,
+			Quantity:     c.Quantity,
+		}
+	}
+	return responses
+}
+
+func toOrderItemComponentResponses(components models.OrderItemComponents) []models.BundleComponentResponse {
+	responses := make([]models.BundleComponentResponse, len(components))
+	for i, c := range components {
+		responses[i] = models.BundleComponentResponse{
+			ItemId:       openapi_types.UUID(c.ItemID),
+			Name:         c.Name,
+			Abbr:         c.Abbr,
+			ItemTypeName: c.ItemTypeName,
+			Quantity:     c.Quantity,
+		}
+	}
+	return responses
+}
+
+func toPrepTask(p *models.PrepItem) models.PrepTask {
+	return models.PrepTask{
+		ItemId:       openapi_types.UUID(p.ItemID),
+		Name:         p.Name,
+		Abbr:         p.Abbr,
+		ItemTypeName: p.ItemTypeName,
+		Assignee:     p.Assignee,
+		BundleItemId: (*openapi_types.UUID)(p.BundleItemID),
+	}
+}
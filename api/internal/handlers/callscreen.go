@@ -0,0 +1,153 @@
+// api/internal/handlers/callscreen.go
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	"cafeore-pos/api/internal/callscreen"
+	"cafeore-pos/api/internal/models"
+	"cafeore-pos/api/internal/service"
+)
+
+// 呼び出しの期限切れがなくても呼び出し画面の内容を見直す間隔
+const callscreenRefreshInterval = time.Minute
+
+type CallscreenHandler struct {
+	sessions *service.SessionService
+	orders   *service.OrderService
+	hub      *Hub
+	// 呼び出し画面向けの WebSocket（オーダー一覧とは別に送る）
+	screen *Hub
+	cfg    callscreen.Config
+	wake   chan struct{}
+}
+
+func NewCallscreenHandler(sessions *service.SessionService, orders *service.OrderService, hub *Hub, cfg callscreen.Config) *CallscreenHandler {
+	return &CallscreenHandler{
+		sessions: sessions,
+		orders:   orders,
+		hub:      hub,
+		screen:   NewHub(),
+		cfg:      cfg,
+		wake:     make(chan struct{}, 1),
+	}
+}
+
+// オーダーが変わったことを Run に知らせる
+func (h *CallscreenHandler) Notify() {
+	select {
+	case h.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (h *CallscreenHandler) snapshot(ctx context.Context, requested *openapi_types.UUID) (callscreen.Snapshot, error) {
+	sessionID, err := h.sessions.Resolve(ctx, (*uuid.UUID)(requested))
+	if err != nil {
+		return callscreen.Snapshot{}, err
+	}
+	return h.orders.Callscreen(ctx, sessionID, h.cfg)
+}
+
+// 呼び出し画面の内容が変わるたびに WebSocket で送る
+// 呼び出しの期限切れはオーダーの更新がなくても起きるので、次に切れる時刻に見直す
+func (h *CallscreenHandler) Run() {
+	go h.screen.Run()
+
+	var last []byte
+	for {
+		wait := callscreenRefreshInterval
+		snap, err := h.snapshot(context.Background(), nil)
+		if err != nil {
+			log.Println("failed to build callscreen:", err)
+		} else {
+			// 生成時刻以外が変わったときだけ送る
+			compared := snap.Response
+			compared.GeneratedAt = time.Time{}
+			if key, err := json.Marshal(compared); err == nil && !bytes.Equal(key, last) {
+				last = key
+				h.screen.Broadcast(WSMessage{Type: WSMessageTypeCallscreen, Callscreen: &snap.Response})
+			}
+			if !snap.NextExpiry.IsZero() {
+				if d := time.Until(snap.NextExpiry); d < wait {
+					wait = d
+				}
+			}
+		}
+
+		select {
+		case <-h.wake:
+		case <-time.After(wait):
+		}
+	}
+}
+
+// GET /api/callscreen - 呼び出し画面の表示内容取得
+func (h *CallscreenHandler) GetCallscreen(c *gin.Context, params models.GetCallscreenParams) {
+	snap, err := h.snapshot(c.Request.Context(), params.SessionId)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, snap.Response)
+}
+
+// GET /api/ws/callscreen - 呼び出し画面の WebSocket
+func (h *CallscreenHandler) WSHandler(c *gin.Context) {
+	conn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		return
+	}
+	defer func() {
+		h.screen.Unregister(conn)
+		if err := conn.Close(); err != nil {
+			log.Println("failed to close connection:", err)
+		}
+	}()
+
+	h.screen.Register(conn)
+
+	// 接続直後に現在の内容を送信
+	if snap, err := h.snapshot(c.Request.Context(), nil); err == nil {
+		h.screen.Broadcast(WSMessage{Type: WSMessageTypeCallscreen, Callscreen: &snap.Response})
+	}
+
+	// 接続維持（クライアントからのメッセージは今は無視）
+	for {
+		if _, _, err := conn.ReadMessage(); err != nil {
+			break
+		}
+	}
+}
+
+// 呼び出し・再呼び出しの共通処理
+func (h *CallscreenHandler) updateCall(c *gin.Context, action func(ctx context.Context, id uuid.UUID) (*models.Order, error), id openapi_types.UUID) {
+	order, err := action(c.Request.Context(), uuid.UUID(id))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toOrderResponse(order))
+	broadcastOrderList(h.orders, h.hub)
+	h.Notify()
+}
+
+// POST /api/orders/:id/call - オーダーを呼び出す
+func (h *CallscreenHandler) CallOrder(c *gin.Context, id openapi_types.UUID) {
+	h.updateCall(c, h.orders.Call, id)
+}
+
+// POST /api/orders/:id/recall - オーダーを再呼び出しする
+func (h *CallscreenHandler) RecallOrder(c *gin.Context, id openapi_types.UUID) {
+	h.updateCall(c, h.orders.Recall, id)
+}
@@ -0,0 +1,400 @@
+// api/internal/handlers/cash.go
+package handlers
+
+import (
+	"net/http"
+	"sort"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"gorm.io/gorm"
+
+	"cafeore-pos/api/internal/apierror"
+	"cafeore-pos/api/internal/models"
+	"cafeore-pos/api/internal/payments"
+	"cafeore-pos/api/internal/service"
+)
+
+type CashHandler struct {
+	db       *gorm.DB
+	sessions *service.SessionService
+	cash     *service.CashService
+	drawers  *service.DrawerService
+}
+
+func NewCashHandler(db *gorm.DB, sessions *service.SessionService, cash *service.CashService, drawers *service.DrawerService) *CashHandler {
+	return &CashHandler{db: db, sessions: sessions, cash: cash, drawers: drawers}
+}
+
+// レジごとの現金集計
+type cashSummary struct {
+	SessionID    uuid.UUID
+	Register     string
+	OpeningFloat int
+	CashSales    int
+	PayIns       int
+	PayOuts      int
+	Refunds      int
+}
+
+// レジにあるはずの現金
+func (s *cashSummary) Expected() int {
+	return s.OpeningFloat + s.CashSales + s.PayIns - s.PayOuts - s.Refunds
+}
+
+func toCashSummaryResponse(s *cashSummary) models.CashSummaryResponse {
+	return models.CashSummaryResponse{
+		SessionId:    openapi_types.UUID(s.SessionID),
+		Register:     s.Register,
+		OpeningFloat: s.OpeningFloat,
+		CashSales:    s.CashSales,
+		PayIns:       s.PayIns,
+		PayOuts:      s.PayOuts,
+		Refunds:      s.Refunds,
+		Expected:     s.Expected(),
+	}
+}
+
+func toCashMovementResponse(m *models.CashMovement) models.CashMovementResponse {
+	return models.CashMovementResponse{
+		Id:        openapi_types.UUID(m.ID),
+		SessionId: openapi_types.UUID(m.SessionID),
+		Register:  m.Register,
+		Type:      models.CashMovementResponseType(m.Type),
+		Amount:    m.Amount,
+		Reason:    m.Reason,
+		Author:    m.Author,
+		CreatedAt: m.CreatedAt,
+	}
+}
+
+func toCashCloseoutResponse(co *models.CashCloseout) models.CashCloseoutResponse {
+	denominations := make([]models.DenominationCount, len(co.Denominations))
+	for i, d := range co.Denominations {
+		denominations[i] = models.DenominationCount{Denomination: d.Denomination, Count: d.Count}
+	}
+	sort.Slice(denominations, func(i, j int) bool {
+		return denominations[i].Denomination > denominations[j].Denomination
+	})
+	return models.CashCloseoutResponse{
+		Id:            openapi_types.UUID(co.ID),
+		SessionId:     openapi_types.UUID(co.SessionID),
+		Register:      co.Register,
+		OpeningFloat:  co.OpeningFloat,
+		CashSales:     co.CashSales,
+		PayIns:        co.PayIns,
+		PayOuts:       co.PayOuts,
+		Refunds:       co.Refunds,
+		Expected:      co.Expected,
+		Counted:       co.Counted,
+		Variance:      co.Variance,
+		CountedBy:     co.CountedBy,
+		Denominations: denominations,
+		CreatedAt:     co.CreatedAt,
+	}
+}
+
+// セッション内のレジごとの現金集計を計算する
+// register が nil の場合はセッション内の全レジを対象にする
+func computeCashSummaries(db *gorm.DB, sessionID uuid.UUID, register *string) ([]cashSummary, error) {
+	summaries := map[string]*cashSummary{}
+	get := func(name string) *cashSummary {
+		if s, ok := summaries[name]; ok {
+			return s
+		}
+		s := &cashSummary{SessionID: sessionID, Register: name}
+		summaries[name] = s
+		return s
+	}
+	if register != nil {
+		get(*register)
+	}
+
+	// 現金売上 = お預かり金額 - お釣り
+	var sales []struct {
+		Register string
+		Total    int
+	}
+	salesQuery := db.Model(&models.Order{}).
+		Select("register, COALESCE(SUM(received - change), 0) AS total").
+		Where("session_id = ?", sessionID).
+		Group("register")
+	if register != nil {
+		salesQuery = salesQuery.Where("register = ?", *register)
+	}
+	if err := salesQuery.Scan(&sales).Error; err != nil {
+		return nil, err
+	}
+	for _, row := range sales {
+		get(row.Register).CashSales += row.Total
+	}
+
+	var movements []struct {
+		Register string
+		Type     string
+		Total    int
+	}
+	movementQuery := db.Model(&models.CashMovement{}).
+		Select("register, type, COALESCE(SUM(amount), 0) AS total").
+		Where("session_id = ?", sessionID).
+		Group("register, type")
+	if register != nil {
+		movementQuery = movementQuery.Where("register = ?", *register)
+	}
+	if err := movementQuery.Scan(&movements).Error; err != nil {
+		return nil, err
+	}
+	for _, row := range movements {
+		s := get(row.Register)
+		switch row.Type {
+		case models.CashMovementFloat:
+			s.OpeningFloat += row.Total
+		case models.CashMovementPayIn:
+			s.PayIns += row.Total
+		case models.CashMovementPayOut:
+			s.PayOuts += row.Total
+		}
+	}
+
+	// 現金での返金
+	var refunds []struct {
+		Register string
+		Total    int
+	}
+	refundQuery := db.Model(&models.Refund{}).
+		Select("register, COALESCE(SUM(amount), 0) AS total").
+		Where("session_id = ? AND type = ? AND method = ?", sessionID, models.RefundTypeRefund, payments.MethodCash).
+		Group("register")
+	if register != nil {
+		refundQuery = refundQuery.Where("register = ?", *register)
+	}
+	if err := refundQuery.Scan(&refunds).Error; err != nil {
+		return nil, err
+	}
+	for _, row := range refunds {
+		get(row.Register).Refunds += row.Total
+	}
+
+	result := make([]cashSummary, 0, len(summaries))
+	for _, s := range summaries {
+		result = append(result, *s)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Register < result[j].Register })
+	return result, nil
+}
+
+// session_id を解決する。セッションが一つもない場合は 404
+func (h *CashHandler) sessionFromQuery(c *gin.Context, requested *openapi_types.UUID) (uuid.UUID, bool) {
+	sessionID, err := h.sessions.Resolve(c.Request.Context(), (*uuid.UUID)(requested))
+	if err != nil {
+		c.Error(err)
+		return uuid.Nil, false
+	}
+	if sessionID == nil {
+		c.Error(apierror.New(models.ErrorCodeSessionNotFound, "Session not found"))
+		return uuid.Nil, false
+	}
+	return *sessionID, true
+}
+
+// register クエリを読み取る（省略時・空の場合は nil）
+func registerQuery(register *string) *string {
+	if register == nil || *register == "" {
+		return nil
+	}
+	return register
+}
+
+// GET /api/cash/summary - レジごとの現金集計取得
+func (h *CashHandler) GetCashSummary(c *gin.Context, params models.GetCashSummaryParams) {
+	sessionID, ok := h.sessionFromQuery(c, params.SessionId)
+	if !ok {
+		return
+	}
+
+	summaries, err := computeCashSummaries(h.db, sessionID, registerQuery(params.Register))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	// API型に変換
+	responses := make([]models.CashSummaryResponse, len(summaries))
+	for i, s := range summaries {
+		responses[i] = toCashSummaryResponse(&s)
+	}
+
+	c.JSON(http.StatusOK, responses)
+}
+
+// GET /api/cash/movements - 入出金一覧取得
+func (h *CashHandler) GetCashMovements(c *gin.Context, params models.GetCashMovementsParams) {
+	sessionID, ok := h.sessionFromQuery(c, params.SessionId)
+	if !ok {
+		return
+	}
+
+	query := h.db.Where("session_id = ?", sessionID)
+	if register := registerQuery(params.Register); register != nil {
+		query = query.Where("register = ?", *register)
+	}
+
+	var movements []models.CashMovement
+	if err := query.Order("created_at").Find(&movements).Error; err != nil {
+		c.Error(err)
+		return
+	}
+
+	// API型に変換
+	responses := make([]models.CashMovementResponse, len(movements))
+	for i, m := range movements {
+		responses[i] = toCashMovementResponse(&m)
+	}
+
+	c.JSON(http.StatusOK, responses)
+}
+
+// POST /api/cash/movements - 入出金登録
+func (h *CashHandler) CreateCashMovement(c *gin.Context) {
+	var req models.CreateCashMovementJSONRequestBody
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(apierror.Binding(err))
+		return
+	}
+
+	switch req.Type {
+	case models.CashMovementCreateRequestTypeFloat,
+		models.CashMovementCreateRequestTypePayIn,
+		models.CashMovementCreateRequestTypePayOut:
+	default:
+		c.Error(apierror.Invalid("/type", "Invalid type"))
+		return
+	}
+	if req.Amount <= 0 {
+		c.Error(apierror.Invalid("/amount", "amount must be positive"))
+		return
+	}
+
+	movement := models.CashMovement{
+		Register: models.DefaultRegister,
+		Type:     string(req.Type),
+		Amount:   req.Amount,
+		Author:   req.Author,
+	}
+	if req.Register != nil && *req.Register != "" {
+		movement.Register = *req.Register
+	}
+	if req.Reason != nil {
+		movement.Reason = *req.Reason
+	}
+
+	// 入出金は営業中のセッションに記録する
+	if err := h.cash.RecordMovement(c.Request.Context(), &movement); err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, toCashMovementResponse(&movement))
+}
+
+// GET /api/cash/closeouts - 締め処理レポート一覧取得
+func (h *CashHandler) GetCashCloseouts(c *gin.Context, params models.GetCashCloseoutsParams) {
+	sessionID, ok := h.sessionFromQuery(c, params.SessionId)
+	if !ok {
+		return
+	}
+
+	var closeouts []models.CashCloseout
+	if err := h.db.Preload("Denominations").
+		Where("session_id = ?", sessionID).
+		Order("register").
+		Find(&closeouts).Error; err != nil {
+		c.Error(err)
+		return
+	}
+
+	// API型に変換
+	responses := make([]models.CashCloseoutResponse, len(closeouts))
+	for i, co := range closeouts {
+		responses[i] = toCashCloseoutResponse(&co)
+	}
+
+	c.JSON(http.StatusOK, responses)
+}
+
+// GET /api/cash/closeouts/:id - 締め処理レポート取得
+func (h *CashHandler) GetCashCloseout(c *gin.Context, id openapi_types.UUID) {
+	var closeout models.CashCloseout
+	if err := h.db.Preload("Denominations").First(&closeout, "id = ?", uuid.UUID(id)).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			c.Error(apierror.New(models.ErrorCodeCloseoutNotFound, "Closeout not found"))
+			return
+		}
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toCashCloseoutResponse(&closeout))
+}
+
+// POST /api/cash/closeouts - 締め処理
+// 金種別の実査額と理論値を比較し、差異を含めたレポートを保存する
+func (h *CashHandler) CreateCashCloseout(c *gin.Context) {
+	var req models.CreateCashCloseoutJSONRequestBody
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(apierror.Binding(err))
+		return
+	}
+
+	sessionID, ok := h.sessionFromQuery(c, req.SessionId)
+	if !ok {
+		return
+	}
+
+	register := models.DefaultRegister
+	if req.Register != nil && *req.Register != "" {
+		register = *req.Register
+	}
+
+	counted, err := service.CountDenominations("/denominations", req.Denominations)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	summaries, err := computeCashSummaries(h.db, sessionID, &register)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	summary := summaries[0]
+
+	closeout := models.CashCloseout{
+		SessionID:    sessionID,
+		Register:     register,
+		OpeningFloat: summary.OpeningFloat,
+		CashSales:    summary.CashSales,
+		PayIns:       summary.PayIns,
+		PayOuts:      summary.PayOuts,
+		Refunds:      summary.Refunds,
+		Expected:     summary.Expected(),
+		Counted:      counted,
+		Variance:     counted - summary.Expected(),
+		CountedBy:    req.CountedBy,
+	}
+	for _, dc := range req.Denominations {
+		closeout.Denominations = append(closeout.Denominations, models.CashCloseoutDenomination{
+			Denomination: dc.Denomination,
+			Count:        dc.Count,
+		})
+	}
+	if err := h.cash.Close(c.Request.Context(), &closeout); err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, toCashCloseoutResponse(&closeout))
+}
@@ -3,24 +3,24 @@ package handlers
 
 import (
 	"net/http"
-	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 
-	"gorm.io/gorm"
-
+	"cafeore-pos/api/internal/apierror"
 	"cafeore-pos/api/internal/models"
+	"cafeore-pos/api/internal/service"
 )
 
 type CommentHandler struct {
-	db  *gorm.DB
-	hub *Hub
+	comments *service.CommentService
+	orders   *service.OrderService
+	hub      *Hub
 }
 
-func NewCommentHandler(db *gorm.DB, hub *Hub) *CommentHandler {
-	return &CommentHandler{db: db, hub: hub}
+func NewCommentHandler(comments *service.CommentService, orders *service.OrderService, hub *Hub) *CommentHandler {
+	return &CommentHandler{comments: comments, orders: orders, hub: hub}
 }
 
 func toCommentResponse(comment *models.Comment) models.CommentResponse {
@@ -33,29 +33,10 @@ func toCommentResponse(comment *models.Comment) models.CommentResponse {
 }
 
 // GET /api/orders/:id/comments - 特定オーダーのコメント一覧取得
-func (h *CommentHandler) GetOrderComments(c *gin.Context) {
-	orderID := c.Param("id")
-
-	orderUUID, err := uuid.Parse(orderID)
+func (h *CommentHandler) GetOrderComments(c *gin.Context, id openapi_types.UUID) {
+	comments, err := h.comments.List(c.Request.Context(), uuid.UUID(id))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid order ID format"})
-		return
-	}
-
-	// オーダーが存在するか確認
-	var order models.Order
-	if err := h.db.First(&order, "id = ?", orderUUID).Error; err != nil {
-		if err == gorm.ErrRecordNotFound {
-			c.JSON(http.StatusNotFound, gin.H{"error": "Order not found"})
-			return
-		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-		return
-	}
-
-	var comments []models.Comment
-	if err := h.db.Where("order_id = ?", orderUUID).Order("created_at DESC").Find(&comments).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.Error(err)
 		return
 	}
 
@@ -69,56 +50,20 @@ func (h *CommentHandler) GetOrderComments(c *gin.Context) {
 }
 
 // POST /api/orders/:id/comments - コメント作成
-func (h *CommentHandler) CreateComment(c *gin.Context) {
-	orderID := c.Param("id")
-
-	orderUUID, err := uuid.Parse(orderID)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid order ID format"})
-		return
-	}
-
+func (h *CommentHandler) CreateOrderComment(c *gin.Context, id openapi_types.UUID) {
 	var req models.CreateOrderCommentJSONRequestBody
 
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
-		return
-	}
-
-	// オーダーが存在するか確認
-	var order models.Order
-	if err := h.db.First(&order, "id = ?", orderUUID).Error; err != nil {
-		if err == gorm.ErrRecordNotFound {
-			c.JSON(http.StatusNotFound, gin.H{"error": "Order not found"})
-			return
-		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.Error(apierror.Binding(err))
 		return
 	}
 
-	comment := models.Comment{
-		OrderID:   orderUUID,
-		Author:    req.Author,
-		Text:      req.Text,
-		CreatedAt: time.Now(),
-	}
-
-	if err := h.db.Create(&comment).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	comment, err := h.comments.Create(c.Request.Context(), uuid.UUID(id), req.Author, req.Text)
+	if err != nil {
+		c.Error(err)
 		return
 	}
 
-	c.JSON(http.StatusCreated, toCommentResponse(&comment))
-	var orders []models.Order
-	if err := h.db.Preload("OrderItems.Item.ItemType").Preload("Comments").Find(&orders).Error; err != nil {
-			return
-	}
-	responses := make([]models.OrderResponse, len(orders))
-	for i, o := range orders {
-			responses[i] = toOrderResponse(&o)
-	}
-	h.hub.Broadcast(WSMessage{
-		Type: WSMessageTypeOrders,
-		Orders: responses,
-	})
+	c.JSON(http.StatusCreated, toCommentResponse(comment))
+	broadcastOrderList(h.orders, h.hub)
 }
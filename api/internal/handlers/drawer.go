@@ -0,0 +1,79 @@
+// api/internal/handlers/drawer.go
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	"cafeore-pos/api/internal/apierror"
+	"cafeore-pos/api/internal/models"
+	"cafeore-pos/api/internal/service"
+)
+
+func toDrawerStockResponse(sessionID uuid.UUID, register string, stocks []models.DrawerStock) models.DrawerStockResponse {
+	entries := make([]models.DrawerStockEntry, len(stocks))
+	total := 0
+	for i, s := range stocks {
+		entries[i] = models.DrawerStockEntry{
+			Denomination: s.Denomination,
+			Count:        s.Count,
+			LowThreshold: s.LowThreshold,
+		}
+		total += s.Denomination * s.Count
+	}
+	return models.DrawerStockResponse{
+		SessionId:     openapi_types.UUID(sessionID),
+		Register:      register,
+		Denominations: entries,
+		Total:         total,
+		Warnings:      service.LowStockWarnings(stocks),
+	}
+}
+
+// GET /api/cash/drawer - レジ内の金種別在庫取得
+func (h *CashHandler) GetDrawerStock(c *gin.Context, params models.GetDrawerStockParams) {
+	sessionID, ok := h.sessionFromQuery(c, params.SessionId)
+	if !ok {
+		return
+	}
+
+	register := models.DefaultRegister
+	if r := registerQuery(params.Register); r != nil {
+		register = *r
+	}
+
+	stocks, err := h.drawers.Stocks(c.Request.Context(), sessionID, register)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toDrawerStockResponse(sessionID, register, stocks))
+}
+
+// PUT /api/cash/drawer - レジ内の金種別在庫を登録
+// 指定した金種の枚数を上書きし、未登録の金種は0枚で作成する
+func (h *CashHandler) UpdateDrawerStock(c *gin.Context) {
+	var req models.UpdateDrawerStockJSONRequestBody
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(apierror.Binding(err))
+		return
+	}
+
+	register := models.DefaultRegister
+	if req.Register != nil && *req.Register != "" {
+		register = *req.Register
+	}
+
+	session, stocks, err := h.drawers.Update(c.Request.Context(), register, req.Denominations)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toDrawerStockResponse(session.ID, register, stocks))
+}
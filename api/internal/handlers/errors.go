@@ -0,0 +1,112 @@
+// api/internal/handlers/errors.go
+package handlers
+
+import (
+	"errors"
+	"log"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"cafeore-pos/api/internal/apierror"
+	"cafeore-pos/api/internal/callscreen"
+	"cafeore-pos/api/internal/models"
+	"cafeore-pos/api/internal/repository"
+	"cafeore-pos/api/internal/vouchers"
+)
+
+// 各パッケージのエラーと API のエラーの種類の対応
+var domainErrors = []struct {
+	err  error
+	code apierror.Code
+}{
+	{vouchers.ErrNotFound, models.ErrorCodeVoucherNotFound},
+	{vouchers.ErrAlreadyRedeemed, models.ErrorCodeVoucherAlreadyRedeemed},
+	{vouchers.ErrExpired, models.ErrorCodeVoucherExpired},
+	{callscreen.ErrServed, models.ErrorCodeOrderAlreadyServed},
+	{callscreen.ErrAlreadyCalled, models.ErrorCodeOrderAlreadyCalled},
+	{callscreen.ErrNotCalled, models.ErrorCodeOrderNotCalled},
+	{repository.ErrNotFound, models.ErrorCodeNotFound},
+}
+
+// 一意制約の違反と API のエラーの種類の対応
+// 事前の確認をすり抜けて同時に作成された場合に 500 にしない
+var uniqueViolations = map[string]apierror.Code{
+	"idx_orders_session_order_id":         models.ErrorCodeOrderNumberInUse,
+	"idx_cash_closeouts_session_register": models.ErrorCodeCloseoutExists,
+	"idx_sessions_open":                   models.ErrorCodeSessionAlreadyOpen,
+}
+
+// エラーを API のエラーにする
+func toAPIError(err error) *apierror.Error {
+	var e *apierror.Error
+	if errors.As(err, &e) {
+		return e
+	}
+	for _, d := range domainErrors {
+		if errors.Is(err, d.err) {
+			return apierror.Wrap(d.code, err)
+		}
+	}
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" { // unique_violation
+		if code, ok := uniqueViolations[pgErr.ConstraintName]; ok {
+			return apierror.Wrap(code, err)
+		}
+	}
+	return apierror.From(err)
+}
+
+// エラーのレスポンスを返す
+// 予期しないエラーの内容（GORM・Postgres のメッセージなど）はログにだけ残す
+func writeError(c *gin.Context, err error) {
+	e := toAPIError(err)
+	if e.Code == models.ErrorCodeInternal {
+		log.Printf("%s %s: %v", c.Request.Method, c.Request.URL.Path, err)
+	}
+	c.AbortWithStatusJSON(e.Status, e.Response())
+}
+
+// ハンドラーが c.Error で渡したエラーをレスポンスにするミドルウェア
+// ハンドラーはレスポンスを書かずに c.Error(err) して return する
+func ErrorHandler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+		if len(c.Errors) == 0 || c.Writer.Written() {
+			return
+		}
+		writeError(c, c.Errors.Last().Err)
+	}
+}
+
+// OpenAPI の検証エラーを VALIDATION_FAILED にする
+// 項目はパラメーター名、リクエストボディは JSON Pointer
+func requestValidationError(err error) *apierror.Error {
+	var reqErr *openapi3filter.RequestError
+	if !errors.As(err, &reqErr) {
+		return apierror.Validation(err.Error())
+	}
+
+	detail := models.FieldError{Message: reqErr.Reason}
+	var schemaErr *openapi3.SchemaError
+	if errors.As(reqErr.Err, &schemaErr) {
+		detail.Message = schemaErr.Reason
+	} else if reqErr.Err != nil && detail.Message == "" {
+		detail.Message = reqErr.Err.Error()
+	}
+	switch {
+	case reqErr.Parameter != nil:
+		detail.Field = reqErr.Parameter.Name
+	case reqErr.RequestBody != nil && schemaErr != nil:
+		if pointer := schemaErr.JSONPointer(); len(pointer) > 0 {
+			detail.Field = "/" + strings.Join(pointer, "/")
+		}
+	}
+
+	e := apierror.Validation(reqErr.Error(), detail)
+	e.Err = err
+	return e
+}
@@ -0,0 +1,57 @@
+// api/internal/handlers/export.go
+package handlers
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"cafeore-pos/api/internal/apierror"
+	"cafeore-pos/api/internal/export"
+	"cafeore-pos/api/internal/models"
+)
+
+type ExportHandler struct {
+	db *gorm.DB
+}
+
+func NewExportHandler(db *gorm.DB) *ExportHandler {
+	return &ExportHandler{db: db}
+}
+
+// GET /api/export/:dataset - データのエクスポート
+func (h *ExportHandler) ExportDataset(c *gin.Context, datasetParam models.ExportDatasetParamsDataset, params models.ExportDatasetParams) {
+	dataset, err := export.ParseDataset(string(datasetParam))
+	if err != nil {
+		c.Error(apierror.Invalid("dataset", err.Error()))
+		return
+	}
+
+	format := export.FormatCSV
+	if params.Format != nil {
+		format, err = export.ParseFormat(string(*params.Format))
+		if err != nil {
+			c.Error(apierror.Invalid("format", err.Error()))
+			return
+		}
+	}
+
+	filter := export.Filter{From: params.From, To: params.To}
+	if params.SessionId != nil {
+		sessionID := uuid.UUID(*params.SessionId)
+		filter.SessionID = &sessionID
+	}
+
+	c.Header("Content-Type", format.ContentType())
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, export.Filename(dataset, format)))
+	c.Status(http.StatusOK)
+
+	// 書き出し始めた後はステータスを変えられないのでログに残すだけにする
+	if err := export.Write(c.Request.Context(), h.db, c.Writer, dataset, format, filter); err != nil {
+		log.Printf("export %s failed: %v", dataset, err)
+	}
+}
@@ -0,0 +1,756 @@
+// api/internal/handlers/handler_test.go
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"cafeore-pos/api/internal/callscreen"
+	"cafeore-pos/api/internal/jst"
+	"cafeore-pos/api/internal/models"
+	"cafeore-pos/api/internal/payments"
+	"cafeore-pos/api/internal/receipt"
+	"cafeore-pos/api/internal/repository"
+	"cafeore-pos/api/internal/repository/memory"
+	"cafeore-pos/api/internal/service"
+	"cafeore-pos/api/internal/tracking"
+)
+
+// メモリ上のリポジトリで動くサーバー
+type testServer struct {
+	t      *testing.T
+	engine *gin.Engine
+	store  *memory.Store
+	repos  *repository.Repositories
+	hub    *Hub
+}
+
+func newTestServer(t *testing.T) *testServer {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	store := memory.New()
+	repos := store.Repositories()
+	hub := NewHub()
+	go hub.Run()
+
+	// レスポンスも仕様どおりか確認する
+	validator, err := OpenAPIValidator(ResponseValidationStrict)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := gin.New()
+	r.Use(RateLimitPrefix("/api/track", 5, time.Minute))
+	r.Use(validator)
+	r.Use(ErrorHandler())
+
+	orders := service.NewOrderService(repos, payments.NewRegistry(payments.NewCashProvider()), nil)
+	states := service.NewMasterStateService(repos.MasterStates, repos.Sessions)
+	sessions := service.NewSessionService(repos.Sessions, repos.Orders, repos.Tx)
+	server := &Server{
+		ItemHandler:        NewItemHandler(service.NewItemService(repos.Items, repos.Tx)),
+		ItemTypeHandler:    NewItemTypeHandler(service.NewItemTypeService(repos.ItemTypes)),
+		OrderHandler:       NewOrderHandler(orders, states, hub, nil),
+		CommentHandler:     NewCommentHandler(service.NewCommentService(repos.Comments, repos.Orders), orders, hub),
+		MasterStateHandler: NewMasterStateHandler(states, hub),
+		SessionHandler:     NewSessionHandler(sessions, orders, states, hub),
+		RefundHandler:      NewRefundHandler(service.NewRefundService(repos), sessions, orders, hub),
+		ReportHandler:      NewReportHandler(nil, sessions),
+		ExportHandler:      NewExportHandler(nil),
+		ReceiptHandler:     NewReceiptHandler(service.NewReceiptService(repos.Orders, repos.Receipts), receipt.Config{ShopName: "珈琲・俺", Addressee: "上様"}),
+		CallscreenHandler:  NewCallscreenHandler(sessions, orders, hub, callscreen.Config{Expiry: time.Minute, RecentLimit: 10, NextUpLimit: 10}),
+		QueueHandler:       NewQueueHandler(sessions, orders),
+		CashHandler:        NewCashHandler(nil, sessions, service.NewCashService(repos.CashMovements, repos.Sessions, repos.Tx), service.NewDrawerService(repos.Drawers, repos.Sessions, repos.Tx)),
+		TrackingHandler:    NewTrackingHandler(orders, hub, tracking.Config{DefaultPace: 2 * time.Minute, PaceSamples: 10}, callscreen.Config{Expiry: time.Minute}),
+	}
+	server.Register(r)
+
+	return &testServer{t: t, engine: r, store: store, repos: repos, hub: hub}
+}
+
+// リクエストを送り、ステータスを確認してレスポンスを out に読む（out が nil なら読まない）
+func (s *testServer) do(method, path string, body any, wantStatus int, out any) {
+	s.t.Helper()
+	w := s.send(method, path, body, wantStatus)
+	if out != nil {
+		if err := json.Unmarshal(w.Body.Bytes(), out); err != nil {
+			s.t.Fatalf("%s %s: %v: %s", method, path, err, w.Body.String())
+		}
+	}
+}
+
+// リクエストを送り、ステータスを確認してレスポンスを返す
+func (s *testServer) send(method, path string, body any, wantStatus int) *httptest.ResponseRecorder {
+	s.t.Helper()
+	var reader *bytes.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			s.t.Fatal(err)
+		}
+		reader = bytes.NewReader(data)
+	} else {
+		reader = bytes.NewReader(nil)
+	}
+	req := httptest.NewRequest(method, path, reader)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	w := httptest.NewRecorder()
+	s.engine.ServeHTTP(w, req)
+
+	if w.Code != wantStatus {
+		s.t.Fatalf("%s %s: status %d, want %d: %s", method, path, w.Code, wantStatus, w.Body.String())
+	}
+	return w
+}
+
+// エラーのレスポンスの code を確認する
+func (s *testServer) expectError(method, path string, body any, wantStatus int, wantCode models.ErrorCode) models.ErrorResponse {
+	s.t.Helper()
+	var resp models.ErrorResponse
+	s.do(method, path, body, wantStatus, &resp)
+	if resp.Code != wantCode {
+		s.t.Fatalf("%s %s: code %s, want %s (%s)", method, path, resp.Code, wantCode, resp.Error)
+	}
+	return resp
+}
+
+func (s *testServer) createItemType(name string) models.ItemTypeResponse {
+	s.t.Helper()
+	var resp models.ItemTypeResponse
+	s.do(http.MethodPost, "/api/item-types", models.ItemTypeCreateRequest{Name: name, DisplayName: name}, http.StatusCreated, &resp)
+	return resp
+}
+
+func (s *testServer) createItem(req models.ItemCreateRequest) models.ItemResponse {
+	s.t.Helper()
+	var resp models.ItemResponse
+	s.do(http.MethodPost, "/api/items", req, http.StatusCreated, &resp)
+	return resp
+}
+
+func (s *testServer) openSession() models.Session {
+	s.t.Helper()
+	return s.createSession(nil)
+}
+
+func (s *testServer) createSession(closedAt *time.Time) models.Session {
+	s.t.Helper()
+	session := models.Session{Name: "test", OpenedAt: time.Now(), ClosedAt: closedAt}
+	if err := s.repos.Sessions.Create(context.Background(), &session); err != nil {
+		s.t.Fatal(err)
+	}
+	return session
+}
+
+func (s *testServer) addOrder(session models.Session, orderID int, item models.ItemResponse) models.Order {
+	s.t.Helper()
+	return s.store.AddOrder(models.Order{
+		SessionID:     &session.ID,
+		Register:      "main",
+		OrderId:       orderID,
+		CreatedAt:     time.Now(),
+		TrackingToken: "AB12CD",
+		BillingAmount: item.Price,
+		Received:      item.Price,
+		OrderItems: []models.OrderItem{{
+			ItemID:    uuid.UUID(item.Id),
+			Name:      item.Name,
+			Abbr:      item.Abbr,
+			UnitPrice: item.Price,
+		}},
+	})
+}
+
+func TestItemTypes(t *testing.T) {
+	s := newTestServer(t)
+	milk := s.createItemType("milk")
+	s.createItemType("hot")
+
+	var list []models.ItemTypeResponse
+	s.do(http.MethodGet, "/api/item-types", nil, http.StatusOK, &list)
+	if len(list) != 2 || list[0].Name != "hot" || list[1].Name != "milk" {
+		t.Fatalf("item types = %+v, want hot and milk by name", list)
+	}
+
+	var updated models.ItemTypeResponse
+	s.do(http.MethodPut, "/api/item-types/"+milk.Id.String(), models.ItemTypeUpdateRequest{Name: "milk", DisplayName: "ミルク"}, http.StatusOK, &updated)
+	if updated.DisplayName != "ミルク" {
+		t.Errorf("display_name = %q, want ミルク", updated.DisplayName)
+	}
+
+	s.do(http.MethodDelete, "/api/item-types/"+milk.Id.String(), nil, http.StatusOK, nil)
+	s.expectError(http.MethodGet, "/api/item-types/"+milk.Id.String(), nil, http.StatusNotFound, models.ErrorCodeItemTypeNotFound)
+	s.expectError(http.MethodDelete, "/api/item-types/"+milk.Id.String(), nil, http.StatusNotFound, models.ErrorCodeItemTypeNotFound)
+}
+
+func TestItems(t *testing.T) {
+	s := newTestServer(t)
+	hot := s.createItemType("hot")
+	unavailable := false
+	item := s.createItem(models.ItemCreateRequest{Name: "ブレンド", Abbr: "ブ", Price: 400, Key: "b", ItemTypeId: hot.Id, Available: &unavailable})
+	if item.ItemType.Name != "hot" || item.Available {
+		t.Fatalf("created item = %+v, want item type hot and unavailable", item)
+	}
+
+	// 価格を変えると履歴に残る
+	var updated models.ItemResponse
+	s.do(http.MethodPut, "/api/items/"+item.Id.String(), models.ItemUpdateRequest{Id: item.Id, Name: "ブレンド", Abbr: "ブ", Price: 450, Key: "b", ItemTypeId: hot.Id, Available: &unavailable}, http.StatusOK, &updated)
+	if updated.Price != 450 || updated.Available {
+		t.Errorf("updated item = %+v, want price 450 and still unavailable", updated)
+	}
+	var prices []models.ItemPriceResponse
+	s.do(http.MethodGet, "/api/items/"+item.Id.String()+"/prices", nil, http.StatusOK, &prices)
+	if len(prices) != 2 || prices[0].Price != 400 || prices[1].Price != 450 {
+		t.Errorf("prices = %+v, want 400 then 450", prices)
+	}
+
+	// 予定した価格変更は有効になるまで一覧に反映しない
+	future := time.Now().Add(time.Hour)
+	var scheduled models.ItemPriceResponse
+	s.do(http.MethodPost, "/api/items/"+item.Id.String()+"/prices", models.ItemPriceCreateRequest{Price: 500, EffectiveFrom: &future}, http.StatusCreated, &scheduled)
+	var got models.ItemResponse
+	s.do(http.MethodGet, "/api/items/"+item.Id.String(), nil, http.StatusOK, &got)
+	if got.Price != 450 {
+		t.Errorf("price = %d, want 450 before the scheduled change", got.Price)
+	}
+	s.do(http.MethodDelete, "/api/items/"+item.Id.String()+"/prices/"+scheduled.Id.String(), nil, http.StatusOK, nil)
+	s.expectError(http.MethodDelete, "/api/items/"+item.Id.String()+"/prices/"+prices[0].Id.String(), nil, http.StatusConflict, models.ErrorCodePriceAlreadyEffective)
+
+	s.do(http.MethodDelete, "/api/items/"+item.Id.String(), nil, http.StatusOK, nil)
+	s.expectError(http.MethodGet, "/api/items/"+item.Id.String(), nil, http.StatusNotFound, models.ErrorCodeItemNotFound)
+
+	var list []models.ItemResponse
+	s.do(http.MethodGet, "/api/items", nil, http.StatusOK, &list)
+	if len(list) != 0 {
+		t.Errorf("items = %+v, want none after delete", list)
+	}
+}
+
+func TestItemBundle(t *testing.T) {
+	s := newTestServer(t)
+	food := s.createItemType("others")
+	coffee := s.createItem(models.ItemCreateRequest{Name: "コーヒー", Abbr: "コ", Price: 400, Key: "c", ItemTypeId: food.Id})
+	cake := s.createItem(models.ItemCreateRequest{Name: "ケーキ", Abbr: "ケ", Price: 300, Key: "k", ItemTypeId: food.Id})
+
+	two := 2
+	bundle := s.createItem(models.ItemCreateRequest{
+		Name: "セット", Abbr: "セ", Price: 600, Key: "s", ItemTypeId: food.Id,
+		Components: &[]models.BundleComponentRequest{{ItemId: coffee.Id}, {ItemId: cake.Id, Quantity: &two}},
+	})
+	if bundle.Components == nil || len(*bundle.Components) != 2 {
+		t.Fatalf("components = %+v, want 2", bundle.Components)
+	}
+
+	// セット商品はセット商品を含められない
+	resp := s.expectError(http.MethodPost, "/api/items", models.ItemCreateRequest{
+		Name: "セット2", Abbr: "セ2", Price: 900, Key: "t", ItemTypeId: food.Id,
+		Components: &[]models.BundleComponentRequest{{ItemId: bundle.Id}},
+	}, http.StatusBadRequest, models.ErrorCodeValidationFailed)
+	if resp.Details == nil || (*resp.Details)[0].Field != "/components" {
+		t.Errorf("details = %+v, want /components", resp.Details)
+	}
+
+	// 構成アイテムになっているアイテムはセット商品にできない
+	s.expectError(http.MethodPut, "/api/items/"+coffee.Id.String(), models.ItemUpdateRequest{
+		Id: coffee.Id, Name: "コーヒー", Abbr: "コ", Price: 400, Key: "c", ItemTypeId: food.Id,
+		Components: &[]models.BundleComponentRequest{{ItemId: cake.Id}},
+	}, http.StatusBadRequest, models.ErrorCodeValidationFailed)
+}
+
+func TestOrders(t *testing.T) {
+	s := newTestServer(t)
+	hot := s.createItemType("hot")
+	item := s.createItem(models.ItemCreateRequest{Name: "ブレンド", Abbr: "ブ", Price: 400, Key: "b", ItemTypeId: hot.Id})
+
+	// 営業中のセッションがなければ直近のセッションのオーダーを返す
+	closedAt := time.Now()
+	s.addOrder(s.createSession(&closedAt), 1, item)
+	var list []models.OrderResponse
+	s.do(http.MethodGet, "/api/orders", nil, http.StatusOK, &list)
+	if len(list) != 1 {
+		t.Fatalf("orders = %d, want 1", len(list))
+	}
+
+	// 営業中のセッションのオーダーだけを返す
+	current := s.openSession()
+	order := s.addOrder(current, 1, item)
+	s.do(http.MethodGet, "/api/orders", nil, http.StatusOK, &list)
+	if len(list) != 1 || uuid.UUID(list[0].Id) != order.ID {
+		t.Fatalf("orders = %+v, want only the order of the open session", list)
+	}
+	if len(list[0].Items) != 1 || list[0].Items[0].Item.Name != "ブレンド" {
+		t.Errorf("items = %+v, want ブレンド", list[0].Items)
+	}
+	s.expectError(http.MethodGet, "/api/orders?session_id="+uuid.NewString(), nil, http.StatusNotFound, models.ErrorCodeSessionNotFound)
+	s.expectError(http.MethodGet, "/api/orders?session_id=abc", nil, http.StatusBadRequest, models.ErrorCodeValidationFailed)
+
+	path := "/api/orders/" + order.ID.String()
+	var got models.OrderResponse
+	s.do(http.MethodGet, path, nil, http.StatusOK, &got)
+	if got.OrderId != 1 || got.BillingAmount != 400 {
+		t.Errorf("order = %+v, want order 1 billing 400", got)
+	}
+
+	// 準備完了にすると呼び出し、戻すと呼び出しも取り消す
+	s.do(http.MethodPatch, path+"/ready", nil, http.StatusOK, &got)
+	if got.ReadyAt == nil || got.CalledAt == nil {
+		t.Errorf("ready order = %+v, want ready and called", got)
+	}
+	s.do(http.MethodPatch, path+"/ready", nil, http.StatusOK, &got)
+	if got.ReadyAt != nil || got.CalledAt != nil {
+		t.Errorf("unready order = %+v, want not ready and not called", got)
+	}
+
+	// 提供済みにすると準備完了にもする
+	s.do(http.MethodPatch, path+"/served", nil, http.StatusOK, &got)
+	if got.ServedAt == nil || got.ReadyAt == nil {
+		t.Errorf("served order = %+v, want served and ready", got)
+	}
+	s.do(http.MethodGet, path, nil, http.StatusOK, &got)
+	if got.ServedAt == nil {
+		t.Error("served_at was not saved")
+	}
+
+	s.do(http.MethodPost, path+"/comments", models.CommentCreateRequest{Author: "a", Text: "b"}, http.StatusCreated, nil)
+	s.do(http.MethodDelete, path, nil, http.StatusOK, nil)
+	s.expectError(http.MethodGet, path, nil, http.StatusNotFound, models.ErrorCodeOrderNotFound)
+	s.expectError(http.MethodPatch, path+"/ready", nil, http.StatusNotFound, models.ErrorCodeOrderNotFound)
+	s.expectError(http.MethodDelete, path, nil, http.StatusNotFound, models.ErrorCodeOrderNotFound)
+	s.expectError(http.MethodGet, path+"/comments", nil, http.StatusNotFound, models.ErrorCodeOrderNotFound)
+}
+
+func TestOrderComments(t *testing.T) {
+	s := newTestServer(t)
+	hot := s.createItemType("hot")
+	item := s.createItem(models.ItemCreateRequest{Name: "ブレンド", Abbr: "ブ", Price: 400, Key: "b", ItemTypeId: hot.Id})
+	order := s.addOrder(s.openSession(), 1, item)
+	path := "/api/orders/" + order.ID.String() + "/comments"
+
+	var created models.CommentResponse
+	s.do(http.MethodPost, path, models.CommentCreateRequest{Author: "master", Text: "濃いめ"}, http.StatusCreated, &created)
+	if created.Text != "濃いめ" || uuid.UUID(created.OrderId) != order.ID {
+		t.Errorf("comment = %+v", created)
+	}
+	time.Sleep(time.Millisecond)
+	s.do(http.MethodPost, path, models.CommentCreateRequest{Author: "cashier", Text: "氷なし"}, http.StatusCreated, nil)
+
+	// 新しい順
+	var comments []models.CommentResponse
+	s.do(http.MethodGet, path, nil, http.StatusOK, &comments)
+	if len(comments) != 2 || comments[0].Text != "氷なし" {
+		t.Errorf("comments = %+v, want newest first", comments)
+	}
+
+	var got models.OrderResponse
+	s.do(http.MethodGet, "/api/orders/"+order.ID.String(), nil, http.StatusOK, &got)
+	if got.Comments == nil || len(*got.Comments) != 2 {
+		t.Errorf("order comments = %+v, want 2", got.Comments)
+	}
+
+	s.expectError(http.MethodPost, "/api/orders/"+uuid.NewString()+"/comments", models.CommentCreateRequest{Author: "a", Text: "b"}, http.StatusNotFound, models.ErrorCodeOrderNotFound)
+}
+
+func TestCreateOrder(t *testing.T) {
+	s := newTestServer(t)
+	hot := s.createItemType("hot")
+	item := s.createItem(models.ItemCreateRequest{Name: "ブレンド", Abbr: "ブ", Price: 400, Key: "b", ItemTypeId: hot.Id})
+	items := []models.ItemInfoCreate{{ItemId: item.Id}}
+
+	// 営業中のセッションがなければ作れない
+	s.expectError(http.MethodPost, "/api/orders", models.OrderCreateRequest{BillingAmount: 400, Received: 400, ItemIds: items}, http.StatusConflict, models.ErrorCodeNoOpenSession)
+
+	// オーダー番号を採番し、お釣りと提供待ちの順番を返す
+	session := s.openSession()
+	var created models.OrderResponse
+	s.do(http.MethodPost, "/api/orders", models.OrderCreateRequest{BillingAmount: 400, Received: 1000, ItemIds: items}, http.StatusCreated, &created)
+	if created.OrderId != 1 || created.SessionId == nil || uuid.UUID(*created.SessionId) != session.ID {
+		t.Fatalf("order = %+v, want order 1 in the open session", created)
+	}
+	if created.Change != 600 || created.ChangeBreakdown == nil || len(*created.ChangeBreakdown) != 2 {
+		t.Errorf("change = %d %+v, want 600 as 500 and 100", created.Change, created.ChangeBreakdown)
+	}
+	if created.QueuePosition == nil || *created.QueuePosition != 1 {
+		t.Errorf("queue_position = %v, want 1", created.QueuePosition)
+	}
+	if len(created.Items) != 1 || created.Items[0].Item.Price != 400 {
+		t.Errorf("items = %+v, want ブレンド at 400", created.Items)
+	}
+	if created.Payments == nil || len(*created.Payments) != 1 || (*created.Payments)[0].Status != models.PaymentResponseStatus(payments.StatusApproved) {
+		t.Errorf("payments = %+v, want one approved cash payment", created.Payments)
+	}
+
+	// 請求額が割引後の金額と合わなければ作らない
+	s.expectError(http.MethodPost, "/api/orders", models.OrderCreateRequest{BillingAmount: 300, Received: 400, ItemIds: items}, http.StatusBadRequest, models.ErrorCodeBillingAmountMismatch)
+
+	// 同じセッションでオーダー番号は重複しない
+	one := 1
+	s.expectError(http.MethodPost, "/api/orders", models.OrderCreateRequest{OrderId: &one, BillingAmount: 400, Received: 400, ItemIds: items}, http.StatusConflict, models.ErrorCodeOrderNumberInUse)
+
+	// 割引に使えるオーダーは一度だけ
+	var discounted models.OrderResponse
+	s.do(http.MethodPost, "/api/orders", models.OrderCreateRequest{DiscountOrderId: &one, BillingAmount: 400, Received: 400, ItemIds: items}, http.StatusCreated, &discounted)
+	if discounted.OrderId != 2 || discounted.DiscountOrderId == nil || *discounted.DiscountOrderId != 1 {
+		t.Errorf("order = %+v, want order 2 with discount_order_id 1", discounted)
+	}
+	s.expectError(http.MethodPost, "/api/orders", models.OrderCreateRequest{DiscountOrderId: &one, BillingAmount: 400, Received: 400, ItemIds: items}, http.StatusConflict, models.ErrorCodeDiscountAlreadyUsed)
+
+	var list []models.OrderResponse
+	s.do(http.MethodGet, "/api/orders", nil, http.StatusOK, &list)
+	if len(list) != 2 {
+		t.Errorf("orders = %d, want 2 after the rejected requests", len(list))
+	}
+}
+
+func TestCreateOrderVoucher(t *testing.T) {
+	s := newTestServer(t)
+	hot := s.createItemType("hot")
+	item := s.createItem(models.ItemCreateRequest{Name: "ブレンド", Abbr: "ブ", Price: 400, Key: "b", ItemTypeId: hot.Id})
+	items := []models.ItemInfoCreate{{ItemId: item.Id}}
+	s.openSession()
+
+	voucher := s.store.AddVoucher(models.Voucher{
+		Code:  "ABCD2345",
+		Batch: models.VoucherBatch{Name: "100円引き", Type: string(models.FixedOff), Amount: 100, CreatedAt: time.Now()},
+	})
+	code := "abcd-2345"
+
+	s.expectError(http.MethodPost, "/api/orders", models.OrderCreateRequest{VoucherCode: &code, BillingAmount: 400, Received: 400, ItemIds: items}, http.StatusBadRequest, models.ErrorCodeBillingAmountMismatch)
+	unknown := "ZZZZ-9999"
+	s.expectError(http.MethodPost, "/api/orders", models.OrderCreateRequest{VoucherCode: &unknown, BillingAmount: 300, Received: 300, ItemIds: items}, http.StatusBadRequest, models.ErrorCodeVoucherNotFound)
+
+	// クーポンの割引を適用し、オーダーと一緒に使用済みにする
+	var created models.OrderResponse
+	s.do(http.MethodPost, "/api/orders", models.OrderCreateRequest{VoucherCode: &code, BillingAmount: 300, Received: 300, ItemIds: items}, http.StatusCreated, &created)
+	if created.Discount == nil || *created.Discount != 100 || created.AppliedPromotions == nil || len(*created.AppliedPromotions) != 1 {
+		t.Fatalf("order = %+v, want the voucher discount of 100", created)
+	}
+	redeemed, err := s.repos.Vouchers.FindByCode(context.Background(), code)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if redeemed.ID != voucher.ID || redeemed.RedeemedOrderID == nil || *redeemed.RedeemedOrderID != uuid.UUID(created.Id) {
+		t.Errorf("voucher = %+v, want redeemed by %s", redeemed, created.Id)
+	}
+
+	// 使用済みのクーポンは使えない
+	s.expectError(http.MethodPost, "/api/orders", models.OrderCreateRequest{VoucherCode: &code, BillingAmount: 300, Received: 300, ItemIds: items}, http.StatusConflict, models.ErrorCodeVoucherAlreadyRedeemed)
+
+	// オーダーを消すとクーポンはまた使える
+	s.do(http.MethodDelete, "/api/orders/"+created.Id.String(), nil, http.StatusOK, nil)
+	released, err := s.repos.Vouchers.FindByCode(context.Background(), code)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if released.RedeemedOrderID != nil || released.RedeemedAt != nil {
+		t.Errorf("voucher = %+v, want released after deleting the order", released)
+	}
+	s.do(http.MethodPost, "/api/orders", models.OrderCreateRequest{VoucherCode: &code, BillingAmount: 300, Received: 300, ItemIds: items}, http.StatusCreated, nil)
+}
+
+func TestRefunds(t *testing.T) {
+	s := newTestServer(t)
+	hot := s.createItemType("hot")
+	item := s.createItem(models.ItemCreateRequest{Name: "ブレンド", Abbr: "ブ", Price: 400, Key: "b", ItemTypeId: hot.Id})
+	session := s.openSession()
+	order := s.addOrder(session, 1, item)
+	path := "/api/orders/" + order.ID.String()
+	line := []models.RefundItemRequest{{OrderItemId: order.OrderItems[0].ID}}
+	refund := models.RefundCreateRequest{Type: models.RefundCreateRequestTypeRefund, Reason: "こぼした", Author: "a", Items: &line}
+
+	// 提供前のオーダーは返金できない
+	s.expectError(http.MethodPost, path+"/refunds", refund, http.StatusConflict, models.ErrorCodeOrderNotServed)
+	s.do(http.MethodPatch, path+"/served", nil, http.StatusOK, nil)
+
+	// 金額を省略すると対象の行の単価を返金する
+	var created models.RefundResponse
+	s.do(http.MethodPost, path+"/refunds", refund, http.StatusCreated, &created)
+	if created.Amount != 400 || created.Method != payments.MethodCash || len(created.Items) != 1 || uuid.UUID(created.Items[0].ItemId) != uuid.UUID(item.Id) {
+		t.Errorf("refund = %+v, want 400 in cash for ブレンド", created)
+	}
+	// 同じ行は一度しか対象にできない
+	s.expectError(http.MethodPost, path+"/refunds", refund, http.StatusBadRequest, models.ErrorCodeValidationFailed)
+
+	var list []models.RefundResponse
+	s.do(http.MethodGet, path+"/refunds", nil, http.StatusOK, &list)
+	if len(list) != 1 || list[0].Id != created.Id {
+		t.Errorf("order refunds = %+v, want the created refund", list)
+	}
+	s.do(http.MethodGet, "/api/refunds?session_id="+session.ID.String(), nil, http.StatusOK, &list)
+	if len(list) != 1 {
+		t.Errorf("session refunds = %d, want 1", len(list))
+	}
+	s.expectError(http.MethodGet, "/api/orders/"+uuid.NewString()+"/refunds", nil, http.StatusNotFound, models.ErrorCodeOrderNotFound)
+}
+
+func TestCallscreen(t *testing.T) {
+	s := newTestServer(t)
+	hot := s.createItemType("hot")
+	item := s.createItem(models.ItemCreateRequest{Name: "ブレンド", Abbr: "ブ", Price: 400, Key: "b", ItemTypeId: hot.Id})
+	session := s.openSession()
+	first := s.addOrder(session, 1, item)
+	s.addOrder(session, 2, item)
+
+	path := "/api/orders/" + first.ID.String()
+	s.expectError(http.MethodPost, path+"/recall", nil, http.StatusConflict, models.ErrorCodeOrderNotCalled)
+	var got models.OrderResponse
+	s.do(http.MethodPost, path+"/call", nil, http.StatusOK, &got)
+	if got.CalledAt == nil {
+		t.Fatalf("order = %+v, want called", got)
+	}
+	s.expectError(http.MethodPost, path+"/call", nil, http.StatusConflict, models.ErrorCodeOrderAlreadyCalled)
+	s.do(http.MethodPost, path+"/recall", nil, http.StatusOK, &got)
+	if got.RecallCount == nil || *got.RecallCount != 1 {
+		t.Errorf("recall_count = %v, want 1", got.RecallCount)
+	}
+
+	var screen models.CallscreenResponse
+	s.do(http.MethodGet, "/api/callscreen", nil, http.StatusOK, &screen)
+	if len(screen.Calling) != 1 || screen.Calling[0].OrderNumber != 1 || len(screen.NextUp) != 1 || screen.NextUp[0] != 2 {
+		t.Errorf("callscreen = %+v, want 1 calling and 2 next", screen)
+	}
+
+	s.do(http.MethodPatch, path+"/served", nil, http.StatusOK, nil)
+	s.expectError(http.MethodPost, path+"/recall", nil, http.StatusConflict, models.ErrorCodeOrderAlreadyServed)
+	s.do(http.MethodGet, "/api/callscreen", nil, http.StatusOK, &screen)
+	if len(screen.Calling) != 0 || len(screen.RecentlyServed) != 1 || screen.RecentlyServed[0].OrderNumber != 1 {
+		t.Errorf("callscreen = %+v, want 1 recently served", screen)
+	}
+	s.expectError(http.MethodPost, "/api/orders/"+uuid.NewString()+"/call", nil, http.StatusNotFound, models.ErrorCodeOrderNotFound)
+}
+
+func TestTracking(t *testing.T) {
+	s := newTestServer(t)
+	hot := s.createItemType("hot")
+	item := s.createItem(models.ItemCreateRequest{Name: "ブレンド", Abbr: "ブ", Price: 400, Key: "b", ItemTypeId: hot.Id})
+	session := s.openSession()
+	s.addOrder(session, 1, item)
+	second := s.addOrder(session, 2, item)
+
+	// 追跡コードは大文字・小文字、ハイフンを区別しない
+	var got models.TrackingResponse
+	s.do(http.MethodGet, "/api/track/2?token=ab12-cd", nil, http.StatusOK, &got)
+	if got.Status != models.Preparing || got.QueuePosition == nil || *got.QueuePosition != 2 || got.EstimatedReadyAt == nil {
+		t.Errorf("tracking = %+v, want preparing at 2", got)
+	}
+	// 追跡コードが違う場合はオーダーがない場合と同じにする
+	s.expectError(http.MethodGet, "/api/track/2?token=ZZZZZZ", nil, http.StatusNotFound, models.ErrorCodeOrderNotFound)
+	s.expectError(http.MethodGet, "/api/track/9?token=AB12CD", nil, http.StatusNotFound, models.ErrorCodeOrderNotFound)
+
+	s.do(http.MethodPatch, "/api/orders/"+second.ID.String()+"/ready", nil, http.StatusOK, nil)
+	// 提供可能になったら呼び出すので、待ち順はもう返さない
+	var ready models.TrackingResponse
+	s.do(http.MethodGet, "/api/track/2?token=AB12CD", nil, http.StatusOK, &ready)
+	if ready.Status != models.Calling || ready.QueuePosition != nil || ready.EstimatedReadyAt != nil {
+		t.Errorf("tracking = %+v, want calling", ready)
+	}
+
+	// IP アドレスごとの回数を超えたら Retry-After を付けて断る
+	s.send(http.MethodGet, "/api/track/1?token=AB12CD", nil, http.StatusOK)
+	w := s.send(http.MethodGet, "/api/track/1?token=AB12CD", nil, http.StatusTooManyRequests)
+	var resp models.ErrorResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil || resp.Code != models.ErrorCodeRateLimited {
+		t.Errorf("response = %s, want RATE_LIMITED", w.Body.String())
+	}
+	if w.Header().Get("Retry-After") == "" {
+		t.Error("Retry-After is not set")
+	}
+	// 回数制限は追跡のルートだけ
+	s.do(http.MethodGet, "/api/callscreen", nil, http.StatusOK, nil)
+}
+
+func TestQueue(t *testing.T) {
+	s := newTestServer(t)
+	hot := s.createItemType("hot")
+	ice := s.createItemType("ice")
+	blend := s.createItem(models.ItemCreateRequest{Name: "ブレンド", Abbr: "ブ", Price: 400, Key: "b", ItemTypeId: hot.Id})
+	iced := s.createItem(models.ItemCreateRequest{Name: "アイスブレンド", Abbr: "ア", Price: 400, Key: "a", ItemTypeId: ice.Id})
+	session := s.openSession()
+	first := s.addOrder(session, 1, blend)
+	second := s.addOrder(session, 2, iced)
+	s.addOrder(session, 3, blend)
+
+	// 提供済みのオーダーは列から外し、提供可能のオーダーのカップは数えない
+	s.do(http.MethodPatch, "/api/orders/"+first.ID.String()+"/served", nil, http.StatusOK, nil)
+	s.do(http.MethodPatch, "/api/orders/"+second.ID.String()+"/ready", nil, http.StatusOK, nil)
+
+	var got models.QueueResponse
+	s.do(http.MethodGet, "/api/queue", nil, http.StatusOK, &got)
+	if len(got.Orders) != 2 || got.Orders[0].OrderNumber != 2 || got.Orders[0].Position != 1 || !got.Orders[0].Ready || got.Orders[1].OrderNumber != 3 || got.Orders[1].Position != 2 {
+		t.Errorf("orders = %+v, want 2 (ready) and 3", got.Orders)
+	}
+	if len(got.WaitingCups) != 1 || got.WaitingCups[0].ItemType != "hot" || got.WaitingCups[0].Cups != 1 {
+		t.Errorf("waiting cups = %+v, want 1 hot", got.WaitingCups)
+	}
+	if got.OldestCreatedAt == nil {
+		t.Error("oldest_created_at is not set")
+	}
+}
+
+func TestReceipts(t *testing.T) {
+	s := newTestServer(t)
+	hot := s.createItemType("hot")
+	item := s.createItem(models.ItemCreateRequest{Name: "ブレンド", Abbr: "ブ", Price: 400, Key: "b", ItemTypeId: hot.Id})
+	session := s.openSession()
+	number := func(order models.Order) string {
+		w := s.send(http.MethodGet, "/api/orders/"+order.ID.String()+"/receipt", nil, http.StatusOK)
+		return w.Header().Get("X-Receipt-Number")
+	}
+
+	first := s.addOrder(session, 1, item)
+	second := s.addOrder(session, 2, item)
+	if got := number(first); got != "000001" {
+		t.Errorf("first receipt = %s, want 000001", got)
+	}
+	if got := number(second); got != "000002" {
+		t.Errorf("second receipt = %s, want 000002", got)
+	}
+	// 出し直しは同じ番号
+	if got := number(first); got != "000001" {
+		t.Errorf("reissued receipt = %s, want 000001", got)
+	}
+
+	// 一番新しい番号のオーダーを消しても、その番号は使い回さない
+	s.do(http.MethodDelete, "/api/orders/"+second.ID.String(), nil, http.StatusOK, nil)
+	third := s.addOrder(session, 3, item)
+	if got := number(third); got != "000003" {
+		t.Errorf("receipt after deleting order 2 = %s, want 000003", got)
+	}
+
+	s.expectError(http.MethodGet, "/api/orders/"+uuid.NewString()+"/receipt", nil, http.StatusNotFound, models.ErrorCodeOrderNotFound)
+}
+
+// 集計の SQL はデータベースが必要なので、パラメーターの検証と絞り込み条件だけ確かめる
+func TestReportParams(t *testing.T) {
+	s := newTestServer(t)
+	session := s.openSession()
+
+	s.expectError(http.MethodGet, "/api/reports/summary?session_id="+uuid.NewString(), nil, http.StatusNotFound, models.ErrorCodeSessionNotFound)
+	s.expectError(http.MethodGet, "/api/reports/timeline?interval=30s", nil, http.StatusBadRequest, models.ErrorCodeValidationFailed)
+	s.expectError(http.MethodGet, "/api/reports/throughput?interval=soon", nil, http.StatusBadRequest, models.ErrorCodeValidationFailed)
+
+	from := time.Date(2025, 11, 1, 0, 0, 0, 0, jst.Location)
+	where, args := reportFilter{sessionID: &session.ID, from: &from}.where()
+	if where != "TRUE AND orders.session_id = ? AND orders.created_at >= ?" || len(args) != 2 {
+		t.Errorf("where = %q %v", where, args)
+	}
+
+	interval, err := intervalQuery(nil)
+	if err != nil || interval != defaultReportInterval {
+		t.Errorf("default interval = %v, %v, want %v", interval, err, defaultReportInterval)
+	}
+}
+
+// 書き出しはデータベースが必要なので、書き出す前の検証だけ確かめる
+func TestExportParams(t *testing.T) {
+	s := newTestServer(t)
+	s.expectError(http.MethodGet, "/api/export/payments", nil, http.StatusBadRequest, models.ErrorCodeValidationFailed)
+	s.expectError(http.MethodGet, "/api/export/orders?format=pdf", nil, http.StatusBadRequest, models.ErrorCodeValidationFailed)
+}
+
+func TestMasterStatus(t *testing.T) {
+	s := newTestServer(t)
+
+	// 営業中のセッションがなければ記録できない
+	s.expectError(http.MethodPost, "/api/master-status", models.MasterStateUpdateRequest{Type: "busy"}, http.StatusConflict, models.ErrorCodeNoOpenSession)
+
+	session := s.openSession()
+	s.do(http.MethodPost, "/api/master-status", models.MasterStateUpdateRequest{Type: "busy"}, http.StatusCreated, nil)
+	time.Sleep(time.Millisecond)
+	s.do(http.MethodPost, "/api/master-status", models.MasterStateUpdateRequest{Type: "idle"}, http.StatusCreated, nil)
+
+	// 古い順
+	var states []models.MasterStateResponse
+	s.do(http.MethodGet, "/api/master-status", nil, http.StatusOK, &states)
+	if len(states) != 2 || states[0].Type != "busy" || states[1].Type != "idle" {
+		t.Fatalf("states = %+v, want busy then idle", states)
+	}
+	if states[0].SessionId == nil || uuid.UUID(*states[0].SessionId) != session.ID {
+		t.Errorf("session_id = %v, want %s", states[0].SessionId, session.ID)
+	}
+}
+
+func TestSessions(t *testing.T) {
+	s := newTestServer(t)
+	s.expectError(http.MethodGet, "/api/sessions/current", nil, http.StatusNotFound, models.ErrorCodeNoOpenSession)
+
+	// 釣り銭準備金はデフォルトのレジへの入金として記録する
+	float := 10000
+	var opened models.SessionResponse
+	s.do(http.MethodPost, "/api/sessions", models.SessionCreateRequest{Name: "day 1", OpeningFloat: &float}, http.StatusCreated, &opened)
+	if opened.NextOrderId != 1 || opened.ClosedAt != nil {
+		t.Fatalf("opened session = %+v, want open with next_order_id 1", opened)
+	}
+	movements := s.store.CashMovements()
+	if len(movements) != 1 || movements[0].Type != models.CashMovementFloat || movements[0].Amount != float || movements[0].SessionID != uuid.UUID(opened.Id) {
+		t.Errorf("cash movements = %+v, want the opening float", movements)
+	}
+
+	// 営業中のセッションは一つまで（失敗したら何も記録しない）
+	s.expectError(http.MethodPost, "/api/sessions", models.SessionCreateRequest{Name: "day 2", OpeningFloat: &float}, http.StatusConflict, models.ErrorCodeSessionAlreadyOpen)
+	if got := len(s.store.CashMovements()); got != 1 {
+		t.Errorf("%d cash movements, want 1", got)
+	}
+
+	var current models.SessionResponse
+	s.do(http.MethodGet, "/api/sessions/current", nil, http.StatusOK, &current)
+	if current.Id != opened.Id {
+		t.Errorf("current session = %s, want %s", current.Id, opened.Id)
+	}
+
+	var closed models.SessionResponse
+	s.do(http.MethodPatch, "/api/sessions/"+opened.Id.String()+"/close", nil, http.StatusOK, &closed)
+	if closed.ClosedAt == nil {
+		t.Errorf("closed session = %+v, want closed_at", closed)
+	}
+	s.expectError(http.MethodPatch, "/api/sessions/"+opened.Id.String()+"/close", nil, http.StatusConflict, models.ErrorCodeSessionAlreadyClosed)
+	s.expectError(http.MethodGet, "/api/sessions/"+uuid.NewString(), nil, http.StatusNotFound, models.ErrorCodeSessionNotFound)
+}
+
+// 同時に作成されて一意制約に違反した場合も種類のあるエラーにする
+func TestCashMovements(t *testing.T) {
+	s := newTestServer(t)
+	req := models.CashMovementCreateRequest{Type: models.CashMovementCreateRequestTypePayOut, Amount: 500, Author: "ながい"}
+	s.expectError(http.MethodPost, "/api/cash/movements", req, http.StatusConflict, models.ErrorCodeNoOpenSession)
+
+	session := s.openSession()
+	var got models.CashMovementResponse
+	s.do(http.MethodPost, "/api/cash/movements", req, http.StatusCreated, &got)
+	if uuid.UUID(got.SessionId) != session.ID || got.Register != models.DefaultRegister || got.Amount != 500 {
+		t.Errorf("movement = %+v, want a pay-out in the open session", got)
+	}
+	if movements := s.store.CashMovements(); len(movements) != 1 || movements[0].ID != uuid.UUID(got.Id) {
+		t.Errorf("cash movements = %+v", movements)
+	}
+
+	req.Amount = 0
+	s.expectError(http.MethodPost, "/api/cash/movements", req, http.StatusBadRequest, models.ErrorCodeValidationFailed)
+}
+
+func TestUniqueViolation(t *testing.T) {
+	for constraint, want := range map[string]models.ErrorCode{
+		"idx_orders_session_order_id":         models.ErrorCodeOrderNumberInUse,
+		"idx_cash_closeouts_session_register": models.ErrorCodeCloseoutExists,
+		"idx_sessions_open":                   models.ErrorCodeSessionAlreadyOpen,
+	} {
+		err := fmt.Errorf("create: %w", &pgconn.PgError{Code: "23505", ConstraintName: constraint})
+		if e := toAPIError(err); e.Code != want || e.Status != http.StatusConflict {
+			t.Errorf("%s: error = %s %d, want %s 409", constraint, e.Code, e.Status, want)
+		}
+	}
+	other := &pgconn.PgError{Code: "23505", ConstraintName: "idx_vouchers_code"}
+	if e := toAPIError(other); e.Code != models.ErrorCodeInternal {
+		t.Fatalf("error = %s, want INTERNAL", e.Code)
+	}
+}
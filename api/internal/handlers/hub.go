@@ -11,13 +11,16 @@ import (
 type Hub struct {
 	clients   map[*websocket.Conn]bool
 	broadcast chan WSMessage
-	mu        sync.Mutex
+	// ブロードキャストのたびに閉じて作り直す（WebSocket 以外で変化を待つ場合に使う）
+	changed chan struct{}
+	mu      sync.Mutex
 }
 
 func NewHub() *Hub {
 	return &Hub{
 		clients:   make(map[*websocket.Conn]bool),
 		broadcast: make(chan WSMessage, 10),
+		changed:   make(chan struct{}),
 	}
 }
 
@@ -32,10 +35,19 @@ func (h *Hub) Run() {
 				delete(h.clients, conn)
 			}
 		}
+		close(h.changed)
+		h.changed = make(chan struct{})
 		h.mu.Unlock()
 	}
 }
 
+// 次のブロードキャストで閉じられるチャネル
+func (h *Hub) Changed() <-chan struct{} {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.changed
+}
+
 func (h *Hub) Register(conn *websocket.Conn) {
 	h.mu.Lock()
 	h.clients[conn] = true
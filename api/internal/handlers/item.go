@@ -7,37 +7,44 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	openapi_types "github.com/oapi-codegen/runtime/types"
-	"gorm.io/gorm"
 
+	"cafeore-pos/api/internal/apierror"
 	"cafeore-pos/api/internal/models"
+	"cafeore-pos/api/internal/service"
 )
 
 type ItemHandler struct {
-	db *gorm.DB
+	items *service.ItemService
 }
 
-func NewItemHandler(db *gorm.DB) *ItemHandler {
-	return &ItemHandler{db: db}
+func NewItemHandler(items *service.ItemService) *ItemHandler {
+	return &ItemHandler{items: items}
 }
 
 // DB models → API models 変換関数
 func toItemResponse(item *models.Item) models.ItemResponse {
 	resp := models.ItemResponse{
-		Id: openapi_types.UUID(item.ID),
-		Name: item.Name,
-		Abbr: item.Abbr,
-		Price: item.Price,
-		Key:  item.Key,
-		ItemType: toItemTypeResponse(&item.ItemType),
+		Id:        openapi_types.UUID(item.ID),
+		Name:      item.Name,
+		Abbr:      item.Abbr,
+		Price:     item.Price,
+		Key:       item.Key,
+		ItemType:  toItemTypeResponse(&item.ItemType),
+		Available: item.Available,
+	}
+	if len(item.Components) > 0 {
+		components := toBundleComponentResponses(item.Components)
+		resp.Components = &components
 	}
 	return resp
 }
 
 // GET /api/items - アイテム一覧取得
 func (h *ItemHandler) GetItems(c *gin.Context) {
-	var items []models.Item
-	if err := h.db.Preload("ItemType").Find(&items).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	// 予定された価格変更を反映済み
+	items, err := h.items.List(c.Request.Context())
+	if err != nil {
+		c.Error(err)
 		return
 	}
 	// API型に変換
@@ -54,137 +61,69 @@ func (h *ItemHandler) CreateItem(c *gin.Context) {
 	var req models.CreateItemJSONRequestBody
 
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		c.Error(apierror.Binding(err))
 		return
 	}
 
-	// API型 → DB型に変換
-	item := models.Item{
-		Name:     req.Name,
-		Abbr:     req.Abbr,
-		Price:    req.Price,
-		Key:      req.Key,
-	}
-
-	// タイプの関連付け
-	itemTypeID, err := uuid.Parse(req.ItemTypeId.String())
+	// API型 → サービスの入力に変換
+	item, err := h.items.Create(c.Request.Context(), service.ItemInput{
+		Name:       req.Name,
+		Abbr:       req.Abbr,
+		Price:      req.Price,
+		Key:        req.Key,
+		ItemTypeID: uuid.UUID(req.ItemTypeId),
+		Available:  req.Available,
+		Components: req.Components,
+	})
 	if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid itemType ID format"})
-			return
-	}
-	item.ItemTypeID = itemTypeID
-
-	if err := h.db.Create(&item).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-		return
-	}
-
-	// 関連データをロード
-	if err := h.db.Preload("ItemType").First(&item, "id = ?", item.ID).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.Error(err)
 		return
 	}
 
-	c.JSON(http.StatusCreated, toItemResponse(&item))
+	c.JSON(http.StatusCreated, toItemResponse(item))
 }
 
 // GET /api/items/:id - アイテム取得
-func (h *ItemHandler) GetItem(c *gin.Context) {
-	id := c.Param("id")
-	
-	itemID, err := uuid.Parse(id)
+func (h *ItemHandler) GetItem(c *gin.Context, id openapi_types.UUID) {
+	item, err := h.items.Get(c.Request.Context(), uuid.UUID(id))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
+		c.Error(err)
 		return
 	}
 
-	var item models.Item
-	if err := h.db.Preload("ItemType").First(&item, "id = ?", itemID).Error; err != nil {
-		if err == gorm.ErrRecordNotFound {
-			c.JSON(http.StatusNotFound, gin.H{"error": "Item not found"})
-			return
-		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-		return
-	}
-
-	c.JSON(http.StatusOK, toItemResponse(&item))
+	c.JSON(http.StatusOK, toItemResponse(item))
 }
 
 // PUT /api/items/:id - アイテム更新
-func (h *ItemHandler) UpdateItem(c *gin.Context) {
-	id := c.Param("id")
-	
-	itemID, err := uuid.Parse(id)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
-		return
-	}
-
+func (h *ItemHandler) UpdateItem(c *gin.Context, id openapi_types.UUID) {
 	var req models.UpdateItemJSONRequestBody
 
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
-		return
-	}
-
-	var item models.Item
-	if err := h.db.First(&item, "id = ?", itemID).Error; err != nil {
-		if err == gorm.ErrRecordNotFound {
-			c.JSON(http.StatusNotFound, gin.H{"error": "Item not found"})
-			return
-		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.Error(apierror.Binding(err))
 		return
 	}
 
-	// 更新
-	item.Name = req.Name
-	item.Abbr = req.Abbr
-	item.Price = req.Price
-	item.Key = req.Key
-
-	// タイプの更新
-	itemTypeID, err := uuid.Parse(req.ItemTypeId.String())
-
+	item, err := h.items.Update(c.Request.Context(), uuid.UUID(id), service.ItemInput{
+		Name:       req.Name,
+		Abbr:       req.Abbr,
+		Price:      req.Price,
+		Key:        req.Key,
+		ItemTypeID: uuid.UUID(req.ItemTypeId),
+		Available:  req.Available,
+		Components: req.Components,
+	})
 	if err != nil {
-    c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid itemType ID format"})
-    return
-	}
-	item.ItemTypeID = itemTypeID
-
-	if err := h.db.Save(&item).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-		return
-	}
-
-	// 更新後のデータをロード
-	if err := h.db.Preload("ItemType").First(&item, "id = ?", item.ID).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.Error(err)
 		return
 	}
 
-	c.JSON(http.StatusOK, toItemResponse(&item))
+	c.JSON(http.StatusOK, toItemResponse(item))
 }
 
 // DELETE /api/items/:id - アイテム削除
-func (h *ItemHandler) DeleteItem(c *gin.Context) {
-	id := c.Param("id")
-	
-	itemID, err := uuid.Parse(id)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
-		return
-	}
-
-	result := h.db.Delete(&models.Item{}, "id = ?", itemID)
-	if result.Error != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": result.Error.Error()})
-		return
-	}
-
-	if result.RowsAffected == 0 {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Item not found"})
+func (h *ItemHandler) DeleteItem(c *gin.Context, id openapi_types.UUID) {
+	if err := h.items.Delete(c.Request.Context(), uuid.UUID(id)); err != nil {
+		c.Error(err)
 		return
 	}
 
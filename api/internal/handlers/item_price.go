@@ -0,0 +1,82 @@
+// api/internal/handlers/item_price.go
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	"cafeore-pos/api/internal/apierror"
+	"cafeore-pos/api/internal/models"
+)
+
+func toItemPriceResponse(price *models.ItemPrice, now time.Time) models.ItemPriceResponse {
+	resp := models.ItemPriceResponse{
+		Id:            openapi_types.UUID(price.ID),
+		ItemId:        openapi_types.UUID(price.ItemID),
+		Price:         price.Price,
+		EffectiveFrom: price.EffectiveFrom,
+		Scheduled:     price.EffectiveFrom.After(now),
+		CreatedAt:     price.CreatedAt,
+	}
+	if price.Reason != "" {
+		resp.Reason = &price.Reason
+	}
+	return resp
+}
+
+// GET /api/items/:id/prices - アイテムの価格履歴・予定取得
+func (h *ItemHandler) GetItemPrices(c *gin.Context, id openapi_types.UUID) {
+	prices, err := h.items.Prices(c.Request.Context(), uuid.UUID(id))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	// API型に変換
+	now := time.Now()
+	responses := make([]models.ItemPriceResponse, len(prices))
+	for i, price := range prices {
+		responses[i] = toItemPriceResponse(&price, now)
+	}
+
+	c.JSON(http.StatusOK, responses)
+}
+
+// POST /api/items/:id/prices - 価格変更の登録
+// effective_from を未来にすると、その時刻に自動で価格が切り替わる
+func (h *ItemHandler) CreateItemPrice(c *gin.Context, id openapi_types.UUID) {
+	var req models.CreateItemPriceJSONRequestBody
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(apierror.Binding(err))
+		return
+	}
+
+	reason := ""
+	if req.Reason != nil {
+		reason = *req.Reason
+	}
+
+	now := time.Now()
+	price, err := h.items.SchedulePrice(c.Request.Context(), uuid.UUID(id), req.Price, req.EffectiveFrom, reason)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, toItemPriceResponse(price, now))
+}
+
+// DELETE /api/items/:id/prices/:price_id - 予定された価格変更の取り消し
+func (h *ItemHandler) DeleteItemPrice(c *gin.Context, id openapi_types.UUID, priceID openapi_types.UUID) {
+	if err := h.items.CancelPrice(c.Request.Context(), uuid.UUID(id), uuid.UUID(priceID)); err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Price deleted successfully"})
+}
@@ -4,20 +4,21 @@ package handlers
 import (
 	"net/http"
 
+	"cafeore-pos/api/internal/apierror"
 	"cafeore-pos/api/internal/models"
+	"cafeore-pos/api/internal/service"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	openapi_types "github.com/oapi-codegen/runtime/types"
-	"gorm.io/gorm"
 )
 
 type ItemTypeHandler struct {
-	db *gorm.DB
+	itemTypes *service.ItemTypeService
 }
 
-func NewItemTypeHandler(db *gorm.DB) *ItemTypeHandler {
-	return &ItemTypeHandler{db: db}
+func NewItemTypeHandler(itemTypes *service.ItemTypeService) *ItemTypeHandler {
+	return &ItemTypeHandler{itemTypes: itemTypes}
 }
 
 func toItemTypeResponse(itemType *models.ItemType) models.ItemTypeResponse {
@@ -30,9 +31,9 @@ func toItemTypeResponse(itemType *models.ItemType) models.ItemTypeResponse {
 
 // GET /api/item-types - ItemType一覧取得
 func (h *ItemTypeHandler) GetItemTypes(c *gin.Context) {
-	var itemTypes []models.ItemType
-	if err := h.db.Order("name").Find(&itemTypes).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	itemTypes, err := h.itemTypes.List(c.Request.Context())
+	if err != nil {
+		c.Error(err)
 		return
 	}
 
@@ -50,109 +51,52 @@ func (h *ItemTypeHandler) CreateItemType(c *gin.Context) {
 	var req models.CreateItemTypeJSONRequestBody
 
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		c.Error(apierror.Binding(err))
 		return
 	}
 
-	itemType := models.ItemType{
-		Name:        req.Name,
-		DisplayName: req.DisplayName,
-	}
-
-	if err := h.db.Create(&itemType).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	itemType, err := h.itemTypes.Create(c.Request.Context(), req.Name, req.DisplayName)
+	if err != nil {
+		c.Error(err)
 		return
 	}
 
-	c.JSON(http.StatusCreated, toItemTypeResponse(&itemType))
+	c.JSON(http.StatusCreated, toItemTypeResponse(itemType))
 }
 
 // GET /api/item-types/:id - idからアイテムタイプ取得
-func (h *ItemTypeHandler) GetItemType(c *gin.Context) {
-	id := c.Param("id")
-	
-	itemTypeID, err := uuid.Parse(id)
+func (h *ItemTypeHandler) GetItemType(c *gin.Context, id openapi_types.UUID) {
+	itemType, err := h.itemTypes.Get(c.Request.Context(), uuid.UUID(id))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
-		return
-	}
-
-	var itemType models.ItemType
-	if err := h.db.First(&itemType, "id = ?", itemTypeID).Error; err != nil {
-		if err == gorm.ErrRecordNotFound {
-			c.JSON(http.StatusNotFound, gin.H{"error": "ItemType not found"})
-			return
-		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.Error(err)
 		return
 	}
 
-	c.JSON(http.StatusOK, toItemTypeResponse(&itemType))
+	c.JSON(http.StatusOK, toItemTypeResponse(itemType))
 }
 
 // PUT /api/item-types/:id - アイテムタイプ更新
-func (h *ItemTypeHandler) UpdateItemType(c *gin.Context) {
-	id := c.Param("id")
-	
-	itemTypeID, err := uuid.Parse(id)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
-		return
-	}
-
+func (h *ItemTypeHandler) UpdateItemType(c *gin.Context, id openapi_types.UUID) {
 	var req models.ItemTypeUpdateRequest
 
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
-		return
-	}
-
-	var itemType models.ItemType
-	if err := h.db.First(&itemType, "id = ?", itemTypeID).Error; err != nil {
-		if err == gorm.ErrRecordNotFound {
-			c.JSON(http.StatusNotFound, gin.H{"error": "Item not found"})
-			return
-		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.Error(apierror.Binding(err))
 		return
 	}
 
-	// 更新
-	itemType.Name = req.Name
-	itemType.DisplayName = req.DisplayName
-
-	if err := h.db.Save(&itemType).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-		return
-	}
-
-	// 更新後のデータをロード
-	if err := h.db.First(&itemType, "id = ?", itemType.ID).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	itemType, err := h.itemTypes.Update(c.Request.Context(), uuid.UUID(id), req.Name, req.DisplayName)
+	if err != nil {
+		c.Error(err)
 		return
 	}
 
-	c.JSON(http.StatusOK, toItemTypeResponse(&itemType))
+	c.JSON(http.StatusOK, toItemTypeResponse(itemType))
 }
 
 // DELETE /api/item-types/:id - アイテムタイプ削除
-func (h *ItemTypeHandler) DeleteItemType(c *gin.Context) {
-	id := c.Param("id")
-	
-	itemTypeID, err := uuid.Parse(id)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
-		return
-	}
-
-	result := h.db.Delete(&models.ItemType{}, "id = ?", itemTypeID)
-	if result.Error != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": result.Error.Error()})
-		return
-	}
-
-	if result.RowsAffected == 0 {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Item not found"})
+func (h *ItemTypeHandler) DeleteItemType(c *gin.Context, id openapi_types.UUID) {
+	if err := h.itemTypes.Delete(c.Request.Context(), uuid.UUID(id)); err != nil {
+		c.Error(err)
 		return
 	}
 
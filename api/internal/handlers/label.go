@@ -0,0 +1,65 @@
+// api/internal/handlers/label.go
+package handlers
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"gorm.io/gorm"
+
+	"cafeore-pos/api/internal/apierror"
+	"cafeore-pos/api/internal/escpos"
+	"cafeore-pos/api/internal/models"
+	"cafeore-pos/api/internal/printing"
+)
+
+type LabelHandler struct {
+	db       *gorm.DB
+	renderer *escpos.Renderer
+}
+
+func NewLabelHandler(db *gorm.DB, renderer *escpos.Renderer) *LabelHandler {
+	return &LabelHandler{db: db, renderer: renderer}
+}
+
+// GET /api/orders/:id/labels - ラベル・レシートの ESC/POS データ取得
+func (h *LabelHandler) GetOrderLabels(c *gin.Context, id openapi_types.UUID, params models.GetOrderLabelsParams) {
+	orderID := uuid.UUID(id)
+
+	kind := models.Labels
+	if params.Kind != nil {
+		kind = *params.Kind
+	}
+	if kind != models.Labels && kind != models.Receipt {
+		c.Error(apierror.Invalid("kind", fmt.Sprintf("Unknown kind: %s", kind)))
+		return
+	}
+
+	order, err := printing.LoadOrder(h.db, orderID)
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			c.Error(apierror.New(models.ErrorCodeOrderNotFound, "Order not found"))
+			return
+		}
+		c.Error(err)
+		return
+	}
+
+	var buf bytes.Buffer
+	if kind == models.Receipt {
+		err = h.renderer.RenderReceipt(&buf, order)
+	} else {
+		err = h.renderer.RenderLabels(&buf, order)
+	}
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="order-%d-%s.bin"`, order.OrderId, kind))
+	c.Data(http.StatusOK, "application/octet-stream", buf.Bytes())
+}
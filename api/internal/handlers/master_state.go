@@ -2,36 +2,40 @@
 package handlers
 
 import (
+	"context"
 	"net/http"
-	"time"
 
+	"cafeore-pos/api/internal/apierror"
 	"cafeore-pos/api/internal/models"
+	"cafeore-pos/api/internal/service"
 
 	"github.com/gin-gonic/gin"
-	"gorm.io/gorm"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 type MasterStateHandler struct {
-	db *gorm.DB
-	hub *Hub
+	states *service.MasterStateService
+	hub    *Hub
 }
 
-func NewMasterStateHandler(db *gorm.DB) *MasterStateHandler {
-	return &MasterStateHandler{db: db}
+func NewMasterStateHandler(states *service.MasterStateService, hub *Hub) *MasterStateHandler {
+	return &MasterStateHandler{states: states, hub: hub}
 }
 
 func toMasterStateResponse(masterState *models.MasterState) models.MasterStateResponse {
 	return models.MasterStateResponse{
 		CreatedAt: masterState.CreatedAt,
-		Type:    masterState.Type,
+		Type:      masterState.Type,
+		SessionId: (*openapi_types.UUID)(masterState.SessionID),
 	}
 }
 
 // GET /api/master-status - オーダー状態取得
-func (h *MasterStateHandler) GetMasterStatus(c *gin.Context) {
-	var masterStatus []models.MasterState
-	if err := h.db.Find(&masterStatus).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+func (h *MasterStateHandler) GetMasterStatus(c *gin.Context, params models.GetMasterStatusParams) {
+	masterStatus, err := h.states.List(c.Request.Context(), (*uuid.UUID)(params.SessionId))
+	if err != nil {
+		c.Error(err)
 		return
 	}
 
@@ -49,38 +53,33 @@ func (h *MasterStateHandler) UpdateMasterStatus(c *gin.Context) {
 	var req models.MasterStateUpdateRequest
 
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		c.Error(apierror.Binding(err))
 		return
 	}
 
-	state := models.MasterState{
-		Type:      req.Type,
-		CreatedAt: time.Now(),
-	}
-
-	if err := h.db.Create(&state).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	// マスターステートは営業中のセッションに紐づける
+	state, err := h.states.Create(c.Request.Context(), req.Type)
+	if err != nil {
+		c.Error(err)
 		return
 	}
 
-	c.JSON(http.StatusCreated, state)
+	c.JSON(http.StatusCreated, toMasterStateResponse(state))
 	h.broadcastMasterState()
 }
 
 func (h *MasterStateHandler) broadcastMasterState() {
-	var state models.MasterState
+	broadcastCurrentMasterState(h.states, h.hub)
+}
 
-	if err := h.db.
-		Order("created_at DESC").
-		First(&state).Error; err != nil {
-		if err == gorm.ErrRecordNotFound {
-			return
-		}
+// 営業中 or 直近のセッションの最新のマスターステートをブロードキャストする
+func broadcastCurrentMasterState(states *service.MasterStateService, hub *Hub) {
+	state, err := states.Current(context.Background())
+	if err != nil || state == nil {
 		return
 	}
-
-	h.hub.Broadcast(WSMessage{
+	hub.Broadcast(WSMessage{
 		Type:        WSMessageTypeMasterState,
-		MasterState: &state,
+		MasterState: state,
 	})
 }
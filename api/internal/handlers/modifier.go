@@ -0,0 +1,291 @@
+// api/internal/handlers/modifier.go
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"gorm.io/gorm"
+
+	"cafeore-pos/api/internal/apierror"
+	"cafeore-pos/api/internal/models"
+	"cafeore-pos/api/internal/repository"
+)
+
+type ModifierHandler struct {
+	db        *gorm.DB
+	modifiers repository.ModifierRepository
+}
+
+func NewModifierHandler(db *gorm.DB, modifiers repository.ModifierRepository) *ModifierHandler {
+	return &ModifierHandler{db: db, modifiers: modifiers}
+}
+
+// DB models → API models 変換関数
+func toModifierGroupResponse(group *models.ModifierGroup) models.ModifierGroupResponse {
+	modifiers := make([]models.ModifierResponse, len(group.Modifiers))
+	for i, m := range group.Modifiers {
+		modifiers[i] = models.ModifierResponse{
+			Id:         openapi_types.UUID(m.ID),
+			Name:       m.Name,
+			PriceDelta: m.PriceDelta,
+		}
+	}
+	return models.ModifierGroupResponse{
+		Id:          openapi_types.UUID(group.ID),
+		Name:        group.Name,
+		DisplayName: group.DisplayName,
+		MinSelect:   group.MinSelect,
+		MaxSelect:   group.MaxSelect,
+		ItemId:      (*openapi_types.UUID)(group.ItemID),
+		ItemTypeId:  (*openapi_types.UUID)(group.ItemTypeID),
+		Modifiers:   modifiers,
+	}
+}
+
+func toSelectedModifierResponses(modifiers models.OrderItemModifiers) []models.SelectedModifier {
+	responses := make([]models.SelectedModifier, len(modifiers))
+	for i, m := range modifiers {
+		responses[i] = models.SelectedModifier{
+			ModifierId: openapi_types.UUID(m.ModifierID),
+			GroupName:  m.GroupName,
+			Name:       m.Name,
+			PriceDelta: m.PriceDelta,
+		}
+	}
+	return responses
+}
+
+func preloadModifiers(db *gorm.DB) *gorm.DB {
+	return db.Preload("Modifiers", func(db *gorm.DB) *gorm.DB { return db.Order("name") })
+}
+
+// リクエストの内容をグループに反映する（選択肢は含まない）
+func applyModifierGroupRequest(group *models.ModifierGroup, req *models.ModifierGroupRequest) error {
+	if (req.ItemId == nil) == (req.ItemTypeId == nil) {
+		return apierror.Invalid("/item_id", "Exactly one of item_id and item_type_id is required")
+	}
+	group.Name = req.Name
+	group.DisplayName = req.Name
+	if req.DisplayName != nil && *req.DisplayName != "" {
+		group.DisplayName = *req.DisplayName
+	}
+	group.MinSelect = 0
+	if req.MinSelect != nil {
+		group.MinSelect = *req.MinSelect
+	}
+	group.MaxSelect = 1
+	if req.MaxSelect != nil {
+		group.MaxSelect = *req.MaxSelect
+	}
+	if group.MinSelect < 0 || group.MaxSelect < 0 || (group.MaxSelect > 0 && group.MinSelect > group.MaxSelect) {
+		return apierror.Invalid("/min_select", "Invalid min_select / max_select")
+	}
+	if group.MinSelect > len(req.Modifiers) {
+		return apierror.Invalid("/min_select", "min_select exceeds the number of modifiers")
+	}
+	group.ItemID = (*uuid.UUID)(req.ItemId)
+	group.ItemTypeID = (*uuid.UUID)(req.ItemTypeId)
+
+	names := map[string]bool{}
+	for i, m := range req.Modifiers {
+		if m.Name == "" {
+			return apierror.Invalid(fmt.Sprintf("/modifiers/%d/name", i), "modifier name is required")
+		}
+		if names[m.Name] {
+			return apierror.Invalid(fmt.Sprintf("/modifiers/%d/name", i), fmt.Sprintf("modifier %q is defined more than once", m.Name))
+		}
+		names[m.Name] = true
+	}
+	return nil
+}
+
+func priceDelta(req models.ModifierRequest) int {
+	if req.PriceDelta == nil {
+		return 0
+	}
+	return *req.PriceDelta
+}
+
+// GET /api/modifier-groups - 選択肢グループ一覧取得
+func (h *ModifierHandler) GetModifierGroups(c *gin.Context) {
+	var groups []models.ModifierGroup
+	if err := preloadModifiers(h.db).Order("name").Find(&groups).Error; err != nil {
+		c.Error(err)
+		return
+	}
+
+	// API型に変換
+	responses := make([]models.ModifierGroupResponse, len(groups))
+	for i, group := range groups {
+		responses[i] = toModifierGroupResponse(&group)
+	}
+
+	c.JSON(http.StatusOK, responses)
+}
+
+// GET /api/items/:id/modifier-groups - アイテムで選べる選択肢グループ取得
+func (h *ModifierHandler) GetItemModifierGroups(c *gin.Context, id openapi_types.UUID) {
+	itemID := uuid.UUID(id)
+
+	var item models.Item
+	if err := h.db.First(&item, "id = ?", itemID).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			c.Error(apierror.New(models.ErrorCodeItemNotFound, "Item not found"))
+			return
+		}
+		c.Error(err)
+		return
+	}
+
+	groups, err := h.modifiers.GroupsFor(c.Request.Context(), []models.Item{item})
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	// API型に変換
+	responses := make([]models.ModifierGroupResponse, len(groups[item.ID]))
+	for i, group := range groups[item.ID] {
+		responses[i] = toModifierGroupResponse(&group)
+	}
+
+	c.JSON(http.StatusOK, responses)
+}
+
+// POST /api/modifier-groups - 選択肢グループ作成
+func (h *ModifierHandler) CreateModifierGroup(c *gin.Context) {
+	var req models.CreateModifierGroupJSONRequestBody
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(apierror.Binding(err))
+		return
+	}
+
+	var group models.ModifierGroup
+	if err := applyModifierGroupRequest(&group, &req); err != nil {
+		c.Error(err)
+		return
+	}
+	for _, m := range req.Modifiers {
+		group.Modifiers = append(group.Modifiers, models.Modifier{Name: m.Name, PriceDelta: priceDelta(m)})
+	}
+
+	if err := h.db.Create(&group).Error; err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, toModifierGroupResponse(&group))
+}
+
+// GET /api/modifier-groups/:id - 選択肢グループ取得
+func (h *ModifierHandler) GetModifierGroup(c *gin.Context, id openapi_types.UUID) {
+	groupID := uuid.UUID(id)
+
+	var group models.ModifierGroup
+	if err := preloadModifiers(h.db).First(&group, "id = ?", groupID).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			c.Error(apierror.New(models.ErrorCodeModifierGroupNotFound, "Modifier group not found"))
+			return
+		}
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toModifierGroupResponse(&group))
+}
+
+// PUT /api/modifier-groups/:id - 選択肢グループ更新
+// 選択肢は名前で照合して更新し、リクエストにないものは論理削除する
+func (h *ModifierHandler) UpdateModifierGroup(c *gin.Context, id openapi_types.UUID) {
+	groupID := uuid.UUID(id)
+
+	var req models.UpdateModifierGroupJSONRequestBody
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(apierror.Binding(err))
+		return
+	}
+
+	var group models.ModifierGroup
+	if err := preloadModifiers(h.db).First(&group, "id = ?", groupID).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			c.Error(apierror.New(models.ErrorCodeModifierGroupNotFound, "Modifier group not found"))
+			return
+		}
+		c.Error(err)
+		return
+	}
+
+	if err := applyModifierGroupRequest(&group, &req); err != nil {
+		c.Error(err)
+		return
+	}
+
+	err := h.db.Transaction(func(tx *gorm.DB) error {
+		existing := map[string]models.Modifier{}
+		for _, m := range group.Modifiers {
+			existing[m.Name] = m
+		}
+		modifiers := group.Modifiers
+		group.Modifiers = nil
+		if err := tx.Save(&group).Error; err != nil {
+			return err
+		}
+
+		for _, m := range req.Modifiers {
+			if current, ok := existing[m.Name]; ok {
+				delete(existing, m.Name)
+				if err := tx.Model(&current).Update("price_delta", priceDelta(m)).Error; err != nil {
+					return err
+				}
+				continue
+			}
+			if err := tx.Create(&models.Modifier{GroupID: group.ID, Name: m.Name, PriceDelta: priceDelta(m)}).Error; err != nil {
+				return err
+			}
+		}
+		for _, m := range modifiers {
+			if _, ok := existing[m.Name]; ok {
+				if err := tx.Delete(&m).Error; err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	// 更新後のデータをロード
+	if err := preloadModifiers(h.db).First(&group, "id = ?", group.ID).Error; err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toModifierGroupResponse(&group))
+}
+
+// DELETE /api/modifier-groups/:id - 選択肢グループ削除
+func (h *ModifierHandler) DeleteModifierGroup(c *gin.Context, id openapi_types.UUID) {
+	groupID := uuid.UUID(id)
+
+	result := h.db.Delete(&models.ModifierGroup{}, "id = ?", groupID)
+	if result.Error != nil {
+		c.Error(result.Error)
+		return
+	}
+
+	if result.RowsAffected == 0 {
+		c.Error(apierror.New(models.ErrorCodeModifierGroupNotFound, "Modifier group not found"))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Modifier group deleted successfully"})
+}
@@ -0,0 +1,227 @@
+// api/internal/handlers/openapi_validator.go
+package handlers
+
+import (
+	"bytes"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
+	"github.com/gin-gonic/gin"
+
+	"cafeore-pos/api/internal/apierror"
+	"cafeore-pos/api/internal/models"
+)
+
+// レスポンスの検証方法
+type ResponseValidation string
+
+const (
+	ResponseValidationOff ResponseValidation = "off"
+	// 仕様と違うレスポンスをログに残す（既定）
+	ResponseValidationLog ResponseValidation = "log"
+	// 仕様と違うレスポンスを 500 に置き換える（開発・テスト向け）
+	ResponseValidationStrict ResponseValidation = "strict"
+)
+
+// 環境変数などの文字列から検証方法を読む（不明な値は既定の log）
+func ParseResponseValidation(s string) ResponseValidation {
+	switch v := ResponseValidation(strings.ToLower(s)); v {
+	case ResponseValidationOff, ResponseValidationStrict:
+		return v
+	default:
+		return ResponseValidationLog
+	}
+}
+
+type openAPIValidator struct {
+	router    routers.Router
+	responses ResponseValidation
+}
+
+// リクエスト・レスポンスを openapi/openapi.yaml（生成コードに埋め込んだもの）で検証するミドルウェア
+// 仕様にないエンドポイント（WebSocket・ヘルスチェック）はそのまま通す
+func OpenAPIValidator(responses ResponseValidation) (gin.HandlerFunc, error) {
+	spec, err := GetSwagger()
+	if err != nil {
+		return nil, err
+	}
+	// servers のホスト名では照合しない（パスだけで探す）
+	spec.Servers = nil
+	router, err := gorillamux.NewRouter(spec)
+	if err != nil {
+		return nil, err
+	}
+	// エラーメッセージにスキーマ全体を含めない
+	openapi3.SchemaErrorDetailsDisabled = true
+
+	v := &openAPIValidator{router: router, responses: responses}
+	return v.handle, nil
+}
+
+func (v *openAPIValidator) handle(c *gin.Context) {
+	route, pathParams, err := v.router.FindRoute(c.Request)
+	if err != nil {
+		c.Next()
+		return
+	}
+
+	input := &openapi3filter.RequestValidationInput{
+		Request:    c.Request,
+		PathParams: pathParams,
+		Route:      route,
+		Options: &openapi3filter.Options{
+			AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+		},
+	}
+	if err := openapi3filter.ValidateRequest(c.Request.Context(), input); err != nil {
+		writeError(c, requestValidationError(err))
+		return
+	}
+
+	if v.responses == ResponseValidationOff {
+		c.Next()
+		return
+	}
+
+	w := &responseRecorder{ResponseWriter: c.Writer, status: c.Writer.Status()}
+	c.Writer = w
+	c.Next()
+	c.Writer = w.ResponseWriter
+
+	// サーバー側のエラーは仕様に書いていないので検証しない
+	if w.status >= http.StatusInternalServerError {
+		w.commit()
+		return
+	}
+
+	err = openapi3filter.ValidateResponse(c.Request.Context(), &openapi3filter.ResponseValidationInput{
+		RequestValidationInput: input,
+		Status:                 w.status,
+		Header:                 w.Header(),
+		Body:                   io.NopCloser(bytes.NewReader(w.body.Bytes())),
+		Options: &openapi3filter.Options{
+			IncludeResponseStatus: true,
+			// JSON 以外（CSV・PDF・SSE など）は本文を溜めずに書き出しているので、ステータスとヘッダーだけ確認する
+			ExcludeResponseBody: w.passthrough,
+		},
+	})
+	if err == nil {
+		w.commit()
+		return
+	}
+	log.Printf("response does not match the OpenAPI spec: %s %s: %v", c.Request.Method, route.Path, err)
+	if v.responses != ResponseValidationStrict || w.passthrough {
+		w.commit()
+		return
+	}
+	writeError(c, apierror.Newf(models.ErrorCodeInternal, "Response does not match the API spec: %v", err))
+}
+
+// JSON のレスポンスを書き出す前に溜めておく（検証してから返すため）
+// JSON 以外はそのまま書き出す
+type responseRecorder struct {
+	gin.ResponseWriter
+	status      int
+	body        bytes.Buffer
+	started     bool
+	passthrough bool
+}
+
+func isJSONContentType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
+
+// 書き出し始めたときに、溜めるかそのまま書き出すかを決める
+func (w *responseRecorder) start() {
+	if w.started {
+		return
+	}
+	w.started = true
+	if !isJSONContentType(w.Header().Get("Content-Type")) {
+		w.passthrough = true
+		w.ResponseWriter.WriteHeader(w.status)
+	}
+}
+
+// 溜めたレスポンスを書き出す
+func (w *responseRecorder) commit() {
+	if w.passthrough {
+		return
+	}
+	w.ResponseWriter.WriteHeader(w.status)
+	if w.body.Len() == 0 {
+		w.ResponseWriter.WriteHeaderNow()
+		return
+	}
+	if _, err := w.ResponseWriter.Write(w.body.Bytes()); err != nil {
+		log.Println("failed to write response:", err)
+	}
+}
+
+func (w *responseRecorder) WriteHeader(code int) {
+	if code > 0 && !w.started {
+		w.status = code
+	}
+}
+
+func (w *responseRecorder) WriteHeaderNow() {
+	w.start()
+	if w.passthrough {
+		w.ResponseWriter.WriteHeaderNow()
+	}
+}
+
+func (w *responseRecorder) Write(data []byte) (int, error) {
+	w.start()
+	if w.passthrough {
+		return w.ResponseWriter.Write(data)
+	}
+	return w.body.Write(data)
+}
+
+func (w *responseRecorder) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+func (w *responseRecorder) Status() int {
+	return w.status
+}
+
+func (w *responseRecorder) Size() int {
+	if w.passthrough {
+		return w.ResponseWriter.Size()
+	}
+	if !w.started {
+		return -1
+	}
+	return w.body.Len()
+}
+
+func (w *responseRecorder) Written() bool {
+	return w.started
+}
+
+// 途中で書き出す（SSE など）場合は溜めずにそのまま書き出す
+func (w *responseRecorder) Flush() {
+	w.start()
+	if !w.passthrough {
+		w.passthrough = true
+		w.ResponseWriter.WriteHeader(w.status)
+		if _, err := w.ResponseWriter.Write(w.body.Bytes()); err != nil {
+			log.Println("failed to write response:", err)
+		}
+		w.body.Reset()
+	}
+	w.ResponseWriter.Flush()
+}
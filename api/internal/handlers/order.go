@@ -2,48 +2,86 @@
 package handlers
 
 import (
+	"context"
+	"errors"
 	"net/http"
-	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"github.com/gorilla/websocket"
 	openapi_types "github.com/oapi-codegen/runtime/types"
-	"gorm.io/gorm"
 
+	"cafeore-pos/api/internal/apierror"
 	"cafeore-pos/api/internal/models"
+	"cafeore-pos/api/internal/service"
 )
 
 type OrderHandler struct {
-	db *gorm.DB
-	hub *Hub
+	orders     *service.OrderService
+	states     *service.MasterStateService
+	hub        *Hub
+	callscreen *CallscreenHandler
 }
 
-func NewOrderHandler(db *gorm.DB, hub *Hub) *OrderHandler {
-	return &OrderHandler{db: db, hub: hub}
+func NewOrderHandler(orders *service.OrderService, states *service.MasterStateService, hub *Hub, screen *CallscreenHandler) *OrderHandler {
+	return &OrderHandler{orders: orders, states: states, hub: hub, callscreen: screen}
 }
 
 // DB models → API models 変換関数
 func toOrderResponse(order *models.Order) models.OrderResponse {
 	resp := models.OrderResponse{
 		Id:                openapi_types.UUID(order.ID),
+		SessionId:         (*openapi_types.UUID)(order.SessionID),
+		Register:          order.Register,
 		OrderId:           order.OrderId,
 		CreatedAt:         order.CreatedAt,
 		ReadyAt:           order.ReadyAt,
 		ServedAt:          order.ServedAt,
+		CalledAt:          order.CalledAt,
+		LastCalledAt:      order.LastCalledAt,
+		RecallCount:       &order.RecallCount,
+		TrackingToken:     &order.TrackingToken,
 		BillingAmount:     order.BillingAmount,
 		Received:          order.Received,
+		Change:            order.Change,
+		RefundedAmount:    order.RefundedAmount(),
+		NetAmount:         order.BillingAmount - order.RefundedAmount(),
 		DiscountOrderId:   &order.DiscountOrderId,
 		DiscountOrderCups: &order.DiscountOrderCups,
+		Discount:          &order.Discount,
+	}
+	if len(order.AppliedPromotions) > 0 {
+		applied := toAppliedPromotionResponses(order.AppliedPromotions)
+		resp.AppliedPromotions = &applied
 	}
 	// Items変換
 	if len(order.OrderItems) > 0 {
 		items := make([]models.ItemInfo, 0, len(order.OrderItems))
 		for _, oi := range order.OrderItems {
-			itemInfo := models.ItemInfo{Assignee: oi.Assignee, Item: toItemResponse(&oi.Item)} 
+			itemInfo := models.ItemInfo{OrderItemId: openapi_types.UUID(oi.ID), Assignee: oi.Assignee, Item: toOrderItemResponse(&oi)}
+			if len(oi.Modifiers) > 0 {
+				modifiers := toSelectedModifierResponses(oi.Modifiers)
+				itemInfo.Modifiers = &modifiers
+			}
+			if len(oi.Components) > 0 {
+				components := toOrderItemComponentResponses(oi.Components)
+				itemInfo.Components = &components
+			}
 			items = append(items, itemInfo)
 		}
 		resp.Items = items
+
+		// セット商品を展開した作るもの一覧
+		prepItems := order.PrepItems()
+		prepTasks := make([]models.PrepTask, len(prepItems))
+		for i, p := range prepItems {
+			prepTasks[i] = toPrepTask(&p)
+		}
+		coffeeCups := order.CoffeeCups()
+		dripperSplit := order.NeedsDripperSplit()
+		resp.PrepTasks = &prepTasks
+		resp.CoffeeCups = &coffeeCups
+		resp.DripperSplit = &dripperSplit
 	}
 	// Comments変換
 	if len(order.Comments) > 0 {
@@ -53,41 +91,75 @@ func toOrderResponse(order *models.Order) models.OrderResponse {
 		}
 		resp.Comments = &comments
 	}
+	// Refunds変換
+	if len(order.Refunds) > 0 {
+		refunds := make([]models.RefundResponse, len(order.Refunds))
+		for i, refund := range order.Refunds {
+			refunds[i] = toRefundResponse(&refund)
+		}
+		resp.Refunds = &refunds
+	}
+	// Payments変換
+	if len(order.Payments) > 0 {
+		payments := make([]models.PaymentResponse, len(order.Payments))
+		for i, payment := range order.Payments {
+			payments[i] = toPaymentResponse(&payment)
+		}
+		resp.Payments = &payments
+	}
+
+	return resp
+}
 
+// オーダー内のアイテムは注文時点の名前・略称・単価で返す
+func toOrderItemResponse(oi *models.OrderItem) models.ItemResponse {
+	resp := toItemResponse(&oi.Item)
+	if oi.Name != "" {
+		resp.Name = oi.Name
+		resp.Abbr = oi.Abbr
+		resp.Price = oi.UnitPrice
+	}
 	return resp
 }
 
 // ブロードキャスト用のヘルパー
 func (h *OrderHandler) broadcastOrders() {
-	var orders []models.Order
-	if err := h.db.Preload("OrderItems.Item.ItemType").Preload("Comments").Find(&orders).Error; err != nil {
-			return
+	broadcastOrderList(h.orders, h.hub)
+	if h.callscreen != nil {
+		h.callscreen.Notify()
+	}
+}
+
+// 営業中 or 直近のセッションのオーダーをブロードキャストする
+func broadcastOrderList(orders *service.OrderService, hub *Hub) {
+	list, err := orders.Current(context.Background())
+	if err != nil {
+		return
 	}
+	hub.Broadcast(WSMessage{
+		Type:   WSMessageTypeOrders,
+		Orders: toOrderResponses(list),
+	})
+}
+
+func toOrderResponses(orders []models.Order) []models.OrderResponse {
 	responses := make([]models.OrderResponse, len(orders))
 	for i, o := range orders {
-			responses[i] = toOrderResponse(&o)
+		responses[i] = toOrderResponse(&o)
 	}
-	h.hub.Broadcast(WSMessage{
-		Type: WSMessageTypeOrders,
-		Orders: responses,
-	})
+	return responses
 }
 
 // GET /api/orders - オーダー一覧取得
-func (h *OrderHandler) GetOrders(c *gin.Context) {
-	var orders []models.Order
-	if err := h.db.Preload("OrderItems.Item.ItemType").Preload("Comments").Find(&orders).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+func (h *OrderHandler) GetOrders(c *gin.Context, params models.GetOrdersParams) {
+	orders, err := h.orders.List(c.Request.Context(), (*uuid.UUID)(params.SessionId))
+	if err != nil {
+		c.Error(err)
 		return
 	}
 
 	// API型に変換
-	responses := make([]models.OrderResponse, len(orders))
-	for i, order := range orders {
-		responses[i] = toOrderResponse(&order)
-	}
-
-	c.JSON(http.StatusOK, responses)
+	c.JSON(http.StatusOK, toOrderResponses(orders))
 }
 
 // POST /api/orders - オーダー作成
@@ -95,157 +167,57 @@ func (h *OrderHandler) CreateOrder(c *gin.Context) {
 	var req models.CreateOrderJSONRequestBody
 
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		c.Error(apierror.Binding(err))
 		return
 	}
 
-	// API型 → DB型に変換
-	order := models.Order{
-		OrderId:           req.OrderId,
-		CreatedAt:         time.Now(),
-		BillingAmount:     req.BillingAmount,
-		Received:          req.Received,
-		DiscountOrderCups: 0,
-	}
-
-	if req.DiscountOrderId != nil {
-		order.DiscountOrderId = *req.DiscountOrderId
-	}
-
-	if req.DiscountOrderCups != nil {
-		order.DiscountOrderCups = *req.DiscountOrderCups
-	}
-
-	// コメントの作成
-	if req.Comments != nil && len(*req.Comments) > 0 {
-		comments := make([]models.Comment, len(*req.Comments))
-		for i, commentReq := range *req.Comments {
-			comments[i] = models.Comment{
-				Author:    commentReq.Author,
-				Text:      commentReq.Text,
-				CreatedAt: time.Now(),
-			}
-		}
-		order.Comments = comments
-	}
-
-	// Orederを先に作る
-	if err := h.db.Create(&order).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	created, err := h.orders.Create(c.Request.Context(), req)
+	var declined *service.PaymentDeclinedError
+	if errors.As(err, &declined) {
+		c.JSON(http.StatusPaymentRequired, toPaymentFailedResponse(declined.Payments))
 		return
 	}
-
-	if len(req.ItemIds) == 0 {
-    c.JSON(http.StatusBadRequest, gin.H{"error": "item_ids is required"})
-    return
-	}
-
-	// ItemIDを収集
-	itemIDs := make([]uuid.UUID, 0, len(req.ItemIds))
-	for _, itemInfo := range req.ItemIds {
-    u, err := uuid.Parse(itemInfo.ItemId.String())
-    if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid item_id"})
-			return
-    }
-    itemIDs = append(itemIDs, u)
-	}
-
-	// アイテムの存在確認（重複を除いてユニークなIDのみチェック）
-	uniqueItemIDs := make(map[uuid.UUID]bool)
-	for _, id := range itemIDs {
-    uniqueItemIDs[id] = true
-	}
-
-	var items []models.Item
-	if err := h.db.Where("id IN ?", itemIDs).Find(&items).Error; err != nil {
-    c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid item IDs"})
-    return
-	}
-	if len(items) != len(uniqueItemIDs) {
-    c.JSON(http.StatusBadRequest, gin.H{"error": "Some item IDs not found"})
-    return
-	}
-	// OrderItemを作成
-	orderItems := make([]models.OrderItem, 0, len(req.ItemIds))
-	for _, itemInfo := range req.ItemIds {
-    itemID, _ := uuid.Parse(itemInfo.ItemId.String())  
-    orderItems = append(orderItems, models.OrderItem{
-			OrderID:  order.ID,
-			ItemID:   itemID,
-			Assignee: itemInfo.Assignee,
-    })
+	if err != nil {
+		c.Error(err)
+		return
 	}
 
-	// OrderItemsを一括作成
-	if len(orderItems) > 0 {
-    if err := h.db.Create(&orderItems).Error; err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-			return
-    }
+	resp := toOrderResponse(created.Order)
+	resp.ChangeBreakdown = &created.ChangeBreakdown
+	resp.QueuePosition = &created.QueuePosition
+	if len(created.DrawerWarnings) > 0 {
+		resp.DrawerWarnings = &created.DrawerWarnings
 	}
 
-	// 関連データをロード
-	var loaded models.Order
-	if err := h.db.
-		Preload("OrderItems.Item.ItemType").
-		Preload("Comments").
-		First(&loaded, "id = ?", order.ID).Error; err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-			return
-    }
-
-	c.JSON(http.StatusCreated, toOrderResponse(&loaded))
+	c.JSON(http.StatusCreated, resp)
 	h.broadcastOrders()
 }
 
 // GET /api/orders/:id - オーダー取得
-func (h *OrderHandler) GetOrder(c *gin.Context) {
-	id := c.Param("id")
-
-	orderID, err := uuid.Parse(id)
+func (h *OrderHandler) GetOrder(c *gin.Context, id openapi_types.UUID) {
+	order, err := h.orders.Get(c.Request.Context(), uuid.UUID(id))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
-		return
-	}
-
-	var order models.Order
-	if err := h.db.Preload("OrderItems.Item.ItemType").Preload("Comments").First(&order, "id = ?", orderID).Error; err != nil {
-		if err == gorm.ErrRecordNotFound {
-			c.JSON(http.StatusNotFound, gin.H{"error": "Order not found"})
-			return
-		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.Error(err)
 		return
 	}
 
-	c.JSON(http.StatusOK, toOrderResponse(&order))
+	c.JSON(http.StatusOK, toOrderResponse(order))
 }
 
 // PUT /api/orders/:id - オーダー更新
-func (h *OrderHandler) UpdateOrder(c *gin.Context) {
-	id := c.Param("id")
-
-	orderID, err := uuid.Parse(id)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
-		return
-	}
+func (h *OrderHandler) UpdateOrder(c *gin.Context, id openapi_types.UUID) {
+	orderID := uuid.UUID(id)
 
 	var req models.UpdateOrderJSONRequestBody
 
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		c.Error(apierror.Binding(err))
 		return
 	}
 
-	var order models.Order
-	if err := h.db.Preload("OrderItems.Item").First(&order, "id = ?", orderID).Error; err != nil {
-		if err == gorm.ErrRecordNotFound {
-			c.JSON(http.StatusNotFound, gin.H{"error": "Order not found"})
-			return
-		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	order, err := h.orders.Get(c.Request.Context(), orderID)
+	if err != nil {
+		c.Error(err)
 		return
 	}
 
@@ -314,7 +286,7 @@ func (h *OrderHandler) UpdateOrder(c *gin.Context) {
 	// 		return err
 	// 	}
 
-  //   	// 新しい明細を作成
+	// 		// 新しい明細を作成
 	// 		orderItems := make([]models.OrderItem, 0, len(items))
 	// 		for _, it := range items {
 	// 			orderItems = append(orderItems, models.OrderItem{
@@ -323,7 +295,7 @@ func (h *OrderHandler) UpdateOrder(c *gin.Context) {
 	// 			Qty:     qtyMap[it.ID],
 	// 			})
 	// 		}
-  //   	if err := tx.Create(&orderItems).Error; err != nil {
+	// 		if err := tx.Create(&orderItems).Error; err != nil {
 	// 			return err
 	// 		}
 	// 		return nil
@@ -336,7 +308,7 @@ func (h *OrderHandler) UpdateOrder(c *gin.Context) {
 	// 		c.JSON(http.StatusBadRequest, gin.H{"error": msg})
 	// 		return
 	// 	}
-	// 	c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	// 	c.Error(err)
 	// 	return
 	// }
 	// // ---- トランザクションここまで ----
@@ -347,49 +319,18 @@ func (h *OrderHandler) UpdateOrder(c *gin.Context) {
 	// 	Preload("OrderItems.Item.ItemType").
 	// 	Preload("Comments").
 	// 	First(&loaded, "id = ?", order.ID).Error; err != nil {
-	// 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	// 		c.Error(err)
 	// 		return
-  //   }
+	// }
 
-	c.JSON(http.StatusOK, toOrderResponse(&order))
+	c.JSON(http.StatusOK, toOrderResponse(order))
 }
 
 // DELETE /api/orders/:id - オーダー削除
-func (h *OrderHandler) DeleteOrder(c *gin.Context) {
-	id := c.Param("id")
-
-	orderID, err := uuid.Parse(id)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
-		return
-	}
-
-	// オーダーアイテムの関連も削除
-	var order models.Order
-	if err := h.db.Preload("OrderItems.Item").First(&order, "id = ?", orderID).Error; err != nil {
-		if err == gorm.ErrRecordNotFound {
-			c.JSON(http.StatusNotFound, gin.H{"error": "Order not found"})
-			return
-		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-		return
-	}
-
-	// many2many の関連を削除
-	if err := h.db.Model(&order).Association("Items").Clear(); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-		return
-	}
-
-	// オーダーを削除
-	result := h.db.Delete(&models.Order{}, "id = ?", orderID)
-	if result.Error != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": result.Error.Error()})
-		return
-	}
-
-	if result.RowsAffected == 0 {
-		c.JSON(http.StatusNotFound, gin.H{"error": "Order not found"})
+func (h *OrderHandler) DeleteOrder(c *gin.Context, id openapi_types.UUID) {
+	// オーダーアイテム・コメントも一緒に削除する
+	if err := h.orders.Delete(c.Request.Context(), uuid.UUID(id)); err != nil {
+		c.Error(err)
 		return
 	}
 
@@ -397,75 +338,27 @@ func (h *OrderHandler) DeleteOrder(c *gin.Context) {
 }
 
 // PATCH /api/orders/:id/ready - オーダーを準備完了にする
-func (h *OrderHandler) MarkOrderReady(c *gin.Context) {
-	id := c.Param("id")
-
-	orderID, err := uuid.Parse(id)
+// 提供可能になったら呼び出し画面で呼び出す
+func (h *OrderHandler) MarkOrderReady(c *gin.Context, id openapi_types.UUID) {
+	order, err := h.orders.ToggleReady(c.Request.Context(), uuid.UUID(id))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
-		return
-	}
-
-	var order models.Order
-	if err := h.db.First(&order, "id = ?", orderID).Error; err != nil {
-		if err == gorm.ErrRecordNotFound {
-			c.JSON(http.StatusNotFound, gin.H{"error": "Order not found"})
-			return
-		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.Error(err)
 		return
 	}
-	if order.ReadyAt == nil{
-		now := time.Now()
-		order.ReadyAt = &now
-	} else {
-		order.ReadyAt = nil
-	}
 
-	if err := h.db.Save(&order).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-		return
-	}
-
-	c.JSON(http.StatusOK, toOrderResponse(&order))
+	c.JSON(http.StatusOK, toOrderResponse(order))
 	h.broadcastOrders()
 }
 
 // PATCH /api/orders/:id/served - オーダーを提供済みにする
-func (h *OrderHandler) MarkOrderServed(c *gin.Context) {
-	id := c.Param("id")
-
-	orderID, err := uuid.Parse(id)
+func (h *OrderHandler) MarkOrderServed(c *gin.Context, id openapi_types.UUID) {
+	order, err := h.orders.ToggleServed(c.Request.Context(), uuid.UUID(id))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid ID format"})
-		return
-	}
-
-	var order models.Order
-	if err := h.db.First(&order, "id = ?", orderID).Error; err != nil {
-		if err == gorm.ErrRecordNotFound {
-			c.JSON(http.StatusNotFound, gin.H{"error": "Order not found"})
-			return
-		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
-		return
-	}
-
-	if order.ServedAt == nil{
-		now := time.Now()
-		order.ServedAt = &now
-		order.ReadyAt = &now
-	} else {
-		order.ServedAt = nil
-		order.ReadyAt = nil
-	}
-
-	if err := h.db.Save(&order).Error; err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.Error(err)
 		return
 	}
 
-	c.JSON(http.StatusOK, toOrderResponse(&order))
+	c.JSON(http.StatusOK, toOrderResponse(order))
 	h.broadcastOrders()
 }
 
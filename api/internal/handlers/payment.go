@@ -0,0 +1,96 @@
+// api/internal/handlers/payment.go
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"gorm.io/gorm"
+
+	"cafeore-pos/api/internal/apierror"
+	"cafeore-pos/api/internal/models"
+	"cafeore-pos/api/internal/payments"
+)
+
+type PaymentHandler struct {
+	db       *gorm.DB
+	payments *payments.Registry
+}
+
+func NewPaymentHandler(db *gorm.DB, registry *payments.Registry) *PaymentHandler {
+	return &PaymentHandler{db: db, payments: registry}
+}
+
+func toPaymentResponse(payment *models.Payment) models.PaymentResponse {
+	resp := models.PaymentResponse{
+		Id:        openapi_types.UUID(payment.ID),
+		OrderId:   openapi_types.UUID(payment.OrderID),
+		Method:    payment.Method,
+		Amount:    payment.Amount,
+		Status:    models.PaymentResponseStatus(payment.Status),
+		CreatedAt: payment.CreatedAt,
+	}
+	if payment.ExternalRef != "" {
+		resp.ExternalReference = &payment.ExternalRef
+	}
+	if payment.Message != "" {
+		resp.Message = &payment.Message
+	}
+	return resp
+}
+
+func toPaymentFailedResponse(results []models.Payment) models.PaymentFailedResponse {
+	resp := models.PaymentFailedResponse{
+		Error:    "Payment was not approved",
+		Payments: make([]models.PaymentResult, len(results)),
+	}
+	for i, p := range results {
+		resp.Payments[i] = models.PaymentResult{
+			Method: p.Method,
+			Amount: p.Amount,
+			Status: models.PaymentResultStatus(p.Status),
+		}
+		if p.Message != "" {
+			message := p.Message
+			resp.Payments[i].Message = &message
+		}
+	}
+	return resp
+}
+
+// GET /api/orders/:id/payments - 特定オーダーの支払い一覧取得
+func (h *PaymentHandler) GetOrderPayments(c *gin.Context, id openapi_types.UUID) {
+	orderUUID := uuid.UUID(id)
+
+	// オーダーが存在するか確認
+	var order models.Order
+	if err := h.db.First(&order, "id = ?", orderUUID).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			c.Error(apierror.New(models.ErrorCodeOrderNotFound, "Order not found"))
+			return
+		}
+		c.Error(err)
+		return
+	}
+
+	var records []models.Payment
+	if err := h.db.Where("order_id = ?", orderUUID).Order("created_at").Find(&records).Error; err != nil {
+		c.Error(err)
+		return
+	}
+
+	// API型に変換
+	responses := make([]models.PaymentResponse, len(records))
+	for i, payment := range records {
+		responses[i] = toPaymentResponse(&payment)
+	}
+
+	c.JSON(http.StatusOK, responses)
+}
+
+// GET /api/payment-methods - 利用できる決済手段の一覧取得
+func (h *PaymentHandler) GetPaymentMethods(c *gin.Context) {
+	c.JSON(http.StatusOK, h.payments.Methods())
+}
@@ -0,0 +1,189 @@
+// api/internal/handlers/print_job.go
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"gorm.io/gorm"
+
+	"cafeore-pos/api/internal/apierror"
+	"cafeore-pos/api/internal/models"
+	"cafeore-pos/api/internal/printing"
+)
+
+type PrintJobHandler struct {
+	db      *gorm.DB
+	spooler *printing.Spooler
+}
+
+func NewPrintJobHandler(db *gorm.DB, spooler *printing.Spooler) *PrintJobHandler {
+	return &PrintJobHandler{db: db, spooler: spooler}
+}
+
+// DB models → API models 変換関数
+func toPrintJobResponse(job *models.PrintJob) models.PrintJobResponse {
+	resp := models.PrintJobResponse{
+		Id:        openapi_types.UUID(job.ID),
+		OrderId:   openapi_types.UUID(job.OrderID),
+		Kind:      models.PrintKind(job.Kind),
+		Printer:   job.Printer,
+		Status:    models.PrintJobStatus(job.Status),
+		Attempts:  job.Attempts,
+		PrintedAt: job.PrintedAt,
+		CreatedAt: job.CreatedAt,
+		UpdatedAt: job.UpdatedAt,
+	}
+	if job.Order != nil {
+		resp.OrderNumber = job.Order.OrderId
+	}
+	if job.LastError != "" {
+		lastError := job.LastError
+		resp.LastError = &lastError
+	}
+	if job.Status == string(models.Queued) {
+		next := job.NextAttemptAt
+		resp.NextAttemptAt = &next
+	}
+	return resp
+}
+
+func (h *PrintJobHandler) findPrintJob(c *gin.Context, id openapi_types.UUID) (*models.PrintJob, bool) {
+	var job models.PrintJob
+	if err := h.db.Preload("Order").First(&job, "id = ?", uuid.UUID(id)).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			c.Error(apierror.New(models.ErrorCodePrintJobNotFound, "Print job not found"))
+			return nil, false
+		}
+		c.Error(err)
+		return nil, false
+	}
+	return &job, true
+}
+
+// GET /api/print-jobs - 印刷ジョブ一覧取得
+func (h *PrintJobHandler) GetPrintJobs(c *gin.Context, params models.GetPrintJobsParams) {
+	query := h.db.Preload("Order").Order("created_at DESC")
+
+	if params.Status != nil {
+		switch *params.Status {
+		case models.Queued, models.Printing, models.Done, models.Failed:
+		default:
+			c.Error(apierror.Invalid("status", "Invalid status"))
+			return
+		}
+		query = query.Where("status = ?", *params.Status)
+	}
+	if params.OrderId != nil {
+		query = query.Where("order_id = ?", uuid.UUID(*params.OrderId))
+	}
+	limit := 100
+	if params.Limit != nil {
+		if *params.Limit < 1 || *params.Limit > 500 {
+			c.Error(apierror.Invalid("limit", "Invalid limit"))
+			return
+		}
+		limit = *params.Limit
+	}
+
+	var jobs []models.PrintJob
+	if err := query.Limit(limit).Find(&jobs).Error; err != nil {
+		c.Error(err)
+		return
+	}
+
+	responses := make([]models.PrintJobResponse, len(jobs))
+	for i := range jobs {
+		responses[i] = toPrintJobResponse(&jobs[i])
+	}
+	c.JSON(http.StatusOK, responses)
+}
+
+// POST /api/print-jobs - 再印刷
+func (h *PrintJobHandler) CreatePrintJob(c *gin.Context) {
+	var req models.CreatePrintJobJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(apierror.Binding(err))
+		return
+	}
+
+	kind := models.Labels
+	if req.Kind != nil {
+		kind = *req.Kind
+	}
+	if kind != models.Labels && kind != models.Receipt {
+		c.Error(apierror.Invalid("/kind", "Invalid kind"))
+		return
+	}
+	printer := ""
+	if req.Printer != nil {
+		printer = *req.Printer
+	}
+
+	var order models.Order
+	if err := h.db.First(&order, "id = ?", uuid.UUID(req.OrderId)).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			c.Error(apierror.New(models.ErrorCodeOrderNotFound, "Order not found"))
+			return
+		}
+		c.Error(err)
+		return
+	}
+
+	job, err := h.spooler.Enqueue(h.db, order.ID, kind, printer)
+	if err != nil {
+		if err == printing.ErrNoPrinter {
+			c.Error(apierror.Invalid("/printer", err.Error()))
+			return
+		}
+		c.Error(err)
+		return
+	}
+	h.spooler.Notify()
+
+	job.Order = &order
+	c.JSON(http.StatusCreated, toPrintJobResponse(job))
+}
+
+// GET /api/print-jobs/:id - 印刷ジョブ取得
+func (h *PrintJobHandler) GetPrintJob(c *gin.Context, id openapi_types.UUID) {
+	job, ok := h.findPrintJob(c, id)
+	if !ok {
+		return
+	}
+	c.JSON(http.StatusOK, toPrintJobResponse(job))
+}
+
+// POST /api/print-jobs/:id/retry - 失敗した印刷ジョブの再試行
+func (h *PrintJobHandler) RetryPrintJob(c *gin.Context, id openapi_types.UUID) {
+	job, ok := h.findPrintJob(c, id)
+	if !ok {
+		return
+	}
+
+	// 失敗したジョブだけを戻す（同時に再試行されても一度だけキューに入る）
+	result := h.db.Model(&models.PrintJob{}).
+		Where("id = ? AND status = ?", job.ID, models.Failed).
+		Updates(map[string]interface{}{
+			"status":          models.Queued,
+			"attempts":        0,
+			"next_attempt_at": gorm.Expr("NOW()"),
+		})
+	if result.Error != nil {
+		c.Error(result.Error)
+		return
+	}
+	if result.RowsAffected == 0 {
+		c.Error(apierror.New(models.ErrorCodePrintJobNotFailed, "Print job has not failed"))
+		return
+	}
+	h.spooler.Notify()
+
+	if err := h.db.Preload("Order").First(job, "id = ?", job.ID).Error; err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, toPrintJobResponse(job))
+}
@@ -0,0 +1,230 @@
+// api/internal/handlers/promotion.go
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"gorm.io/gorm"
+
+	"cafeore-pos/api/internal/apierror"
+	"cafeore-pos/api/internal/models"
+	"cafeore-pos/api/internal/promotions"
+	"cafeore-pos/api/internal/service"
+)
+
+type PromotionHandler struct {
+	db     *gorm.DB
+	orders *service.OrderService
+}
+
+func NewPromotionHandler(db *gorm.DB, orders *service.OrderService) *PromotionHandler {
+	return &PromotionHandler{db: db, orders: orders}
+}
+
+// DB models → API models 変換関数
+func toPromotionResponse(p *models.Promotion) models.PromotionResponse {
+	return models.PromotionResponse{
+		Id:           openapi_types.UUID(p.ID),
+		Name:         p.Name,
+		Type:         models.PromotionType(p.Type),
+		Active:       p.Active,
+		Priority:     p.Priority,
+		Amount:       p.Amount,
+		Percent:      p.Percent,
+		ItemTypeId:   (*openapi_types.UUID)(p.ItemTypeID),
+		ItemId:       (*openapi_types.UUID)(p.ItemID),
+		BuyQuantity:  p.BuyQuantity,
+		FreeQuantity: p.FreeQuantity,
+		MinSubtotal:  p.MinSubtotal,
+		StartsAt:     p.StartsAt,
+		EndsAt:       p.EndsAt,
+		DailyStart:   p.DailyStart,
+		DailyEnd:     p.DailyEnd,
+	}
+}
+
+func toAppliedPromotionResponses(applied models.OrderPromotions) []models.AppliedPromotion {
+	responses := make([]models.AppliedPromotion, len(applied))
+	for i, a := range applied {
+		responses[i] = models.AppliedPromotion{
+			PromotionId: openapi_types.UUID(a.PromotionID),
+			Name:        a.Name,
+			Type:        models.PromotionType(a.Type),
+			Amount:      a.Amount,
+		}
+	}
+	return responses
+}
+
+func intOrZero(v *int) int {
+	if v == nil {
+		return 0
+	}
+	return *v
+}
+
+// リクエストの内容をルールに反映する
+func applyPromotionRequest(p *models.Promotion, req *models.PromotionRequest) error {
+	p.Name = req.Name
+	p.Type = string(req.Type)
+	p.Active = req.Active == nil || *req.Active
+	p.Priority = intOrZero(req.Priority)
+	p.Amount = intOrZero(req.Amount)
+	p.Percent = intOrZero(req.Percent)
+	p.ItemTypeID = (*uuid.UUID)(req.ItemTypeId)
+	p.ItemID = (*uuid.UUID)(req.ItemId)
+	p.BuyQuantity = intOrZero(req.BuyQuantity)
+	p.FreeQuantity = intOrZero(req.FreeQuantity)
+	p.MinSubtotal = intOrZero(req.MinSubtotal)
+	p.StartsAt = req.StartsAt
+	p.EndsAt = req.EndsAt
+	p.DailyStart = req.DailyStart
+	p.DailyEnd = req.DailyEnd
+	if err := promotions.Validate(p); err != nil {
+		return apierror.Wrap(models.ErrorCodeValidationFailed, err)
+	}
+	return nil
+}
+
+// GET /api/promotions - 割引ルール一覧取得
+func (h *PromotionHandler) GetPromotions(c *gin.Context) {
+	var rules []models.Promotion
+	if err := h.db.Order("priority, created_at").Find(&rules).Error; err != nil {
+		c.Error(err)
+		return
+	}
+
+	// API型に変換
+	responses := make([]models.PromotionResponse, len(rules))
+	for i, rule := range rules {
+		responses[i] = toPromotionResponse(&rule)
+	}
+
+	c.JSON(http.StatusOK, responses)
+}
+
+// POST /api/promotions - 割引ルール作成
+func (h *PromotionHandler) CreatePromotion(c *gin.Context) {
+	var req models.CreatePromotionJSONRequestBody
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(apierror.Binding(err))
+		return
+	}
+
+	rule := models.Promotion{CreatedAt: time.Now()}
+	if err := applyPromotionRequest(&rule, &req); err != nil {
+		c.Error(err)
+		return
+	}
+
+	if err := h.db.Create(&rule).Error; err != nil {
+		c.Error(err)
+		return
+	}
+
+	// active はDBの既定値（true）で作られるので、false の場合は後から更新する
+	if !rule.Active {
+		if err := h.db.Model(&rule).Update("active", false).Error; err != nil {
+			c.Error(err)
+			return
+		}
+	}
+
+	c.JSON(http.StatusCreated, toPromotionResponse(&rule))
+}
+
+// GET /api/promotions/:id - 割引ルール取得
+func (h *PromotionHandler) GetPromotion(c *gin.Context, id openapi_types.UUID) {
+	rule, ok := h.findPromotion(c, id)
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, toPromotionResponse(rule))
+}
+
+// PUT /api/promotions/:id - 割引ルール更新
+func (h *PromotionHandler) UpdatePromotion(c *gin.Context, id openapi_types.UUID) {
+	var req models.UpdatePromotionJSONRequestBody
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(apierror.Binding(err))
+		return
+	}
+
+	rule, ok := h.findPromotion(c, id)
+	if !ok {
+		return
+	}
+
+	if err := applyPromotionRequest(rule, &req); err != nil {
+		c.Error(err)
+		return
+	}
+
+	if err := h.db.Save(rule).Error; err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toPromotionResponse(rule))
+}
+
+// DELETE /api/promotions/:id - 割引ルール削除
+func (h *PromotionHandler) DeletePromotion(c *gin.Context, id openapi_types.UUID) {
+	ruleID := uuid.UUID(id)
+
+	result := h.db.Delete(&models.Promotion{}, "id = ?", ruleID)
+	if result.Error != nil {
+		c.Error(result.Error)
+		return
+	}
+
+	if result.RowsAffected == 0 {
+		c.Error(apierror.New(models.ErrorCodePromotionNotFound, "Promotion not found"))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Promotion deleted successfully"})
+}
+
+// POST /api/promotions/evaluate - オーダーを作らずに割引を計算する
+func (h *PromotionHandler) EvaluatePromotions(c *gin.Context) {
+	var req models.EvaluatePromotionsJSONRequestBody
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(apierror.Binding(err))
+		return
+	}
+
+	evaluation, err := h.orders.Evaluate(c.Request.Context(), req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, models.PromotionEvaluation{
+		Subtotal:          evaluation.Subtotal,
+		Discount:          evaluation.Discount,
+		BillingAmount:     evaluation.BillingAmount(),
+		AppliedPromotions: toAppliedPromotionResponses(evaluation.Applied),
+	})
+}
+
+func (h *PromotionHandler) findPromotion(c *gin.Context, id openapi_types.UUID) (*models.Promotion, bool) {
+	var rule models.Promotion
+	if err := h.db.First(&rule, "id = ?", uuid.UUID(id)).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			c.Error(apierror.New(models.ErrorCodePromotionNotFound, "Promotion not found"))
+			return nil, false
+		}
+		c.Error(err)
+		return nil, false
+	}
+	return &rule, true
+}
@@ -0,0 +1,37 @@
+// api/internal/handlers/queue.go
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"cafeore-pos/api/internal/models"
+	"cafeore-pos/api/internal/service"
+)
+
+type QueueHandler struct {
+	sessions *service.SessionService
+	orders   *service.OrderService
+}
+
+func NewQueueHandler(sessions *service.SessionService, orders *service.OrderService) *QueueHandler {
+	return &QueueHandler{sessions: sessions, orders: orders}
+}
+
+// GET /api/queue - 提供待ちの列の取得
+func (h *QueueHandler) GetQueue(c *gin.Context, params models.GetQueueParams) {
+	sessionID, err := h.sessions.Resolve(c.Request.Context(), (*uuid.UUID)(params.SessionId))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	resp, err := h.orders.Queue(c.Request.Context(), sessionID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
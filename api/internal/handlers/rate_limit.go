@@ -0,0 +1,83 @@
+// api/internal/handlers/rate_limit.go
+package handlers
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"cafeore-pos/api/internal/apierror"
+	"cafeore-pos/api/internal/models"
+)
+
+// IP アドレスごとに window の間に limit 回まで受け付ける
+type rateLimiter struct {
+	limit  int
+	window time.Duration
+
+	mu      sync.Mutex
+	windows map[string]*rateWindow
+}
+
+type rateWindow struct {
+	start time.Time
+	count int
+}
+
+// 公開 API 向けの回数制限ミドルウェア
+func RateLimit(limit int, window time.Duration) gin.HandlerFunc {
+	l := &rateLimiter{limit: limit, window: window, windows: map[string]*rateWindow{}}
+	return l.handle
+}
+
+// prefix で始まるルートにだけ回数制限をかける
+// （生成されたルーティングはまとめて登録するので、ルートのグループにミドルウェアを付けられない）
+func RateLimitPrefix(prefix string, limit int, window time.Duration) gin.HandlerFunc {
+	limited := RateLimit(limit, window)
+	return func(c *gin.Context) {
+		if !strings.HasPrefix(c.FullPath(), prefix) {
+			c.Next()
+			return
+		}
+		limited(c)
+	}
+}
+
+// 受け付けられるか。受け付けられない場合は再試行までの時間を返す
+func (l *rateLimiter) allow(key string, now time.Time) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	w, ok := l.windows[key]
+	if !ok || now.Sub(w.start) >= l.window {
+		// 期限の切れた記録は増えすぎる前に消す
+		if len(l.windows) > 10000 {
+			for k, old := range l.windows {
+				if now.Sub(old.start) >= l.window {
+					delete(l.windows, k)
+				}
+			}
+		}
+		l.windows[key] = &rateWindow{start: now, count: 1}
+		return true, 0
+	}
+	if w.count >= l.limit {
+		return false, w.start.Add(l.window).Sub(now)
+	}
+	w.count++
+	return true, 0
+}
+
+func (l *rateLimiter) handle(c *gin.Context) {
+	ok, retryAfter := l.allow(c.ClientIP(), time.Now())
+	if !ok {
+		seconds := int(retryAfter.Seconds() + 0.999)
+		c.Header("Retry-After", strconv.Itoa(seconds))
+		writeError(c, apierror.New(models.ErrorCodeRateLimited, "Too many requests"))
+		return
+	}
+	c.Next()
+}
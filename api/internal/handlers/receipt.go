@@ -0,0 +1,69 @@
+// api/internal/handlers/receipt.go
+package handlers
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	"cafeore-pos/api/internal/apierror"
+	"cafeore-pos/api/internal/models"
+	"cafeore-pos/api/internal/receipt"
+	"cafeore-pos/api/internal/service"
+)
+
+type ReceiptHandler struct {
+	receipts *service.ReceiptService
+	cfg      receipt.Config
+}
+
+func NewReceiptHandler(receipts *service.ReceiptService, cfg receipt.Config) *ReceiptHandler {
+	return &ReceiptHandler{receipts: receipts, cfg: cfg}
+}
+
+// GET /api/orders/:id/receipt - 領収書の発行
+func (h *ReceiptHandler) GetOrderReceipt(c *gin.Context, id openapi_types.UUID, params models.GetOrderReceiptParams) {
+	format := models.Html
+	if params.Format != nil {
+		format = *params.Format
+	}
+	if format != models.Html && format != models.Pdf {
+		c.Error(apierror.Invalid("format", fmt.Sprintf("Unknown format: %s", format)))
+		return
+	}
+
+	// 初回は領収書番号を採番する。2回目以降は同じ番号で出し直す
+	addressee := ""
+	if params.Addressee != nil {
+		addressee = *params.Addressee
+	}
+	order, issued, err := h.receipts.Issue(c.Request.Context(), uuid.UUID(id), addressee)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	doc := h.cfg.NewDocument(order, issued)
+
+	var buf bytes.Buffer
+	if format == models.Pdf {
+		err = receipt.RenderPDF(&buf, doc)
+	} else {
+		err = receipt.RenderHTML(&buf, doc)
+	}
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.Header("X-Receipt-Number", doc.Number)
+	if format == models.Pdf {
+		c.Header("Content-Disposition", fmt.Sprintf(`inline; filename="receipt-%s.pdf"`, doc.Number))
+		c.Data(http.StatusOK, "application/pdf", buf.Bytes())
+		return
+	}
+	c.Data(http.StatusOK, "text/html; charset=utf-8", buf.Bytes())
+}
@@ -0,0 +1,99 @@
+// api/internal/handlers/refund.go
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	"cafeore-pos/api/internal/apierror"
+	"cafeore-pos/api/internal/models"
+	"cafeore-pos/api/internal/service"
+)
+
+type RefundHandler struct {
+	refunds  *service.RefundService
+	sessions *service.SessionService
+	orders   *service.OrderService
+	hub      *Hub
+}
+
+func NewRefundHandler(refunds *service.RefundService, sessions *service.SessionService, orders *service.OrderService, hub *Hub) *RefundHandler {
+	return &RefundHandler{refunds: refunds, sessions: sessions, orders: orders, hub: hub}
+}
+
+func toRefundResponse(refund *models.Refund) models.RefundResponse {
+	items := make([]models.RefundItemResponse, len(refund.Items))
+	for i, ri := range refund.Items {
+		items[i] = models.RefundItemResponse{
+			OrderItemId: openapi_types.UUID(ri.OrderItemID),
+			ItemId:      openapi_types.UUID(ri.ItemID),
+		}
+	}
+	return models.RefundResponse{
+		Id:        openapi_types.UUID(refund.ID),
+		OrderId:   openapi_types.UUID(refund.OrderID),
+		SessionId: openapi_types.UUID(refund.SessionID),
+		Register:  refund.Register,
+		Type:      models.RefundResponseType(refund.Type),
+		Reason:    refund.Reason,
+		Amount:    refund.Amount,
+		Method:    refund.Method,
+		Author:    refund.Author,
+		Items:     items,
+		CreatedAt: refund.CreatedAt,
+	}
+}
+
+func toRefundResponses(refunds []models.Refund) []models.RefundResponse {
+	responses := make([]models.RefundResponse, len(refunds))
+	for i := range refunds {
+		responses[i] = toRefundResponse(&refunds[i])
+	}
+	return responses
+}
+
+// GET /api/orders/:id/refunds - 特定オーダーの返金一覧取得
+func (h *RefundHandler) GetOrderRefunds(c *gin.Context, id openapi_types.UUID) {
+	refunds, err := h.refunds.ListByOrder(c.Request.Context(), uuid.UUID(id))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, toRefundResponses(refunds))
+}
+
+// GET /api/refunds - セッション内の返金一覧取得
+func (h *RefundHandler) GetRefunds(c *gin.Context, params models.GetRefundsParams) {
+	sessionID, err := h.sessions.Resolve(c.Request.Context(), (*uuid.UUID)(params.SessionId))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	refunds, err := h.refunds.List(c.Request.Context(), sessionID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, toRefundResponses(refunds))
+}
+
+// POST /api/orders/:id/refunds - 返金・作り直しの記録
+func (h *RefundHandler) CreateOrderRefund(c *gin.Context, id openapi_types.UUID) {
+	var req models.CreateOrderRefundJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(apierror.Binding(err))
+		return
+	}
+
+	refund, err := h.refunds.Create(c.Request.Context(), uuid.UUID(id), req)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, toRefundResponse(refund))
+	broadcastOrderList(h.orders, h.hub)
+}
@@ -0,0 +1,436 @@
+// api/internal/handlers/report.go
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"gorm.io/gorm"
+
+	"cafeore-pos/api/internal/apierror"
+	"cafeore-pos/api/internal/jst"
+	"cafeore-pos/api/internal/models"
+	"cafeore-pos/api/internal/service"
+)
+
+const (
+	defaultReportInterval = time.Hour
+	minReportInterval     = time.Minute
+)
+
+type ReportHandler struct {
+	db       *gorm.DB
+	sessions *service.SessionService
+}
+
+func NewReportHandler(db *gorm.DB, sessions *service.SessionService) *ReportHandler {
+	return &ReportHandler{db: db, sessions: sessions}
+}
+
+// 集計対象のオーダーの条件
+type reportFilter struct {
+	sessionID *uuid.UUID
+	from      *time.Time
+	to        *time.Time
+}
+
+// orders テーブルに対する WHERE 句
+func (f reportFilter) where() (string, []interface{}) {
+	conds := []string{"TRUE"}
+	args := []interface{}{}
+	if f.sessionID != nil {
+		conds = append(conds, "orders.session_id = ?")
+		args = append(args, *f.sessionID)
+	}
+	if f.from != nil {
+		conds = append(conds, "orders.created_at >= ?")
+		args = append(args, *f.from)
+	}
+	if f.to != nil {
+		conds = append(conds, "orders.created_at < ?")
+		args = append(args, *f.to)
+	}
+	return strings.Join(conds, " AND "), args
+}
+
+// クエリから集計条件を読み取る
+// 期間の指定がなければ session_id（省略時は営業中 or 直近のセッション）で絞り込む
+func (h *ReportHandler) filterFromQuery(c *gin.Context, requested *openapi_types.UUID, from, to *time.Time) (reportFilter, bool) {
+	f := reportFilter{from: from, to: to}
+	if requested == nil && (f.from != nil || f.to != nil) {
+		return f, true
+	}
+	var err error
+	f.sessionID, err = h.sessions.Resolve(c.Request.Context(), (*uuid.UUID)(requested))
+	if err != nil {
+		c.Error(err)
+		return f, false
+	}
+	return f, true
+}
+
+func intervalQuery(raw *string) (time.Duration, error) {
+	if raw == nil || *raw == "" {
+		return defaultReportInterval, nil
+	}
+	d, err := time.ParseDuration(*raw)
+	if err != nil {
+		return 0, apierror.Invalid("interval", "Invalid interval format")
+	}
+	if d < minReportInterval {
+		return 0, apierror.Invalid("interval", fmt.Sprintf("interval must be at least %s", minReportInterval))
+	}
+	return d, nil
+}
+
+// オーダー作成日時を interval ごとに区切る SQL 式
+// UTC ではなく日本時間の 0 時を起点に区切る（1 日ごとなら日本時間の日付ごとになる）
+func bucketExpr(interval time.Duration) string {
+	seconds := int64(interval / time.Second)
+	return fmt.Sprintf(
+		"(to_timestamp(floor(extract(epoch from orders.created_at AT TIME ZONE '%[1]s') / %[2]d) * %[2]d) AT TIME ZONE 'UTC') AT TIME ZONE '%[1]s'",
+		jst.Name, seconds)
+}
+
+// order_items の一行のコーヒーの杯数を求める SQL 式（Order.CoffeeCups と同じ数え方）
+// セット商品は構成アイテムのうちコーヒーの数量を数える。order_items に items と item_types を JOIN して使う
+func cupsExpr() string {
+	names := make([]string, len(models.NonCoffeeTypeNames))
+	for i, n := range models.NonCoffeeTypeNames {
+		names[i] = "'" + n + "'"
+	}
+	nonCoffee := strings.Join(names, ", ")
+	return `CASE WHEN jsonb_array_length(order_items.components) = 0 THEN
+		CASE WHEN COALESCE(item_types.name, '') NOT IN (` + nonCoffee + `) THEN 1 ELSE 0 END
+	ELSE (
+		SELECT COALESCE(SUM((c->>'quantity')::int), 0)
+		FROM jsonb_array_elements(order_items.components) AS c
+		WHERE COALESCE(c->>'item_type_name', '') NOT IN (` + nonCoffee + `)
+	) END`
+}
+
+// GET /api/reports/summary - 売上サマリー取得
+func (h *ReportHandler) GetSalesSummaryReport(c *gin.Context, params models.GetSalesSummaryReportParams) {
+	f, ok := h.filterFromQuery(c, params.SessionId, params.From, params.To)
+	if !ok {
+		return
+	}
+	where, args := f.where()
+
+	var row struct {
+		OrderCount           int
+		CupCount             int
+		GrossSales           int
+		BillingTotal         int
+		DiscountedOrderCount int
+		RefundTotal          int
+	}
+	query := `
+WITH o AS (
+	SELECT orders.id, orders.billing_amount FROM orders WHERE ` + where + `
+), g AS (
+	SELECT order_items.order_id, SUM(` + cupsExpr() + `) AS cups, SUM(order_items.unit_price) AS gross
+	FROM order_items
+	LEFT JOIN items ON items.id = order_items.item_id
+	LEFT JOIN item_types ON item_types.id = items.item_type_id
+	WHERE order_items.order_id IN (SELECT id FROM o)
+	GROUP BY order_items.order_id
+), r AS (
+	SELECT refunds.order_id, SUM(refunds.amount) AS amount
+	FROM refunds
+	WHERE refunds.order_id IN (SELECT id FROM o)
+	GROUP BY refunds.order_id
+)
+SELECT
+	COUNT(*) AS order_count,
+	COALESCE(SUM(g.cups), 0) AS cup_count,
+	COALESCE(SUM(g.gross), 0) AS gross_sales,
+	COALESCE(SUM(o.billing_amount), 0) AS billing_total,
+	COUNT(*) FILTER (WHERE COALESCE(g.gross, 0) > o.billing_amount) AS discounted_order_count,
+	COALESCE(SUM(r.amount), 0) AS refund_total
+FROM o
+LEFT JOIN g ON g.order_id = o.id
+LEFT JOIN r ON r.order_id = o.id`
+	if err := h.db.Raw(query, args...).Scan(&row).Error; err != nil {
+		c.Error(err)
+		return
+	}
+
+	resp := models.SalesSummaryReport{
+		OrderCount:           row.OrderCount,
+		CupCount:             row.CupCount,
+		GrossSales:           row.GrossSales,
+		DiscountTotal:        row.GrossSales - row.BillingTotal,
+		DiscountedOrderCount: row.DiscountedOrderCount,
+		BillingTotal:         row.BillingTotal,
+		RefundTotal:          row.RefundTotal,
+		NetSales:             row.BillingTotal - row.RefundTotal,
+	}
+	if row.OrderCount > 0 {
+		resp.AverageTicket = float32(row.BillingTotal) / float32(row.OrderCount)
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// GET /api/reports/items - アイテム別売上取得
+func (h *ReportHandler) GetItemSalesReport(c *gin.Context, params models.GetItemSalesReportParams) {
+	f, ok := h.filterFromQuery(c, params.SessionId, params.From, params.To)
+	if !ok {
+		return
+	}
+	where, args := f.where()
+
+	var rows []struct {
+		ItemID       uuid.UUID
+		Name         string
+		Abbr         string
+		ItemTypeID   uuid.UUID
+		ItemTypeName string
+		Quantity     int
+		Sales        int
+	}
+	// 名前・略称は注文時点のもの（途中で名前を変えたアイテムは名前ごとに分かれる）
+	query := `
+SELECT
+	items.id AS item_id, order_items.name, order_items.abbr,
+	item_types.id AS item_type_id, item_types.name AS item_type_name,
+	COUNT(*) AS quantity, SUM(order_items.unit_price) AS sales
+FROM order_items
+JOIN orders ON orders.id = order_items.order_id
+JOIN items ON items.id = order_items.item_id
+JOIN item_types ON item_types.id = items.item_type_id
+WHERE ` + where + `
+GROUP BY items.id, order_items.name, order_items.abbr, item_types.id, item_types.name
+ORDER BY quantity DESC, order_items.name`
+	if err := h.db.Raw(query, args...).Scan(&rows).Error; err != nil {
+		c.Error(err)
+		return
+	}
+
+	// API型に変換
+	responses := make([]models.ItemSalesReport, len(rows))
+	for i, row := range rows {
+		responses[i] = models.ItemSalesReport{
+			ItemId:       openapi_types.UUID(row.ItemID),
+			Name:         row.Name,
+			Abbr:         row.Abbr,
+			ItemTypeId:   openapi_types.UUID(row.ItemTypeID),
+			ItemTypeName: row.ItemTypeName,
+			Quantity:     row.Quantity,
+			Sales:        row.Sales,
+		}
+	}
+
+	c.JSON(http.StatusOK, responses)
+}
+
+// GET /api/reports/item-types - アイテム種別ごとの売上取得
+func (h *ReportHandler) GetItemTypeSalesReport(c *gin.Context, params models.GetItemTypeSalesReportParams) {
+	f, ok := h.filterFromQuery(c, params.SessionId, params.From, params.To)
+	if !ok {
+		return
+	}
+	where, args := f.where()
+
+	var rows []struct {
+		ItemTypeID uuid.UUID
+		Name       string
+		Quantity   int
+		Sales      int
+	}
+	query := `
+SELECT
+	item_types.id AS item_type_id, item_types.name,
+	COUNT(*) AS quantity, SUM(order_items.unit_price) AS sales
+FROM order_items
+JOIN orders ON orders.id = order_items.order_id
+JOIN items ON items.id = order_items.item_id
+JOIN item_types ON item_types.id = items.item_type_id
+WHERE ` + where + `
+GROUP BY item_types.id, item_types.name
+ORDER BY quantity DESC, item_types.name`
+	if err := h.db.Raw(query, args...).Scan(&rows).Error; err != nil {
+		c.Error(err)
+		return
+	}
+
+	// API型に変換
+	responses := make([]models.ItemTypeSalesReport, len(rows))
+	for i, row := range rows {
+		responses[i] = models.ItemTypeSalesReport{
+			ItemTypeId: openapi_types.UUID(row.ItemTypeID),
+			Name:       row.Name,
+			Quantity:   row.Quantity,
+			Sales:      row.Sales,
+		}
+	}
+
+	c.JSON(http.StatusOK, responses)
+}
+
+// GET /api/reports/timeline - 時間帯別売上取得
+func (h *ReportHandler) GetSalesTimelineReport(c *gin.Context, params models.GetSalesTimelineReportParams) {
+	f, ok := h.filterFromQuery(c, params.SessionId, params.From, params.To)
+	if !ok {
+		return
+	}
+	interval, err := intervalQuery(params.Interval)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	where, args := f.where()
+
+	var rows []struct {
+		BucketStart  time.Time
+		OrderCount   int
+		CupCount     int
+		BillingTotal int
+	}
+	query := `
+WITH cups AS (
+	SELECT order_items.order_id, SUM(` + cupsExpr() + `) AS cups
+	FROM order_items
+	JOIN orders ON orders.id = order_items.order_id
+	LEFT JOIN items ON items.id = order_items.item_id
+	LEFT JOIN item_types ON item_types.id = items.item_type_id
+	WHERE ` + where + `
+	GROUP BY order_items.order_id
+)
+SELECT
+	` + bucketExpr(interval) + ` AS bucket_start,
+	COUNT(*) AS order_count,
+	COALESCE(SUM(cups.cups), 0) AS cup_count,
+	COALESCE(SUM(orders.billing_amount), 0) AS billing_total
+FROM orders
+LEFT JOIN cups ON cups.order_id = orders.id
+WHERE ` + where + `
+GROUP BY 1
+ORDER BY 1`
+	// where は cups とオーダー本体の 2 か所で使う
+	args = append(args, args...)
+	if err := h.db.Raw(query, args...).Scan(&rows).Error; err != nil {
+		c.Error(err)
+		return
+	}
+
+	// API型に変換
+	responses := make([]models.SalesTimelineBucket, len(rows))
+	for i, row := range rows {
+		responses[i] = models.SalesTimelineBucket{
+			BucketStart:  row.BucketStart,
+			OrderCount:   row.OrderCount,
+			CupCount:     row.CupCount,
+			BillingTotal: row.BillingTotal,
+		}
+	}
+
+	c.JSON(http.StatusOK, responses)
+}
+
+// 所要時間のパーセンタイルを集計する列
+const throughputColumns = `
+	COUNT(orders.ready_at) AS ready_count,
+	percentile_cont(0.5) WITHIN GROUP (ORDER BY extract(epoch from orders.ready_at - orders.created_at)) AS ready_p50,
+	percentile_cont(0.9) WITHIN GROUP (ORDER BY extract(epoch from orders.ready_at - orders.created_at)) AS ready_p90,
+	percentile_cont(0.99) WITHIN GROUP (ORDER BY extract(epoch from orders.ready_at - orders.created_at)) AS ready_p99,
+	COUNT(orders.served_at) FILTER (WHERE orders.ready_at IS NOT NULL) AS pickup_count,
+	percentile_cont(0.5) WITHIN GROUP (ORDER BY extract(epoch from orders.served_at - orders.ready_at)) AS pickup_p50,
+	percentile_cont(0.9) WITHIN GROUP (ORDER BY extract(epoch from orders.served_at - orders.ready_at)) AS pickup_p90,
+	percentile_cont(0.99) WITHIN GROUP (ORDER BY extract(epoch from orders.served_at - orders.ready_at)) AS pickup_p99,
+	COUNT(orders.served_at) AS served_count,
+	percentile_cont(0.5) WITHIN GROUP (ORDER BY extract(epoch from orders.served_at - orders.created_at)) AS served_p50,
+	percentile_cont(0.9) WITHIN GROUP (ORDER BY extract(epoch from orders.served_at - orders.created_at)) AS served_p90,
+	percentile_cont(0.99) WITHIN GROUP (ORDER BY extract(epoch from orders.served_at - orders.created_at)) AS served_p99`
+
+type throughputRow struct {
+	BucketStart time.Time
+	ReadyCount  int
+	ReadyP50    *float64
+	ReadyP90    *float64
+	ReadyP99    *float64
+	PickupCount int
+	PickupP50   *float64
+	PickupP90   *float64
+	PickupP99   *float64
+	ServedCount int
+	ServedP50   *float64
+	ServedP90   *float64
+	ServedP99   *float64
+}
+
+func toFloat32Ptr(v *float64) *float32 {
+	if v == nil {
+		return nil
+	}
+	f := float32(*v)
+	return &f
+}
+
+func toDurationPercentiles(count int, p50, p90, p99 *float64) models.DurationPercentiles {
+	return models.DurationPercentiles{
+		Count: count,
+		P50:   toFloat32Ptr(p50),
+		P90:   toFloat32Ptr(p90),
+		P99:   toFloat32Ptr(p99),
+	}
+}
+
+func (row *throughputRow) stats() models.ThroughputStats {
+	return models.ThroughputStats{
+		CreatedToReady:  toDurationPercentiles(row.ReadyCount, row.ReadyP50, row.ReadyP90, row.ReadyP99),
+		ReadyToServed:   toDurationPercentiles(row.PickupCount, row.PickupP50, row.PickupP90, row.PickupP99),
+		CreatedToServed: toDurationPercentiles(row.ServedCount, row.ServedP50, row.ServedP90, row.ServedP99),
+	}
+}
+
+// GET /api/reports/throughput - 提供時間の統計取得
+func (h *ReportHandler) GetThroughputReport(c *gin.Context, params models.GetThroughputReportParams) {
+	f, ok := h.filterFromQuery(c, params.SessionId, params.From, params.To)
+	if !ok {
+		return
+	}
+	interval, err := intervalQuery(params.Interval)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	where, args := f.where()
+
+	var overall throughputRow
+	if err := h.db.Raw(`SELECT `+throughputColumns+` FROM orders WHERE `+where, args...).Scan(&overall).Error; err != nil {
+		c.Error(err)
+		return
+	}
+
+	var rows []throughputRow
+	query := `SELECT ` + bucketExpr(interval) + ` AS bucket_start,` + throughputColumns + `
+FROM orders
+WHERE ` + where + `
+GROUP BY 1
+ORDER BY 1`
+	if err := h.db.Raw(query, args...).Scan(&rows).Error; err != nil {
+		c.Error(err)
+		return
+	}
+
+	// API型に変換
+	resp := models.ThroughputReport{
+		Overall: overall.stats(),
+		Buckets: make([]models.ThroughputBucket, len(rows)),
+	}
+	for i, row := range rows {
+		resp.Buckets[i] = models.ThroughputBucket{
+			BucketStart: row.BucketStart,
+			Stats:       row.stats(),
+		}
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
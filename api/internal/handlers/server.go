@@ -0,0 +1,57 @@
+// api/internal/handlers/server.go
+package handlers
+
+import (
+	"regexp"
+
+	"github.com/gin-gonic/gin"
+
+	"cafeore-pos/api/internal/apierror"
+	"cafeore-pos/api/internal/models"
+)
+
+// 生成された ServerInterface の実装
+// 各ハンドラーを埋め込み、operationId と同じ名前のメソッドをそのまま使う
+type Server struct {
+	*StatusHandler
+	*ItemHandler
+	*ItemTypeHandler
+	*ModifierHandler
+	*PromotionHandler
+	*VoucherHandler
+	*OrderHandler
+	*CommentHandler
+	*RefundHandler
+	*PaymentHandler
+	*LabelHandler
+	*ReceiptHandler
+	*PrintJobHandler
+	*CallscreenHandler
+	*QueueHandler
+	*TrackingHandler
+	*MasterStateHandler
+	*SessionHandler
+	*CashHandler
+	*ReportHandler
+	*ExportHandler
+}
+
+var _ ServerInterface = (*Server)(nil)
+
+// 生成コードのエラーメッセージからパラメーター名を取り出す
+var paramNamePattern = regexp.MustCompile(`(?:parameter|argument) ([A-Za-z0-9_]+)`)
+
+// パスパラメータ・クエリパラメータを読み取れなかったときのレスポンス
+func paramErrorHandler(c *gin.Context, err error, statusCode int) {
+	e := apierror.Validation(err.Error())
+	if m := paramNamePattern.FindStringSubmatch(err.Error()); m != nil {
+		e.Details = []models.FieldError{{Field: m[1], Message: err.Error()}}
+	}
+	e.Err = err
+	writeError(c, e.WithStatus(statusCode))
+}
+
+// 仕様のエンドポイントを登録する
+func (s *Server) Register(r gin.IRouter) {
+	RegisterHandlersWithOptions(r, s, GinServerOptions{ErrorHandler: paramErrorHandler})
+}
@@ -0,0 +1,134 @@
+// api/internal/handlers/session.go
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	"cafeore-pos/api/internal/apierror"
+	"cafeore-pos/api/internal/models"
+	"cafeore-pos/api/internal/service"
+)
+
+type SessionHandler struct {
+	sessions *service.SessionService
+	orders   *service.OrderService
+	states   *service.MasterStateService
+	hub      *Hub
+}
+
+func NewSessionHandler(sessions *service.SessionService, orders *service.OrderService, states *service.MasterStateService, hub *Hub) *SessionHandler {
+	return &SessionHandler{sessions: sessions, orders: orders, states: states, hub: hub}
+}
+
+func toSessionResponse(session *models.Session, nextOrderID int) models.SessionResponse {
+	return models.SessionResponse{
+		Id:           openapi_types.UUID(session.ID),
+		Name:         session.Name,
+		OpenedAt:     session.OpenedAt,
+		ClosedAt:     session.ClosedAt,
+		OpeningFloat: session.OpeningFloat,
+		NextOrderId:  nextOrderID,
+	}
+}
+
+func (h *SessionHandler) respond(c *gin.Context, status int, session *models.Session) {
+	next, err := h.sessions.NextOrderID(c.Request.Context(), session.ID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(status, toSessionResponse(session, next))
+}
+
+// セッションの開始・終了で対象のセッションが変わるので、オーダーとマスターステートを送り直す
+func (h *SessionHandler) broadcast() {
+	broadcastOrderList(h.orders, h.hub)
+	broadcastCurrentMasterState(h.states, h.hub)
+}
+
+// GET /api/sessions - セッション一覧取得
+func (h *SessionHandler) GetSessions(c *gin.Context) {
+	sessions, err := h.sessions.List(c.Request.Context())
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	// API型に変換
+	responses := make([]models.SessionResponse, len(sessions))
+	for i, session := range sessions {
+		next, err := h.sessions.NextOrderID(c.Request.Context(), session.ID)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+		responses[i] = toSessionResponse(&session, next)
+	}
+
+	c.JSON(http.StatusOK, responses)
+}
+
+// POST /api/sessions - セッション開始
+func (h *SessionHandler) OpenSession(c *gin.Context) {
+	var req models.OpenSessionJSONRequestBody
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(apierror.Binding(err))
+		return
+	}
+
+	openingFloat := 0
+	if req.OpeningFloat != nil {
+		openingFloat = *req.OpeningFloat
+	}
+	session, err := h.sessions.Open(c.Request.Context(), req.Name, openingFloat)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	h.respond(c, http.StatusCreated, session)
+	h.broadcast()
+}
+
+// GET /api/sessions/current - 営業中のセッション取得
+func (h *SessionHandler) GetCurrentSession(c *gin.Context) {
+	session, err := h.sessions.Current(c.Request.Context())
+	if err != nil {
+		// 営業中のセッションがないことは取得対象がないこととして 404 にする
+		if e := apierror.From(err); e.Code == models.ErrorCodeNoOpenSession {
+			err = e.WithStatus(http.StatusNotFound)
+		}
+		c.Error(err)
+		return
+	}
+
+	h.respond(c, http.StatusOK, session)
+}
+
+// GET /api/sessions/:id - セッション取得
+func (h *SessionHandler) GetSession(c *gin.Context, id openapi_types.UUID) {
+	session, err := h.sessions.Get(c.Request.Context(), uuid.UUID(id))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	h.respond(c, http.StatusOK, session)
+}
+
+// PATCH /api/sessions/:id/close - セッション終了
+func (h *SessionHandler) CloseSession(c *gin.Context, id openapi_types.UUID) {
+	session, err := h.sessions.Close(c.Request.Context(), uuid.UUID(id))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	h.respond(c, http.StatusOK, session)
+	h.broadcast()
+}
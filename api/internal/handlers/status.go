@@ -0,0 +1,59 @@
+// api/internal/handlers/status.go
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+
+	"cafeore-pos/api/internal/models"
+)
+
+const serverVersion = "1.0.0"
+
+type StatusHandler struct {
+	db *gorm.DB
+}
+
+func NewStatusHandler(db *gorm.DB) *StatusHandler {
+	return &StatusHandler{db: db}
+}
+
+// GET /status - サーバーステータス取得
+func (h *StatusHandler) GetStatus(c *gin.Context) {
+	dbStatus := "connected"
+
+	// DB接続確認
+	sqlDB, err := h.db.DB()
+	if err != nil || sqlDB.Ping() != nil {
+		dbStatus = "disconnected"
+	}
+
+	c.JSON(http.StatusOK, models.StatusResponse{
+		Status:    "ok",
+		Timestamp: time.Now(),
+		Version:   serverVersion,
+		Database:  dbStatus,
+	})
+}
+
+// GET /health - ヘルスチェック（仕様外。コンテナの死活監視用）
+func (h *StatusHandler) Health(c *gin.Context) {
+	var result int
+	err := h.db.Raw("SELECT 1").Scan(&result).Error
+
+	if err != nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{
+			"status": "unhealthy",
+			"error":  err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"status":   "healthy",
+		"database": "connected",
+	})
+}
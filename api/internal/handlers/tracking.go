@@ -0,0 +1,99 @@
+// api/internal/handlers/tracking.go
+package handlers
+
+import (
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"cafeore-pos/api/internal/apierror"
+	"cafeore-pos/api/internal/callscreen"
+	"cafeore-pos/api/internal/models"
+	"cafeore-pos/api/internal/service"
+	"cafeore-pos/api/internal/tracking"
+)
+
+type TrackingHandler struct {
+	orders *service.OrderService
+	hub    *Hub
+	cfg    tracking.Config
+	screen callscreen.Config
+}
+
+func NewTrackingHandler(orders *service.OrderService, hub *Hub, cfg tracking.Config, screen callscreen.Config) *TrackingHandler {
+	return &TrackingHandler{orders: orders, hub: hub, cfg: cfg, screen: screen}
+}
+
+// オーダー番号と追跡コードからオーダーを探す（見つからなければ c.Error でエラーを渡して nil）
+func (h *TrackingHandler) findOrder(c *gin.Context, orderNumber int, token string) *models.Order {
+	order, err := h.orders.FindByTracking(c.Request.Context(), orderNumber, token)
+	if err != nil {
+		c.Error(err)
+		return nil
+	}
+	return order
+}
+
+// GET /api/track/:order_number - お客様向けの注文状況取得
+func (h *TrackingHandler) TrackOrder(c *gin.Context, orderNumber int, params models.TrackOrderParams) {
+	order := h.findOrder(c, orderNumber, params.Token)
+	if order == nil {
+		return
+	}
+	resp, err := h.orders.Tracking(c.Request.Context(), order, h.cfg, h.screen)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// GET /api/track/:order_number/events - お客様向けの注文状況の購読（SSE）
+func (h *TrackingHandler) TrackOrderEvents(c *gin.Context, orderNumber int, params models.TrackOrderEventsParams) {
+	order := h.findOrder(c, orderNumber, params.Token)
+	if order == nil {
+		return
+	}
+	orderID := order.ID
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("X-Accel-Buffering", "no")
+
+	ticker := time.NewTicker(h.cfg.RefreshInterval)
+	defer ticker.Stop()
+	for {
+		// 読み直す前に待ち受けを始めて、その間の更新を取りこぼさないようにする
+		changed := h.hub.Changed()
+
+		current, err := h.orders.Get(c.Request.Context(), orderID)
+		if err != nil {
+			c.SSEvent("error", toAPIError(err).Response())
+			c.Writer.Flush()
+			return
+		}
+		resp, err := h.orders.Tracking(c.Request.Context(), current, h.cfg, h.screen)
+		if err != nil {
+			log.Printf("track order %s failed: %v", orderID, err)
+			c.SSEvent("error", apierror.Internal(err).Response())
+			c.Writer.Flush()
+			return
+		}
+		c.SSEvent("tracking", resp)
+		c.Writer.Flush()
+
+		// 提供済みになったら終わり
+		if resp.Status == models.Served {
+			return
+		}
+
+		select {
+		case <-c.Request.Context().Done():
+			return
+		case <-changed:
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,244 @@
+// api/internal/handlers/voucher.go
+package handlers
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"gorm.io/gorm"
+
+	"cafeore-pos/api/internal/apierror"
+	"cafeore-pos/api/internal/export"
+	"cafeore-pos/api/internal/models"
+	"cafeore-pos/api/internal/promotions"
+	"cafeore-pos/api/internal/vouchers"
+)
+
+type VoucherHandler struct {
+	db *gorm.DB
+}
+
+func NewVoucherHandler(db *gorm.DB) *VoucherHandler {
+	return &VoucherHandler{db: db}
+}
+
+// DB models → API models 変換関数
+func toVoucherBatchResponse(b *models.VoucherBatch, stats vouchers.Stats) models.VoucherBatchResponse {
+	return models.VoucherBatchResponse{
+		Id:            openapi_types.UUID(b.ID),
+		Name:          b.Name,
+		Type:          models.PromotionType(b.Type),
+		Amount:        b.Amount,
+		Percent:       b.Percent,
+		ItemTypeId:    (*openapi_types.UUID)(b.ItemTypeID),
+		ItemId:        (*openapi_types.UUID)(b.ItemID),
+		BuyQuantity:   b.BuyQuantity,
+		FreeQuantity:  b.FreeQuantity,
+		MinSubtotal:   b.MinSubtotal,
+		ExpiresAt:     b.ExpiresAt,
+		CreatedAt:     b.CreatedAt,
+		Issued:        stats.Issued,
+		Redeemed:      stats.Redeemed,
+		DiscountTotal: stats.DiscountTotal,
+	}
+}
+
+func toVoucherResponse(v *models.Voucher, now time.Time) models.VoucherResponse {
+	resp := models.VoucherResponse{
+		Code:              vouchers.Format(v.Code),
+		BatchId:           openapi_types.UUID(v.BatchID),
+		BatchName:         v.Batch.Name,
+		Usable:            !v.Redeemed() && !v.Batch.Expired(now),
+		ExpiresAt:         v.Batch.ExpiresAt,
+		RedeemedAt:        v.RedeemedAt,
+		RedeemedOrderUuid: (*openapi_types.UUID)(v.RedeemedOrderID),
+	}
+	if v.RedeemedOrder != nil {
+		resp.RedeemedOrderId = &v.RedeemedOrder.OrderId
+	}
+	return resp
+}
+
+// GET /api/voucher-batches - クーポンのバッチ一覧取得
+func (h *VoucherHandler) GetVoucherBatches(c *gin.Context) {
+	var batches []models.VoucherBatch
+	if err := h.db.Order("created_at DESC").Find(&batches).Error; err != nil {
+		c.Error(err)
+		return
+	}
+
+	// API型に変換
+	responses := make([]models.VoucherBatchResponse, len(batches))
+	for i, batch := range batches {
+		stats, err := vouchers.BatchStats(h.db, batch.ID)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+		responses[i] = toVoucherBatchResponse(&batch, stats)
+	}
+
+	c.JSON(http.StatusOK, responses)
+}
+
+// POST /api/voucher-batches - クーポンのバッチ作成
+func (h *VoucherHandler) CreateVoucherBatch(c *gin.Context) {
+	var req models.CreateVoucherBatchJSONRequestBody
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.Error(apierror.Binding(err))
+		return
+	}
+
+	// API型 → DB型に変換
+	batch := models.VoucherBatch{
+		Name:         req.Name,
+		Type:         string(req.Type),
+		Amount:       intOrZero(req.Amount),
+		Percent:      intOrZero(req.Percent),
+		ItemTypeID:   (*uuid.UUID)(req.ItemTypeId),
+		ItemID:       (*uuid.UUID)(req.ItemId),
+		BuyQuantity:  intOrZero(req.BuyQuantity),
+		FreeQuantity: intOrZero(req.FreeQuantity),
+		MinSubtotal:  intOrZero(req.MinSubtotal),
+		ExpiresAt:    req.ExpiresAt,
+		CreatedAt:    time.Now(),
+	}
+
+	// カップ返却とハッピーアワーはクーポンにできない
+	switch models.PromotionType(batch.Type) {
+	case models.PerCupReturn, models.HappyHour:
+		c.Error(apierror.Invalid("/type", fmt.Sprintf("%s cannot be used for vouchers", batch.Type)))
+		return
+	}
+	rule := vouchers.Rule(&models.Voucher{Batch: batch})
+	if err := promotions.Validate(&rule); err != nil {
+		c.Error(apierror.Wrap(models.ErrorCodeValidationFailed, err))
+		return
+	}
+	if req.Count < 1 || req.Count > vouchers.MaxBatchSize {
+		c.Error(apierror.Invalid("/count", fmt.Sprintf("count must be between 1 and %d", vouchers.MaxBatchSize)))
+		return
+	}
+
+	if err := vouchers.Issue(h.db, &batch, req.Count); err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, toVoucherBatchResponse(&batch, vouchers.Stats{Issued: req.Count}))
+}
+
+// GET /api/voucher-batches/:id - クーポンのバッチ取得
+func (h *VoucherHandler) GetVoucherBatch(c *gin.Context, id openapi_types.UUID) {
+	batch, ok := h.findBatch(c, id)
+	if !ok {
+		return
+	}
+
+	stats, err := vouchers.BatchStats(h.db, batch.ID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toVoucherBatchResponse(batch, stats))
+}
+
+// GET /api/voucher-batches/:id/vouchers - バッチのクーポン一覧取得
+func (h *VoucherHandler) GetBatchVouchers(c *gin.Context, id openapi_types.UUID) {
+	batch, ok := h.findBatch(c, id)
+	if !ok {
+		return
+	}
+
+	var list []models.Voucher
+	if err := h.db.Preload("RedeemedOrder").Where("batch_id = ?", batch.ID).Order("code").Find(&list).Error; err != nil {
+		c.Error(err)
+		return
+	}
+
+	// API型に変換
+	now := time.Now()
+	responses := make([]models.VoucherResponse, len(list))
+	for i, v := range list {
+		v.Batch = *batch
+		responses[i] = toVoucherResponse(&v, now)
+	}
+
+	c.JSON(http.StatusOK, responses)
+}
+
+// GET /api/voucher-batches/:id/export - 印刷用にクーポンコードを書き出す
+func (h *VoucherHandler) ExportVoucherBatch(c *gin.Context, id openapi_types.UUID, params models.ExportVoucherBatchParams) {
+	format := export.FormatCSV
+	if params.Format != nil {
+		var err error
+		format, err = export.ParseFormat(string(*params.Format))
+		if err != nil {
+			c.Error(apierror.Invalid("format", err.Error()))
+			return
+		}
+	}
+
+	batch, ok := h.findBatch(c, id)
+	if !ok {
+		return
+	}
+
+	var list []models.Voucher
+	if err := h.db.Where("batch_id = ?", batch.ID).Order("code").Find(&list).Error; err != nil {
+		c.Error(err)
+		return
+	}
+
+	var expiresAt interface{}
+	if batch.ExpiresAt != nil {
+		expiresAt = *batch.ExpiresAt
+	}
+	rows := make([][]interface{}, len(list))
+	for i, v := range list {
+		var redeemedAt interface{}
+		if v.RedeemedAt != nil {
+			redeemedAt = *v.RedeemedAt
+		}
+		rows[i] = []interface{}{vouchers.Format(v.Code), batch.Name, expiresAt, redeemedAt}
+	}
+
+	c.Header("Content-Type", format.ContentType())
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="vouchers-%s.%s"`, batch.ID, format))
+	c.Status(http.StatusOK)
+	if err := export.WriteRows(c.Writer, format, "vouchers", []string{"code", "batch", "expires_at", "redeemed_at"}, rows); err != nil {
+		// 書き出し始めた後はステータスを変えられないのでログに残すだけにする
+		log.Printf("export vouchers %s failed: %v", batch.ID, err)
+	}
+}
+
+// GET /api/vouchers/:code - クーポンコードの確認（使用はしない）
+func (h *VoucherHandler) GetVoucher(c *gin.Context, code string) {
+	voucher, err := vouchers.Find(h.db, code)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toVoucherResponse(voucher, time.Now()))
+}
+
+func (h *VoucherHandler) findBatch(c *gin.Context, id openapi_types.UUID) (*models.VoucherBatch, bool) {
+	var batch models.VoucherBatch
+	if err := h.db.First(&batch, "id = ?", uuid.UUID(id)).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			c.Error(apierror.New(models.ErrorCodeVoucherBatchNotFound, "Voucher batch not found"))
+			return nil, false
+		}
+		c.Error(err)
+		return nil, false
+	}
+	return &batch, true
+}
@@ -6,7 +6,6 @@ import (
 	"log"
 
 	"github.com/gin-gonic/gin"
-	"gorm.io/gorm"
 )
 
 type WSMessageType string
@@ -14,12 +13,14 @@ type WSMessageType string
 const (
 	WSMessageTypeOrders      WSMessageType = "orders"
 	WSMessageTypeMasterState WSMessageType = "master_state"
+	WSMessageTypeCallscreen  WSMessageType = "callscreen"
 )
 
 type WSMessage struct {
-	Type        WSMessageType          `json:"type"`
-	Orders      []models.OrderResponse `json:"orders,omitempty"`
-	MasterState *models.MasterState    `json:"master_state,omitempty"`
+	Type        WSMessageType              `json:"type"`
+	Orders      []models.OrderResponse     `json:"orders,omitempty"`
+	MasterState *models.MasterState        `json:"master_state,omitempty"`
+	Callscreen  *models.CallscreenResponse `json:"callscreen,omitempty"`
 }
 
 func (h *OrderHandler) WSHandler(c *gin.Context) {
@@ -30,7 +31,7 @@ func (h *OrderHandler) WSHandler(c *gin.Context) {
 	defer func() {
 		h.hub.Unregister(conn)
 		if err := conn.Close(); err != nil {
-    	log.Println("failed to close connection:", err)
+			log.Println("failed to close connection:", err)
 		}
 	}()
 
@@ -49,19 +50,5 @@ func (h *OrderHandler) WSHandler(c *gin.Context) {
 }
 
 func (h *OrderHandler) broadcastMasterState() {
-	var state models.MasterState
-
-	if err := h.db.
-		Order("created_at DESC").
-		First(&state).Error; err != nil {
-		if err == gorm.ErrRecordNotFound {
-			return
-		}
-		return
-	}
-
-	h.hub.Broadcast(WSMessage{
-		Type:        WSMessageTypeMasterState,
-		MasterState: &state,
-	})
+	broadcastCurrentMasterState(h.states, h.hub)
 }
@@ -0,0 +1,471 @@
+// api/internal/importer/firestore.go
+package importer
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"cafeore-pos/api/internal/jst"
+	"cafeore-pos/api/internal/models"
+	"cafeore-pos/api/internal/payments"
+	"cafeore-pos/api/internal/pricing"
+)
+
+var errDryRun = errors.New("dry run")
+
+// download-orders.ts が出力する JSON（orderSchema の配列）
+type FirestoreOrders struct {
+	Orders []FirestoreOrder `json:"orders"`
+}
+
+type FirestoreOrder struct {
+	ID                string             `json:"id"`
+	OrderID           int                `json:"orderId"`
+	CreatedAt         time.Time          `json:"createdAt"`
+	ReadyAt           *time.Time         `json:"readyAt"`
+	ServedAt          *time.Time         `json:"servedAt"`
+	Items             []FirestoreItem    `json:"items"`
+	Comments          []FirestoreComment `json:"comments"`
+	BillingAmount     int                `json:"billingAmount"`
+	Received          int                `json:"received"`
+	DiscountOrderID   *int               `json:"discountOrderId"`
+	DiscountOrderCups int                `json:"discountOrderCups"`
+	Discount          int                `json:"discount"`
+}
+
+type FirestoreItem struct {
+	Name     string            `json:"name"`
+	Abbr     string            `json:"abbr"`
+	Price    int               `json:"price"`
+	Key      string            `json:"key"`
+	ItemType FirestoreItemType `json:"item_type"`
+	Assignee *string           `json:"assignee"`
+}
+
+type FirestoreItemType struct {
+	Name        string `json:"name"`
+	DisplayName string `json:"display_name"`
+}
+
+type FirestoreComment struct {
+	Author    string    `json:"author"`
+	Text      string    `json:"text"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// download-master-state.ts が出力する JSON（orderStats の配列）
+type FirestoreMasterState struct {
+	CreatedAt time.Time `json:"createdAt"`
+	Type      string    `json:"type"`
+}
+
+func DecodeOrders(r io.Reader) ([]FirestoreOrder, error) {
+	var data FirestoreOrders
+	if err := json.NewDecoder(r).Decode(&data); err != nil {
+		return nil, err
+	}
+	return data.Orders, nil
+}
+
+func DecodeMasterStates(r io.Reader) ([]FirestoreMasterState, error) {
+	var data []FirestoreMasterState
+	if err := json.NewDecoder(r).Decode(&data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// 取り込み結果
+type Report struct {
+	SessionsCreated     int
+	ItemTypesCreated    int
+	ItemsCreated        int
+	ItemsUpdated        int
+	OrdersCreated       int
+	OrdersSkipped       int
+	MasterStatesCreated int
+	MasterStatesSkipped int
+	Conflicts           []string
+
+	seen map[string]bool
+}
+
+// 同じ内容の競合は一度だけ報告する
+func (r *Report) conflict(format string, args ...interface{}) {
+	msg := fmt.Sprintf(format, args...)
+	if r.seen == nil {
+		r.seen = map[string]bool{}
+	}
+	if r.seen[msg] {
+		return
+	}
+	r.seen[msg] = true
+	r.Conflicts = append(r.Conflicts, msg)
+}
+
+type Options struct {
+	// セッション名の接頭辞（セッションは営業日ごとに「接頭辞 YYYY-MM-DD」で作る）
+	SessionPrefix string
+	// true の場合は取り込みをロールバックして結果だけを返す
+	DryRun bool
+}
+
+type importer struct {
+	tx     *gorm.DB
+	opts   Options
+	report *Report
+
+	// 営業日ごとの最初と最後の時刻
+	days      map[string][2]time.Time
+	sessions  map[string]*models.Session
+	itemTypes map[string]*models.ItemType
+	items     map[string]*models.Item
+	updated   map[uuid.UUID]bool
+}
+
+// Firestore のデータを取り込む
+// 何度実行しても同じ結果になるように、取り込み済みのデータは飛ばす
+func Import(db *gorm.DB, orders []FirestoreOrder, masterStates []FirestoreMasterState, opts Options) (*Report, error) {
+	report := &Report{}
+	err := db.Transaction(func(tx *gorm.DB) error {
+		im := &importer{
+			tx:        tx,
+			opts:      opts,
+			report:    report,
+			days:      map[string][2]time.Time{},
+			sessions:  map[string]*models.Session{},
+			itemTypes: map[string]*models.ItemType{},
+			items:     map[string]*models.Item{},
+			updated:   map[uuid.UUID]bool{},
+		}
+		for _, o := range orders {
+			im.addDay(o.CreatedAt)
+			if o.ServedAt != nil {
+				im.addDay(*o.ServedAt)
+			}
+		}
+		for _, ms := range masterStates {
+			im.addDay(ms.CreatedAt)
+		}
+
+		sort.Slice(orders, func(i, j int) bool { return orders[i].CreatedAt.Before(orders[j].CreatedAt) })
+		for _, o := range orders {
+			if err := im.importOrder(o); err != nil {
+				return fmt.Errorf("order %d (%s): %w", o.OrderID, o.ID, err)
+			}
+		}
+		for _, ms := range masterStates {
+			if err := im.importMasterState(ms); err != nil {
+				return fmt.Errorf("master state %s: %w", ms.CreatedAt.Format(time.RFC3339), err)
+			}
+		}
+
+		if opts.DryRun {
+			return errDryRun
+		}
+		return nil
+	})
+	if err != nil && err != errDryRun {
+		return nil, err
+	}
+	return report, nil
+}
+
+// 日本時間の日付（営業日の区切り）
+func dayOf(t time.Time) string {
+	return t.In(jst.Location).Format("2006-01-02")
+}
+
+func (im *importer) addDay(t time.Time) {
+	day := dayOf(t)
+	span, ok := im.days[day]
+	if !ok {
+		im.days[day] = [2]time.Time{t, t}
+		return
+	}
+	if t.Before(span[0]) {
+		span[0] = t
+	}
+	if t.After(span[1]) {
+		span[1] = t
+	}
+	im.days[day] = span
+}
+
+// 営業日のセッションを取得する（なければ終了済みのセッションとして作る）
+func (im *importer) session(t time.Time) (*models.Session, error) {
+	day := dayOf(t)
+	if s, ok := im.sessions[day]; ok {
+		return s, nil
+	}
+
+	name := fmt.Sprintf("%s %s", im.opts.SessionPrefix, day)
+	var session models.Session
+	err := im.tx.Where("name = ?", name).First(&session).Error
+	if err == gorm.ErrRecordNotFound {
+		span := im.days[day]
+		session = models.Session{
+			Name:     name,
+			OpenedAt: span[0],
+			ClosedAt: &span[1],
+		}
+		if err := im.tx.Create(&session).Error; err != nil {
+			return nil, err
+		}
+		im.report.SessionsCreated++
+	} else if err != nil {
+		return nil, err
+	}
+	im.sessions[day] = &session
+	return &session, nil
+}
+
+// アイテム種別を名前で探し、なければ作る
+func (im *importer) itemType(src FirestoreItemType) (*models.ItemType, error) {
+	if t, ok := im.itemTypes[src.Name]; ok {
+		return t, nil
+	}
+
+	var itemType models.ItemType
+	err := im.tx.Unscoped().Where("name = ?", src.Name).Order("deleted DESC NULLS FIRST").First(&itemType).Error
+	if err == gorm.ErrRecordNotFound {
+		displayName := src.DisplayName
+		if displayName == "" {
+			displayName = src.Name
+		}
+		itemType = models.ItemType{Name: src.Name, DisplayName: displayName}
+		if err := im.tx.Create(&itemType).Error; err != nil {
+			return nil, err
+		}
+		im.report.ItemTypesCreated++
+	} else if err != nil {
+		return nil, err
+	}
+	im.itemTypes[src.Name] = &itemType
+	return &itemType, nil
+}
+
+// アイテムを名前とキーで探し、なければ作る
+// 現在のメニューに出てこないように、新しく作ったアイテムは削除済みにする
+// 既存のアイテムは注文時点の略称・価格・種別に合わせる（オーダーは古い順に取り込むので、最後は一番新しい内容になる）
+func (im *importer) item(src FirestoreItem, at time.Time) (*models.Item, error) {
+	itemType, err := im.itemType(src.ItemType)
+	if err != nil {
+		return nil, err
+	}
+
+	cacheKey := src.Name + "\x00" + src.Key
+	if item, ok := im.items[cacheKey]; ok {
+		return item, im.updateItem(item, itemType, src, at)
+	}
+
+	var item models.Item
+	err = im.tx.Unscoped().Where("name = ? AND key = ?", src.Name, src.Key).Order("deleted DESC NULLS FIRST").First(&item).Error
+	if err == gorm.ErrRecordNotFound {
+		item = models.Item{
+			Name:       src.Name,
+			Abbr:       src.Abbr,
+			Price:      src.Price,
+			Key:        src.Key,
+			ItemTypeID: itemType.ID,
+			Deleted:    gorm.DeletedAt{Time: time.Now(), Valid: true},
+		}
+		if err := im.tx.Create(&item).Error; err != nil {
+			return nil, err
+		}
+		im.report.ItemsCreated++
+		im.items[cacheKey] = &item
+		return &item, nil
+	} else if err != nil {
+		return nil, err
+	}
+	im.items[cacheKey] = &item
+	return &item, im.updateItem(&item, itemType, src, at)
+}
+
+// アイテムを注文時点の内容に合わせる
+// 価格は at から有効な価格として履歴にも残す
+func (im *importer) updateItem(item *models.Item, itemType *models.ItemType, src FirestoreItem, at time.Time) error {
+	prices, err := pricing.EffectivePrices(im.tx, []uuid.UUID{item.ID}, at)
+	if err != nil {
+		return err
+	}
+	price := item.Price
+	if p, ok := prices[item.ID]; ok {
+		price = p
+	}
+	if item.Abbr == src.Abbr && item.ItemTypeID == itemType.ID && price == src.Price {
+		return nil
+	}
+
+	if price != src.Price {
+		if _, err := pricing.Record(im.tx, item.ID, src.Price, at, "imported"); err != nil {
+			return err
+		}
+	}
+	item.Abbr = src.Abbr
+	item.Price = src.Price
+	item.ItemTypeID = itemType.ID
+	if err := im.tx.Unscoped().Model(item).Select("abbr", "price", "item_type_id").Updates(item).Error; err != nil {
+		return err
+	}
+	if !im.updated[item.ID] {
+		im.updated[item.ID] = true
+		im.report.ItemsUpdated++
+	}
+	return nil
+}
+
+func (im *importer) importOrder(src FirestoreOrder) error {
+	session, err := im.session(src.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	// 同じドキュメント ID のオーダーは取り込み済み
+	var existing models.Order
+	if src.ID != "" {
+		err = im.tx.Where("firestore_id = ?", src.ID).First(&existing).Error
+		if err == nil {
+			im.report.OrdersSkipped++
+			return nil
+		}
+		if err != gorm.ErrRecordNotFound {
+			return err
+		}
+	}
+
+	// 同じ営業日に同じ番号のオーダーがあれば取り込めない
+	// ドキュメント ID を記録する前に取り込んだ同じオーダーには ID を記録する
+	err = im.tx.Where("session_id = ? AND order_id = ?", session.ID, src.OrderID).First(&existing).Error
+	if err == nil {
+		if sameOrder(&existing, src) {
+			if src.ID != "" {
+				if err := im.tx.Model(&existing).Update("firestore_id", src.ID).Error; err != nil {
+					return err
+				}
+			}
+		} else {
+			im.report.conflict("order %d on %s: another order with the same number already exists", src.OrderID, dayOf(src.CreatedAt))
+		}
+		im.report.OrdersSkipped++
+		return nil
+	}
+	if err != gorm.ErrRecordNotFound {
+		return err
+	}
+
+	orderItems := make([]models.OrderItem, 0, len(src.Items))
+	for _, srcItem := range src.Items {
+		item, err := im.item(srcItem, src.CreatedAt)
+		if err != nil {
+			return err
+		}
+		orderItems = append(orderItems, models.OrderItem{
+			ItemID:    item.ID,
+			Assignee:  srcItem.Assignee,
+			Name:      srcItem.Name,
+			Abbr:      srcItem.Abbr,
+			UnitPrice: srcItem.Price,
+		})
+	}
+
+	change := src.Received - src.BillingAmount
+	if change < 0 {
+		im.report.conflict("order %d on %s: received %d is less than billing amount %d", src.OrderID, dayOf(src.CreatedAt), src.Received, src.BillingAmount)
+		change = 0
+	}
+
+	order := models.Order{
+		SessionID:         &session.ID,
+		Register:          models.DefaultRegister,
+		OrderId:           src.OrderID,
+		CreatedAt:         src.CreatedAt,
+		ReadyAt:           src.ReadyAt,
+		ServedAt:          src.ServedAt,
+		BillingAmount:     src.BillingAmount,
+		Received:          src.Received,
+		Change:            change,
+		DiscountOrderCups: src.DiscountOrderCups,
+		Discount:          src.Discount,
+	}
+	if src.ID != "" {
+		order.FirestoreID = &src.ID
+	}
+	if src.DiscountOrderID != nil {
+		order.DiscountOrderId = *src.DiscountOrderID
+	}
+	for _, c := range src.Comments {
+		order.Comments = append(order.Comments, models.Comment{
+			Author:    c.Author,
+			Text:      c.Text,
+			CreatedAt: c.CreatedAt,
+		})
+	}
+	// 過去のデータはすべて現金払い
+	if src.BillingAmount > 0 {
+		order.Payments = []models.Payment{{
+			Method:    payments.MethodCash,
+			Amount:    src.BillingAmount,
+			Status:    string(payments.StatusApproved),
+			CreatedAt: src.CreatedAt,
+		}}
+	}
+	if err := im.tx.Create(&order).Error; err != nil {
+		return err
+	}
+
+	for i := range orderItems {
+		orderItems[i].OrderID = order.ID
+	}
+	if len(orderItems) > 0 {
+		if err := im.tx.Create(&orderItems).Error; err != nil {
+			return err
+		}
+	}
+	im.report.OrdersCreated++
+	return nil
+}
+
+// ドキュメント ID を記録する前に取り込んだ同じオーダーか（作成日時が同じ）
+// 別のドキュメントとして取り込んだオーダーとは番号が同じでも別のオーダーとみなす
+func sameOrder(existing *models.Order, src FirestoreOrder) bool {
+	return existing.FirestoreID == nil && existing.CreatedAt.Equal(src.CreatedAt)
+}
+
+func (im *importer) importMasterState(src FirestoreMasterState) error {
+	if src.Type != "stop" && src.Type != "operational" {
+		im.report.conflict("master state %s: unknown type %q", src.CreatedAt.Format(time.RFC3339), src.Type)
+		return nil
+	}
+
+	var count int64
+	if err := im.tx.Model(&models.MasterState{}).Where("created_at = ?", src.CreatedAt).Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		im.report.MasterStatesSkipped++
+		return nil
+	}
+
+	session, err := im.session(src.CreatedAt)
+	if err != nil {
+		return err
+	}
+	if err := im.tx.Create(&models.MasterState{
+		CreatedAt: src.CreatedAt,
+		Type:      src.Type,
+		SessionID: &session.ID,
+	}).Error; err != nil {
+		return err
+	}
+	im.report.MasterStatesCreated++
+	return nil
+}
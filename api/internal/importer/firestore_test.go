@@ -0,0 +1,80 @@
+// api/internal/importer/firestore_test.go
+package importer
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"cafeore-pos/api/internal/models"
+)
+
+func TestDecodeOrders(t *testing.T) {
+	orders, err := DecodeOrders(strings.NewReader(`{"orders": [{
+		"id": "doc1", "orderId": 12, "createdAt": "2025-11-01T01:00:00Z", "servedAt": null,
+		"items": [{"name": "ブレンド", "abbr": "ブ", "price": 400, "key": "b", "item_type": {"name": "hot", "display_name": "ホット"}}],
+		"billingAmount": 400, "received": 500, "discountOrderId": null
+	}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(orders) != 1 {
+		t.Fatalf("orders = %+v", orders)
+	}
+	o := orders[0]
+	if o.ID != "doc1" || o.OrderID != 12 || o.ServedAt != nil || o.DiscountOrderID != nil || len(o.Items) != 1 || o.Items[0].ItemType.Name != "hot" {
+		t.Errorf("order = %+v", o)
+	}
+}
+
+func TestDayOf(t *testing.T) {
+	// 日本時間の 0 時（UTC の 15 時）で営業日を区切る
+	if got := dayOf(time.Date(2025, 11, 1, 14, 59, 0, 0, time.UTC)); got != "2025-11-01" {
+		t.Errorf("dayOf(14:59Z) = %s", got)
+	}
+	if got := dayOf(time.Date(2025, 11, 1, 15, 0, 0, 0, time.UTC)); got != "2025-11-02" {
+		t.Errorf("dayOf(15:00Z) = %s", got)
+	}
+
+	im := &importer{days: map[string][2]time.Time{}}
+	first := time.Date(2025, 11, 1, 1, 0, 0, 0, time.UTC)
+	last := time.Date(2025, 11, 1, 8, 0, 0, 0, time.UTC)
+	for _, at := range []time.Time{last, first, first.Add(time.Hour)} {
+		im.addDay(at)
+	}
+	if span := im.days["2025-11-01"]; !span[0].Equal(first) || !span[1].Equal(last) || len(im.days) != 1 {
+		t.Errorf("days = %v", im.days)
+	}
+}
+
+func TestSameOrder(t *testing.T) {
+	createdAt := time.Date(2025, 11, 1, 1, 0, 0, 0, time.UTC)
+	src := FirestoreOrder{ID: "doc1", OrderID: 12, CreatedAt: createdAt}
+	other := "doc0"
+
+	tests := []struct {
+		name     string
+		existing models.Order
+		want     bool
+	}{
+		// ドキュメント ID を記録する前に取り込んだもの
+		{"legacy import", models.Order{OrderId: 12, CreatedAt: createdAt}, true},
+		{"different time", models.Order{OrderId: 12, CreatedAt: createdAt.Add(time.Minute)}, false},
+		{"another document", models.Order{OrderId: 12, CreatedAt: createdAt, FirestoreID: &other}, false},
+	}
+	for _, tt := range tests {
+		if got := sameOrder(&tt.existing, src); got != tt.want {
+			t.Errorf("%s: sameOrder = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestReportConflict(t *testing.T) {
+	var r Report
+	r.conflict("order %d: duplicated", 1)
+	r.conflict("order %d: duplicated", 1)
+	r.conflict("order %d: duplicated", 2)
+	if len(r.Conflicts) != 2 {
+		t.Errorf("conflicts = %v, want each message once", r.Conflicts)
+	}
+}
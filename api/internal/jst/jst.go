@@ -0,0 +1,25 @@
+// api/internal/jst/jst.go
+//
+// 日付・時刻の基準にする日本時間
+// コンテナは TZ を設定しておらず UTC で動くので、time.Local ではなくこちらを使う
+package jst
+
+import (
+	"time"
+	// tzdata のないイメージでも Asia/Tokyo を読めるように埋め込む
+	_ "time/tzdata"
+)
+
+// IANA のタイムゾーン名（SQL の AT TIME ZONE にも使う）
+const Name = "Asia/Tokyo"
+
+// 日本時間のロケーション
+var Location = mustLoad()
+
+func mustLoad() *time.Location {
+	loc, err := time.LoadLocation(Name)
+	if err != nil {
+		panic(err)
+	}
+	return loc
+}
@@ -0,0 +1,141 @@
+// api/internal/menu/apply.go
+package menu
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"cafeore-pos/api/internal/models"
+	"cafeore-pos/api/internal/pricing"
+)
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// メニュー定義を DB に反映し、反映した差分を返す
+// すべての変更は一つのトランザクションで行う。アイテムは論理削除のみで、
+// 過去のオーダーから参照されているアイテムが消えることはない
+func Apply(db *gorm.DB, m *Menu) (*Plan, error) {
+	var plan *Plan
+	err := db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		plan, err = MakePlan(tx, m)
+		if err != nil {
+			return err
+		}
+
+		typeIDs := map[string]uuid.UUID{}
+		var itemTypes []models.ItemType
+		if err := tx.Find(&itemTypes).Error; err != nil {
+			return err
+		}
+		for _, t := range itemTypes {
+			typeIDs[t.Name] = t.ID
+		}
+
+		// アイテム種別を先に反映して、アイテムから参照できるようにする
+		for _, c := range plan.Changes {
+			if c.Kind != KindItemType || c.Action == ActionDelete {
+				continue
+			}
+			displayName := c.menuItemType.DisplayName
+			if displayName == "" {
+				displayName = c.menuItemType.Name
+			}
+			switch c.Action {
+			case ActionCreate:
+				created := models.ItemType{Name: c.menuItemType.Name, DisplayName: displayName}
+				if err := tx.Create(&created).Error; err != nil {
+					return err
+				}
+				typeIDs[created.Name] = created.ID
+			case ActionUpdate, ActionRestore:
+				if err := tx.Unscoped().Model(c.itemType).Updates(map[string]interface{}{
+					"display_name": displayName,
+					"deleted":      nil,
+				}).Error; err != nil {
+					return err
+				}
+				typeIDs[c.itemType.Name] = c.itemType.ID
+			}
+		}
+
+		for _, c := range plan.Changes {
+			if c.Kind != KindItem || c.Action == ActionDelete {
+				continue
+			}
+			typeID, ok := typeIDs[c.menuItemType.Name]
+			if !ok {
+				return fmt.Errorf("item type %q not found", c.menuItemType.Name)
+			}
+			switch c.Action {
+			case ActionCreate:
+				if err := createItem(tx, c.menuItem, typeID); err != nil {
+					return err
+				}
+			case ActionUpdate, ActionRestore:
+				// c.item.Price は MakePlan で反映した今有効な価格
+				if c.item.Price != c.menuItem.Price {
+					if _, err := pricing.Record(tx, c.item.ID, c.menuItem.Price, time.Now(), "menu"); err != nil {
+						return err
+					}
+				}
+				if err := tx.Unscoped().Model(c.item).Updates(map[string]interface{}{
+					"abbr":         c.menuItem.Abbr,
+					"key":          c.menuItem.Key,
+					"price":        c.menuItem.Price,
+					"assignee":     c.menuItem.Assignee,
+					"available":    c.menuItem.IsAvailable(),
+					"item_type_id": typeID,
+					"deleted":      nil,
+				}).Error; err != nil {
+					return err
+				}
+			}
+		}
+
+		for _, c := range plan.Changes {
+			if c.Action != ActionDelete {
+				continue
+			}
+			switch c.Kind {
+			case KindItem:
+				if err := tx.Delete(c.item).Error; err != nil {
+					return err
+				}
+			case KindItemType:
+				if err := tx.Delete(c.itemType).Error; err != nil {
+					return err
+				}
+			}
+		}
+
+		// 有効なアイテムのキーが重複していないことを確認する
+		var duplicated []string
+		if err := tx.Model(&models.Item{}).
+			Select("key").
+			Group("key").
+			Having("COUNT(*) > 1").
+			Pluck("key", &duplicated).Error; err != nil {
+			return err
+		}
+		if len(duplicated) > 0 {
+			return fmt.Errorf("duplicate keys after apply: %v", duplicated)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
@@ -0,0 +1,103 @@
+// api/internal/menu/menu.go
+package menu
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/goccy/go-yaml"
+)
+
+// メニュー定義ファイル（YAML / JSON）
+//
+//	item_types:
+//	  - name: hot
+//	    display_name: ホット
+//	    items:
+//	      - name: ブレンド
+//	        abbr: ブ
+//	        key: "1"
+//	        price: 500
+//	        available: false # 省略時は true
+type Menu struct {
+	ItemTypes []ItemType `json:"item_types" yaml:"item_types"`
+}
+
+type ItemType struct {
+	Name        string `json:"name" yaml:"name"`
+	DisplayName string `json:"display_name" yaml:"display_name"`
+	Items       []Item `json:"items" yaml:"items"`
+}
+
+type Item struct {
+	Name     string `json:"name" yaml:"name"`
+	Abbr     string `json:"abbr" yaml:"abbr"`
+	Key      string `json:"key" yaml:"key"`
+	Price    int    `json:"price" yaml:"price"`
+	Assignee string `json:"assignee,omitempty" yaml:"assignee,omitempty"`
+	// 省略時は注文できる
+	Available *bool `json:"available,omitempty" yaml:"available,omitempty"`
+}
+
+func (item *Item) IsAvailable() bool {
+	return item.Available == nil || *item.Available
+}
+
+// メニュー定義ファイルを読み込む（拡張子が .json なら JSON、それ以外は YAML）
+func Load(path string) (*Menu, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var m Menu
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		err = json.Unmarshal(data, &m)
+	} else {
+		err = yaml.Unmarshal(data, &m)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if err := m.Validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return &m, nil
+}
+
+// 必須項目と名前・キーの重複を確認する
+func (m *Menu) Validate() error {
+	typeNames := map[string]bool{}
+	itemNames := map[string]bool{}
+	keys := map[string]string{}
+	for _, t := range m.ItemTypes {
+		if t.Name == "" {
+			return fmt.Errorf("item type name is required")
+		}
+		if typeNames[t.Name] {
+			return fmt.Errorf("item type %q is defined more than once", t.Name)
+		}
+		typeNames[t.Name] = true
+
+		for _, item := range t.Items {
+			if item.Name == "" || item.Abbr == "" || item.Key == "" {
+				return fmt.Errorf("item in %q requires name, abbr and key", t.Name)
+			}
+			if item.Price < 0 {
+				return fmt.Errorf("item %q has a negative price", item.Name)
+			}
+			if itemNames[item.Name] {
+				return fmt.Errorf("item %q is defined more than once", item.Name)
+			}
+			itemNames[item.Name] = true
+			if other, ok := keys[item.Key]; ok {
+				return fmt.Errorf("key %q is used by both %q and %q", item.Key, other, item.Name)
+			}
+			keys[item.Key] = item.Name
+		}
+	}
+	return nil
+}
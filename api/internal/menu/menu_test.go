@@ -0,0 +1,106 @@
+// api/internal/menu/menu_test.go
+package menu
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"cafeore-pos/api/internal/models"
+)
+
+func TestValidate(t *testing.T) {
+	item := func(name, key string) Item {
+		return Item{Name: name, Abbr: name, Key: key, Price: 400}
+	}
+	tests := []struct {
+		name string
+		menu Menu
+		want string
+	}{
+		{"duplicate key", Menu{ItemTypes: []ItemType{
+			{Name: "hot", Items: []Item{item("ブレンド", "1")}},
+			{Name: "ice", Items: []Item{item("アイスブレンド", "1")}},
+		}}, `key "1" is used by both "ブレンド" and "アイスブレンド"`},
+		{"duplicate item", Menu{ItemTypes: []ItemType{
+			{Name: "hot", Items: []Item{item("ブレンド", "1"), item("ブレンド", "2")}},
+		}}, `item "ブレンド" is defined more than once`},
+		{"duplicate item type", Menu{ItemTypes: []ItemType{{Name: "hot"}, {Name: "hot"}}}, `item type "hot" is defined more than once`},
+		{"missing abbr", Menu{ItemTypes: []ItemType{
+			{Name: "hot", Items: []Item{{Name: "ブレンド", Key: "1"}}},
+		}}, `item in "hot" requires name, abbr and key`},
+	}
+	for _, tt := range tests {
+		err := tt.menu.Validate()
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%s: err = %v, want %s", tt.name, err, tt.want)
+		}
+	}
+
+	valid := Menu{ItemTypes: []ItemType{{Name: "hot", Items: []Item{item("ブレンド", "1"), item("カフェオレ", "2")}}}}
+	if err := valid.Validate(); err != nil {
+		t.Errorf("valid menu: %v", err)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	deleted := gorm.DeletedAt{Time: time.Now(), Valid: true}
+	hot := models.ItemType{ID: uuid.New(), Name: "hot", DisplayName: "ホット"}
+	milk := models.ItemType{ID: uuid.New(), Name: "milk", DisplayName: "ミルク"}
+	oldType := models.ItemType{ID: uuid.New(), Name: "seasonal", DisplayName: "季節限定", Deleted: deleted}
+	item := func(name, key string, price int, itemType models.ItemType) models.Item {
+		return models.Item{ID: uuid.New(), Name: name, Abbr: name, Key: key, Price: price, Available: true, ItemTypeID: itemType.ID, ItemType: itemType}
+	}
+	blend := item("ブレンド", "1", 400, hot)
+	latte := item("カフェオレ", "2", 500, milk)
+	pumpkin := item("パンプキン", "3", 600, oldType)
+	pumpkin.Deleted = deleted
+
+	unavailable := false
+	m := &Menu{ItemTypes: []ItemType{
+		{Name: "hot", DisplayName: "ホット", Items: []Item{
+			{Name: "ブレンド", Abbr: "ブレンド", Key: "1", Price: 450},
+			{Name: "パンプキン", Abbr: "パンプキン", Key: "3", Price: 600, Available: &unavailable},
+			{Name: "アメリカン", Abbr: "ア", Key: "4", Price: 400},
+		}},
+	}}
+	plan := diff(m, []models.ItemType{hot, milk, oldType}, []models.Item{blend, latte, pumpkin})
+
+	want := []string{
+		`~ item ブレンド
+    price: "400" -> "450"`,
+		`^ item パンプキン
+    item_type: "seasonal" -> "hot"
+    available: "true" -> "false"`,
+		`+ item アメリカン
+    item_type: "" -> "hot"
+    abbr: "" -> "ア"
+    key: "" -> "4"
+    price: "" -> "400"`,
+		// メニューにないものは論理削除だけ（削除済みの種別はそのまま）
+		`- item カフェオレ`,
+		`- item_type milk`,
+	}
+	if len(plan.Changes) != len(want) {
+		t.Fatalf("plan =\n%s", plan)
+	}
+	for i, c := range plan.Changes {
+		if c.String() != want[i] {
+			t.Errorf("change %d =\n%s\nwant\n%s", i, c, want[i])
+		}
+	}
+	for _, c := range plan.Changes {
+		if c.Action == ActionDelete && c.item == nil && c.itemType == nil {
+			t.Errorf("delete %s has no target", c.Name)
+		}
+	}
+
+	// 同じ内容なら差分はない
+	same := &Menu{ItemTypes: []ItemType{{Name: "hot", DisplayName: "ホット", Items: []Item{{Name: "ブレンド", Abbr: "ブレンド", Key: "1", Price: 400}}}}}
+	if plan := diff(same, []models.ItemType{hot}, []models.Item{blend}); plan.HasChanges() {
+		t.Errorf("plan =\n%s, want no changes", plan)
+	}
+}
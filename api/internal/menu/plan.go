@@ -0,0 +1,257 @@
+// api/internal/menu/plan.go
+package menu
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"cafeore-pos/api/internal/models"
+	"cafeore-pos/api/internal/pricing"
+)
+
+type Action string
+
+const (
+	ActionCreate  Action = "create"
+	ActionUpdate  Action = "update"
+	ActionRestore Action = "restore" // 削除済みのものを戻して更新する
+	ActionDelete  Action = "delete"  // 論理削除（過去のオーダーから参照できるように残す）
+)
+
+type Kind string
+
+const (
+	KindItemType Kind = "item_type"
+	KindItem     Kind = "item"
+)
+
+type FieldChange struct {
+	Field string
+	From  string
+	To    string
+}
+
+type Change struct {
+	Action Action
+	Kind   Kind
+	Name   string
+	Fields []FieldChange
+
+	itemType     *models.ItemType
+	item         *models.Item
+	menuItemType *ItemType
+	menuItem     *Item
+}
+
+func (c Change) String() string {
+	mark := map[Action]string{
+		ActionCreate:  "+",
+		ActionUpdate:  "~",
+		ActionRestore: "^",
+		ActionDelete:  "-",
+	}[c.Action]
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s %s %s", mark, c.Kind, c.Name)
+	for _, f := range c.Fields {
+		fmt.Fprintf(&b, "\n    %s: %q -> %q", f.Field, f.From, f.To)
+	}
+	return b.String()
+}
+
+// メニュー定義と DB の差分
+type Plan struct {
+	Changes []Change
+}
+
+func (p *Plan) HasChanges() bool {
+	return len(p.Changes) > 0
+}
+
+func (p *Plan) String() string {
+	if !p.HasChanges() {
+		return "no changes"
+	}
+	lines := make([]string, len(p.Changes))
+	for i, c := range p.Changes {
+		lines[i] = c.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+func diffField(fields []FieldChange, name, from, to string) []FieldChange {
+	if from == to {
+		return fields
+	}
+	return append(fields, FieldChange{Field: name, From: from, To: to})
+}
+
+// 同じ名前のものが複数あれば、削除されていないものを優先する
+func pickByName[T any](candidates []*T, deleted func(*T) bool) (*T, []*T) {
+	var picked *T
+	for _, c := range candidates {
+		if picked == nil || (deleted(picked) && !deleted(c)) {
+			picked = c
+		}
+	}
+	rest := []*T{}
+	for _, c := range candidates {
+		if c != picked {
+			rest = append(rest, c)
+		}
+	}
+	return picked, rest
+}
+
+// メニュー定義と DB を比べて差分を作る
+// アイテム種別・アイテムは名前で照合する
+func MakePlan(db *gorm.DB, m *Menu) (*Plan, error) {
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+
+	var itemTypes []models.ItemType
+	if err := db.Unscoped().Order("name").Find(&itemTypes).Error; err != nil {
+		return nil, err
+	}
+	var items []models.Item
+	if err := db.Unscoped().Preload("ItemType", func(db *gorm.DB) *gorm.DB { return db.Unscoped() }).Order("name").Find(&items).Error; err != nil {
+		return nil, err
+	}
+	// 価格は予定された変更を反映した今の価格と比べる
+	if err := pricing.Apply(db, items, time.Now()); err != nil {
+		return nil, err
+	}
+	return diff(m, itemTypes, items), nil
+}
+
+// メニュー定義と DB のアイテム種別・アイテム（削除済みを含む）の差分
+// メニューにないものは論理削除にして、物理削除はしない
+func diff(m *Menu, itemTypes []models.ItemType, items []models.Item) *Plan {
+	typesByName := map[string][]*models.ItemType{}
+	for i := range itemTypes {
+		typesByName[itemTypes[i].Name] = append(typesByName[itemTypes[i].Name], &itemTypes[i])
+	}
+	itemsByName := map[string][]*models.Item{}
+	for i := range items {
+		itemsByName[items[i].Name] = append(itemsByName[items[i].Name], &items[i])
+	}
+	typeDeleted := func(t *models.ItemType) bool { return t.Deleted.Valid }
+	itemDeleted := func(item *models.Item) bool { return item.Deleted.Valid }
+
+	plan := &Plan{}
+	var deletions []Change
+	for ti := range m.ItemTypes {
+		mt := &m.ItemTypes[ti]
+		displayName := mt.DisplayName
+		if displayName == "" {
+			displayName = mt.Name
+		}
+
+		current, rest := pickByName(typesByName[mt.Name], typeDeleted)
+		delete(typesByName, mt.Name)
+		for _, t := range rest {
+			if !typeDeleted(t) {
+				deletions = append(deletions, Change{Action: ActionDelete, Kind: KindItemType, Name: t.Name, itemType: t})
+			}
+		}
+		switch {
+		case current == nil:
+			plan.Changes = append(plan.Changes, Change{
+				Action:       ActionCreate,
+				Kind:         KindItemType,
+				Name:         mt.Name,
+				Fields:       diffField(nil, "display_name", "", displayName),
+				menuItemType: mt,
+			})
+		default:
+			fields := diffField(nil, "display_name", current.DisplayName, displayName)
+			action := ActionUpdate
+			if typeDeleted(current) {
+				action = ActionRestore
+			}
+			if action == ActionRestore || len(fields) > 0 {
+				plan.Changes = append(plan.Changes, Change{
+					Action:       action,
+					Kind:         KindItemType,
+					Name:         mt.Name,
+					Fields:       fields,
+					itemType:     current,
+					menuItemType: mt,
+				})
+			}
+		}
+
+		for ii := range mt.Items {
+			mi := &mt.Items[ii]
+			current, rest := pickByName(itemsByName[mi.Name], itemDeleted)
+			delete(itemsByName, mi.Name)
+			for _, item := range rest {
+				if !itemDeleted(item) {
+					deletions = append(deletions, Change{Action: ActionDelete, Kind: KindItem, Name: item.Name, item: item})
+				}
+			}
+
+			var fields []FieldChange
+			from := Item{}
+			fromType := ""
+			fromAvailable := true
+			if current != nil {
+				from = Item{Name: current.Name, Abbr: current.Abbr, Key: current.Key, Price: current.Price, Assignee: current.Assignee}
+				fromType = current.ItemType.Name
+				fromAvailable = current.Available
+			}
+			fields = diffField(fields, "item_type", fromType, mt.Name)
+			fields = diffField(fields, "abbr", from.Abbr, mi.Abbr)
+			fields = diffField(fields, "key", from.Key, mi.Key)
+			if current == nil || from.Price != mi.Price {
+				fields = append(fields, FieldChange{Field: "price", From: priceString(current, from.Price), To: strconv.Itoa(mi.Price)})
+			}
+			fields = diffField(fields, "assignee", from.Assignee, mi.Assignee)
+			if current == nil || fromAvailable != mi.IsAvailable() {
+				fields = diffField(fields, "available", strconv.FormatBool(fromAvailable), strconv.FormatBool(mi.IsAvailable()))
+			}
+
+			change := Change{Kind: KindItem, Name: mi.Name, Fields: fields, item: current, menuItem: mi, menuItemType: mt}
+			switch {
+			case current == nil:
+				change.Action = ActionCreate
+			case itemDeleted(current):
+				change.Action = ActionRestore
+			case len(fields) > 0:
+				change.Action = ActionUpdate
+			default:
+				continue
+			}
+			plan.Changes = append(plan.Changes, change)
+		}
+	}
+
+	// メニューにないものは削除する
+	for _, name := range sortedKeys(itemsByName) {
+		for _, item := range itemsByName[name] {
+			if !itemDeleted(item) {
+				deletions = append(deletions, Change{Action: ActionDelete, Kind: KindItem, Name: item.Name, item: item})
+			}
+		}
+	}
+	for _, name := range sortedKeys(typesByName) {
+		for _, t := range typesByName[name] {
+			if !typeDeleted(t) {
+				deletions = append(deletions, Change{Action: ActionDelete, Kind: KindItemType, Name: t.Name, itemType: t})
+			}
+		}
+	}
+	plan.Changes = append(plan.Changes, deletions...)
+	return plan
+}
+
+func priceString(current *models.Item, price int) string {
+	if current == nil {
+		return ""
+	}
+	return strconv.Itoa(price)
+}
@@ -0,0 +1,84 @@
+// api/internal/menu/seed.go
+package menu
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"cafeore-pos/api/internal/models"
+	"cafeore-pos/api/internal/pricing"
+)
+
+type SeedResult struct {
+	ItemTypesCreated int
+	ItemsCreated     int
+	ItemsSkipped     int
+}
+
+// メニューにあって DB にないアイテム種別・アイテムを作る
+// アイテム種別は名前、アイテムは名前とキーで照合し、既存のものは変更しない
+func Seed(db *gorm.DB, m *Menu) (*SeedResult, error) {
+	result := &SeedResult{}
+	err := db.Transaction(func(tx *gorm.DB) error {
+		for _, t := range m.ItemTypes {
+			var itemType models.ItemType
+			err := tx.Where("name = ?", t.Name).First(&itemType).Error
+			if err == gorm.ErrRecordNotFound {
+				itemType = models.ItemType{Name: t.Name, DisplayName: t.DisplayName}
+				if itemType.DisplayName == "" {
+					itemType.DisplayName = t.Name
+				}
+				if err := tx.Create(&itemType).Error; err != nil {
+					return err
+				}
+				result.ItemTypesCreated++
+			} else if err != nil {
+				return err
+			}
+
+			for _, item := range t.Items {
+				var count int64
+				if err := tx.Model(&models.Item{}).Where("name = ? AND key = ?", item.Name, item.Key).Count(&count).Error; err != nil {
+					return err
+				}
+				if count > 0 {
+					result.ItemsSkipped++
+					continue
+				}
+				if err := createItem(tx, &item, itemType.ID); err != nil {
+					return err
+				}
+				result.ItemsCreated++
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func createItem(tx *gorm.DB, item *Item, itemTypeID uuid.UUID) error {
+	created := models.Item{
+		Name:       item.Name,
+		Abbr:       item.Abbr,
+		Price:      item.Price,
+		Key:        item.Key,
+		Assignee:   item.Assignee,
+		ItemTypeID: itemTypeID,
+	}
+	if err := tx.Create(&created).Error; err != nil {
+		return err
+	}
+	if _, err := pricing.Record(tx, created.ID, created.Price, time.Now(), "menu"); err != nil {
+		return err
+	}
+	// available はDBの既定値（true）で作られるので、false の場合は後から更新する
+	if !item.IsAvailable() {
+		return tx.Model(&created).Update("available", false).Error
+	}
+	return nil
+}
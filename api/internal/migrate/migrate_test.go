@@ -0,0 +1,337 @@
+// api/internal/migrate/migrate_test.go
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"cafeore-pos/api/internal/models"
+)
+
+func TestEmbeddedMigrations(t *testing.T) {
+	m, err := New(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 番号は 1 から欠けずに続く
+	for i, migration := range m.migrations {
+		if migration.Version != int64(i+1) {
+			t.Fatalf("migration %d is %s, want version %d", i, migration, i+1)
+		}
+		if empty(migration.Up) {
+			t.Errorf("%s has an empty up file", migration)
+		}
+	}
+}
+
+func TestLoad(t *testing.T) {
+	fsys := fstest.MapFS{
+		"0002_second.up.sql":   {Data: []byte("SELECT 2;")},
+		"0002_second.down.sql": {Data: []byte("-- 戻さない\n")},
+		"0001_first.up.sql":    {Data: []byte("SELECT 1;")},
+		"0001_first.down.sql":  {Data: []byte("SELECT -1;")},
+	}
+	migrations, err := load(fsys)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(migrations) != 2 || migrations[0].String() != "0001_first" || migrations[1].String() != "0002_second" {
+		t.Fatalf("migrations = %v, want 0001_first and 0002_second", migrations)
+	}
+	// チェックサムは up の SQL だけで決まる
+	if migrations[0].Checksum == migrations[1].Checksum || len(migrations[0].Checksum) != 64 {
+		t.Fatalf("checksums = %q, %q", migrations[0].Checksum, migrations[1].Checksum)
+	}
+	if !empty(migrations[1].Down) {
+		t.Fatalf("down of 0002_second should be empty")
+	}
+
+	delete(fsys, "0002_second.down.sql")
+	if _, err := load(fsys); err == nil || !strings.Contains(err.Error(), "no down file") {
+		t.Fatalf("err = %v, want missing down file", err)
+	}
+}
+
+// AutoMigrate が作っていたテーブル（モデルを追加したらここにも追加する）
+var allModels = []interface{}{
+	&models.Session{}, &models.ItemType{}, &models.Item{}, &models.Order{}, &models.Comment{}, &models.OrderItem{},
+	&models.MasterState{}, &models.CashMovement{}, &models.CashCloseout{}, &models.CashCloseoutDenomination{},
+	&models.DrawerStock{}, &models.Refund{}, &models.RefundItem{}, &models.Payment{}, &models.ItemPrice{},
+	&models.ModifierGroup{}, &models.Modifier{}, &models.BundleComponent{}, &models.Promotion{},
+	&models.VoucherBatch{}, &models.Voucher{}, &models.PrintJob{}, &models.IssuedReceipt{},
+}
+
+type column struct {
+	typ      string
+	notNull  bool
+	defaults string
+}
+
+type table struct {
+	columns map[string]column
+	pk      string
+}
+
+// SQL から組み立てたスキーマ（外部キーは見ない）
+type schema struct {
+	tables  map[string]*table
+	indexes map[string]string
+}
+
+var (
+	createTable = regexp.MustCompile(`^CREATE TABLE (?:IF NOT EXISTS )?"(\w+)" \((.*)\)$`)
+	dropTable   = regexp.MustCompile(`^DROP TABLE (?:IF EXISTS )?"(\w+)"$`)
+	addColumn   = regexp.MustCompile(`^ALTER TABLE "(\w+)" ADD COLUMN (?:IF NOT EXISTS )?"(\w+)" (.*)$`)
+	dropColumn  = regexp.MustCompile(`^ALTER TABLE "(\w+)" DROP COLUMN (?:IF EXISTS )?"(\w+)"$`)
+	setNotNull  = regexp.MustCompile(`^ALTER TABLE "(\w+)" ALTER COLUMN "(\w+)" (SET|DROP) NOT NULL$`)
+	addPK       = regexp.MustCompile(`^ALTER TABLE "(\w+)" ADD CONSTRAINT "\w+" PRIMARY KEY \((.*)\)$`)
+	dropPK      = regexp.MustCompile(`^ALTER TABLE "(\w+)" DROP CONSTRAINT "(\w+)_pkey"$`)
+	createIndex = regexp.MustCompile(`^CREATE (UNIQUE )?INDEX (?:IF NOT EXISTS )?"(\w+)" ON (.*)$`)
+	dropIndex   = regexp.MustCompile(`^DROP INDEX (?:IF EXISTS )?"(\w+)"$`)
+	spaces      = regexp.MustCompile(`\s+`)
+)
+
+func parseColumn(def string) column {
+	typ, rest, _ := strings.Cut(def, " ")
+	c := column{typ: typ, notNull: strings.Contains(rest, "NOT NULL")}
+	rest = strings.TrimSpace(strings.ReplaceAll(rest, "NOT NULL", ""))
+	if d, ok := strings.CutPrefix(rest, "DEFAULT "); ok {
+		c.defaults = strings.TrimSpace(d)
+	}
+	return c
+}
+
+// カッコの外のカンマで分ける
+func splitTop(s string) []string {
+	var parts []string
+	depth, start := 0, 0
+	for i, r := range s {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				parts = append(parts, strings.TrimSpace(s[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	return append(parts, strings.TrimSpace(s[start:]))
+}
+
+// CREATE / ALTER / DROP の文を順に当てはめる（それ以外の文は読み飛ばす）
+func (s *schema) apply(t *testing.T, query string) {
+	t.Helper()
+	var lines []string
+	for _, line := range strings.Split(query, "\n") {
+		if !strings.HasPrefix(strings.TrimSpace(line), "--") {
+			lines = append(lines, line)
+		}
+	}
+	for _, stmt := range strings.Split(strings.Join(lines, "\n"), ";") {
+		stmt = strings.TrimSpace(spaces.ReplaceAllString(stmt, " "))
+		stmt = strings.ReplaceAll(strings.ReplaceAll(stmt, "( ", "("), " )", ")")
+		if m := createTable.FindStringSubmatch(stmt); m != nil {
+			if _, ok := s.tables[m[1]]; ok {
+				continue
+			}
+			tbl := &table{columns: map[string]column{}}
+			for _, part := range splitTop(m[2]) {
+				if pk, ok := strings.CutPrefix(part, "PRIMARY KEY "); ok {
+					tbl.pk = pk
+					continue
+				}
+				name, def, _ := strings.Cut(part, " ")
+				tbl.columns[strings.Trim(name, `"`)] = parseColumn(def)
+			}
+			s.tables[m[1]] = tbl
+		} else if m := dropTable.FindStringSubmatch(stmt); m != nil {
+			delete(s.tables, m[1])
+			for name, def := range s.indexes {
+				if strings.HasPrefix(def, `"`+m[1]+`" `) {
+					delete(s.indexes, name)
+				}
+			}
+		} else if m := addColumn.FindStringSubmatch(stmt); m != nil {
+			tbl := s.table(t, m[1])
+			if _, ok := tbl.columns[m[2]]; !ok {
+				tbl.columns[m[2]] = parseColumn(m[3])
+			}
+		} else if m := dropColumn.FindStringSubmatch(stmt); m != nil {
+			delete(s.table(t, m[1]).columns, m[2])
+			for name, def := range s.indexes {
+				if strings.HasPrefix(def, `"`+m[1]+`" `) && strings.Contains(def, `"`+m[2]+`"`) {
+					delete(s.indexes, name)
+				}
+			}
+		} else if m := setNotNull.FindStringSubmatch(stmt); m != nil {
+			tbl := s.table(t, m[1])
+			c := tbl.columns[m[2]]
+			c.notNull = m[3] == "SET"
+			tbl.columns[m[2]] = c
+		} else if m := addPK.FindStringSubmatch(stmt); m != nil {
+			s.table(t, m[1]).pk = "(" + m[2] + ")"
+		} else if m := dropPK.FindStringSubmatch(stmt); m != nil {
+			s.table(t, m[1]).pk = ""
+		} else if m := createIndex.FindStringSubmatch(stmt); m != nil {
+			if _, ok := s.indexes[m[2]]; !ok {
+				s.indexes[m[2]] = m[3] + strings.Repeat(" UNIQUE", len(m[1])/len("UNIQUE "))
+			}
+		} else if m := dropIndex.FindStringSubmatch(stmt); m != nil {
+			delete(s.indexes, m[1])
+		}
+	}
+}
+
+func (s *schema) table(t *testing.T, name string) *table {
+	t.Helper()
+	tbl, ok := s.tables[name]
+	if !ok {
+		t.Fatalf("table %q does not exist", name)
+	}
+	return tbl
+}
+
+func newSchema() *schema {
+	return &schema{tables: map[string]*table{}, indexes: map[string]string{}}
+}
+
+// DryRun で実行されなかった SQL を集める
+type recorder struct {
+	logger.Interface
+	queries []string
+}
+
+func (r *recorder) Trace(ctx context.Context, begin time.Time, fc func() (string, int64), err error) {
+	query, _ := fc()
+	r.queries = append(r.queries, query)
+}
+
+// モデルから AutoMigrate が作るスキーマ
+func modelSchema(t *testing.T) *schema {
+	rec := &recorder{Interface: logger.Discard}
+	db, err := gorm.Open(
+		postgres.New(postgres.Config{DSN: "host=127.0.0.1 port=1", PreferSimpleProtocol: true}),
+		&gorm.Config{
+			DryRun:                                   true,
+			DisableAutomaticPing:                     true,
+			DisableForeignKeyConstraintWhenMigrating: true,
+			Logger:                                   rec,
+		})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, model := range allModels {
+		if err := db.Migrator().CreateTable(model); err != nil {
+			t.Fatal(err)
+		}
+	}
+	s := newSchema()
+	s.apply(t, strings.Join(rec.queries, ";\n"))
+	return s
+}
+
+// すべて適用したマイグレーションのスキーマがモデルと一致する
+// （マイグレーションだけにある外部キー用のインデックスは許す）
+func TestMigrationsMatchModels(t *testing.T) {
+	m, err := New(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := newSchema()
+	for _, migration := range m.migrations {
+		got.apply(t, migration.Up)
+	}
+	want := modelSchema(t)
+
+	for name, wantTable := range want.tables {
+		gotTable, ok := got.tables[name]
+		if !ok {
+			t.Errorf("table %q is not created by migrations", name)
+			continue
+		}
+		if gotTable.pk != wantTable.pk {
+			t.Errorf("%s: primary key = %s, want %s", name, gotTable.pk, wantTable.pk)
+		}
+		for col, wantCol := range wantTable.columns {
+			gotCol, ok := gotTable.columns[col]
+			// 主キーの列は NOT NULL になる
+			if strings.Contains(wantTable.pk, `"`+col+`"`) {
+				wantCol.notNull, gotCol.notNull = true, true
+			}
+			if !ok {
+				t.Errorf("%s.%s is not created by migrations", name, col)
+			} else if gotCol != wantCol {
+				t.Errorf("%s.%s = %+v, want %+v", name, col, gotCol, wantCol)
+			}
+		}
+		for col := range gotTable.columns {
+			if _, ok := wantTable.columns[col]; !ok {
+				t.Errorf("%s.%s is not in the model", name, col)
+			}
+		}
+	}
+	for name := range got.tables {
+		if _, ok := want.tables[name]; !ok {
+			t.Errorf("table %q has no model", name)
+		}
+	}
+	for name, def := range want.indexes {
+		if got.indexes[name] != def {
+			t.Errorf("index %s = %q, want %q", name, got.indexes[name], def)
+		}
+	}
+
+	// down をすべて戻すと何も残らない
+	for i := len(m.migrations) - 1; i >= 0; i-- {
+		got.apply(t, m.migrations[i].Down)
+	}
+	if len(got.tables) != 0 || len(got.indexes) != 0 {
+		t.Errorf("after rolling back all migrations: tables %v, indexes %v", got.tables, got.indexes)
+	}
+}
+
+// TEST_DATABASE_URL の空のデータベースで、すべて適用・すべて戻す・もう一度適用を試す
+func TestMigrateDatabase(t *testing.T) {
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+	db, err := sql.Open("pgx", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	ctx := context.Background()
+	m, err := New(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Up(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Down(ctx, len(m.migrations)); err != nil {
+		t.Fatal(err)
+	}
+	applied, err := m.Up(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(applied) != len(m.migrations) {
+		t.Fatalf("applied %d migrations, want %d", len(applied), len(m.migrations))
+	}
+}
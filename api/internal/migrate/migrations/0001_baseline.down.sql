@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS "master_states";
+DROP TABLE IF EXISTS "order_items";
+DROP TABLE IF EXISTS "comments";
+DROP TABLE IF EXISTS "orders";
+DROP TABLE IF EXISTS "items";
+DROP TABLE IF EXISTS "item_types";
@@ -0,0 +1,60 @@
+-- 0001: AutoMigrate で作っていたスキーマ（セッション導入前）
+-- AutoMigrate で作った既存のデータベースにもそのまま適用できるように IF NOT EXISTS にしている
+CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
+
+CREATE TABLE IF NOT EXISTS "item_types" (
+  "id" uuid DEFAULT uuid_generate_v4(),
+  "name" text NOT NULL,
+  "display_name" text NOT NULL,
+  "deleted" timestamptz,
+  PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_item_types_deleted" ON "item_types" ("deleted");
+
+CREATE TABLE IF NOT EXISTS "items" (
+  "id" uuid DEFAULT uuid_generate_v4(),
+  "name" text NOT NULL,
+  "abbr" text NOT NULL,
+  "price" bigint NOT NULL,
+  "key" text NOT NULL,
+  "deleted" timestamptz,
+  "assignee" text,
+  "item_type_id" uuid NOT NULL,
+  PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_items_deleted" ON "items" ("deleted");
+
+CREATE TABLE IF NOT EXISTS "orders" (
+  "id" uuid DEFAULT uuid_generate_v4(),
+  "order_id" bigint NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "ready_at" timestamptz,
+  "served_at" timestamptz,
+  "billing_amount" bigint NOT NULL,
+  "received" bigint NOT NULL,
+  "discount_order_id" bigint,
+  "discount_order_cups" bigint,
+  PRIMARY KEY ("id")
+);
+
+CREATE TABLE IF NOT EXISTS "comments" (
+  "order_id" uuid NOT NULL,
+  "author" text NOT NULL,
+  "text" text NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY ("order_id","created_at")
+);
+
+CREATE TABLE IF NOT EXISTS "order_items" (
+  "order_id" uuid NOT NULL,
+  "item_id" uuid NOT NULL,
+  "assignee" text
+);
+CREATE INDEX IF NOT EXISTS "idx_order_items_item_id" ON "order_items" ("item_id");
+CREATE INDEX IF NOT EXISTS "idx_order_items_order_id" ON "order_items" ("order_id");
+
+CREATE TABLE IF NOT EXISTS "master_states" (
+  "created_at" timestamptz NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  "type" text NOT NULL,
+  PRIMARY KEY ("created_at")
+);
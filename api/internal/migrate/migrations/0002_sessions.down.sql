@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS "idx_master_states_session_id";
+ALTER TABLE "master_states" DROP COLUMN IF EXISTS "session_id";
+DROP INDEX IF EXISTS "idx_orders_session_order_id";
+ALTER TABLE "orders" DROP COLUMN IF EXISTS "session_id";
+DROP TABLE IF EXISTS "sessions";
@@ -0,0 +1,16 @@
+-- 0002: 営業日のセッション（既存のオーダーはセッションなしのまま）
+CREATE TABLE IF NOT EXISTS "sessions" (
+  "id" uuid DEFAULT uuid_generate_v4(),
+  "name" text NOT NULL,
+  "opened_at" timestamptz NOT NULL,
+  "closed_at" timestamptz,
+  "opening_float" bigint NOT NULL DEFAULT 0,
+  PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_sessions_closed_at" ON "sessions" ("closed_at");
+
+ALTER TABLE "orders" ADD COLUMN IF NOT EXISTS "session_id" uuid;
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_orders_session_order_id" ON "orders" ("session_id","order_id");
+
+ALTER TABLE "master_states" ADD COLUMN IF NOT EXISTS "session_id" uuid;
+CREATE INDEX IF NOT EXISTS "idx_master_states_session_id" ON "master_states" ("session_id");
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS "cash_closeout_denominations";
+DROP TABLE IF EXISTS "cash_closeouts";
+DROP TABLE IF EXISTS "cash_movements";
+ALTER TABLE "orders" DROP COLUMN IF EXISTS "register";
@@ -0,0 +1,39 @@
+-- 0003: レジの入出金と締め
+ALTER TABLE "orders" ADD COLUMN IF NOT EXISTS "register" text NOT NULL DEFAULT 'main';
+
+CREATE TABLE IF NOT EXISTS "cash_movements" (
+  "id" uuid DEFAULT uuid_generate_v4(),
+  "session_id" uuid NOT NULL,
+  "register" text NOT NULL DEFAULT 'main',
+  "type" text NOT NULL,
+  "amount" bigint NOT NULL,
+  "reason" text NOT NULL DEFAULT '',
+  "author" text NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_cash_movements_session_id" ON "cash_movements" ("session_id");
+
+CREATE TABLE IF NOT EXISTS "cash_closeouts" (
+  "id" uuid DEFAULT uuid_generate_v4(),
+  "session_id" uuid NOT NULL,
+  "register" text NOT NULL,
+  "opening_float" bigint NOT NULL,
+  "cash_sales" bigint NOT NULL,
+  "pay_ins" bigint NOT NULL,
+  "pay_outs" bigint NOT NULL,
+  "expected" bigint NOT NULL,
+  "counted" bigint NOT NULL,
+  "variance" bigint NOT NULL,
+  "counted_by" text NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_cash_closeouts_session_register" ON "cash_closeouts" ("session_id","register");
+
+CREATE TABLE IF NOT EXISTS "cash_closeout_denominations" (
+  "closeout_id" uuid NOT NULL,
+  "denomination" bigint NOT NULL,
+  "count" bigint NOT NULL,
+  PRIMARY KEY ("closeout_id","denomination")
+);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "drawer_stocks";
+ALTER TABLE "orders" DROP COLUMN IF EXISTS "change";
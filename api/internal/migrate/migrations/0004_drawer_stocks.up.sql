@@ -0,0 +1,11 @@
+-- 0004: お釣りとレジ内の金種の在庫
+ALTER TABLE "orders" ADD COLUMN IF NOT EXISTS "change" bigint NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS "drawer_stocks" (
+  "session_id" uuid NOT NULL,
+  "register" text NOT NULL,
+  "denomination" bigint NOT NULL,
+  "count" bigint NOT NULL,
+  "low_threshold" bigint NOT NULL DEFAULT 0,
+  PRIMARY KEY ("session_id","register","denomination")
+);
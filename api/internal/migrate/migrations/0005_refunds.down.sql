@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "refund_items";
+DROP TABLE IF EXISTS "refunds";
+ALTER TABLE "cash_closeouts" DROP COLUMN IF EXISTS "refunds";
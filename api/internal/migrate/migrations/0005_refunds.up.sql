@@ -0,0 +1,24 @@
+-- 0005: 返金・作り直し
+ALTER TABLE "cash_closeouts" ADD COLUMN IF NOT EXISTS "refunds" bigint NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS "refunds" (
+  "id" uuid DEFAULT uuid_generate_v4(),
+  "order_id" uuid NOT NULL,
+  "session_id" uuid NOT NULL,
+  "register" text NOT NULL DEFAULT 'main',
+  "type" text NOT NULL,
+  "reason" text NOT NULL,
+  "amount" bigint NOT NULL,
+  "author" text NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_refunds_session_id" ON "refunds" ("session_id");
+CREATE INDEX IF NOT EXISTS "idx_refunds_order_id" ON "refunds" ("order_id");
+
+CREATE TABLE IF NOT EXISTS "refund_items" (
+  "refund_id" uuid NOT NULL,
+  "item_id" uuid NOT NULL,
+  "quantity" bigint NOT NULL,
+  PRIMARY KEY ("refund_id","item_id")
+);
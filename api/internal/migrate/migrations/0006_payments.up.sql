@@ -0,0 +1,13 @@
+-- 0006: 支払い（分割払い）
+CREATE TABLE IF NOT EXISTS "payments" (
+  "id" uuid DEFAULT uuid_generate_v4(),
+  "order_id" uuid NOT NULL,
+  "method" text NOT NULL,
+  "amount" bigint NOT NULL,
+  "status" text NOT NULL,
+  "external_ref" text,
+  "message" text,
+  "created_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_payments_order_id" ON "payments" ("order_id");
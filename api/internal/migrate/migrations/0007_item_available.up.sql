@@ -0,0 +1,2 @@
+-- 0007: アイテムの販売可否
+ALTER TABLE "items" ADD COLUMN IF NOT EXISTS "available" boolean NOT NULL DEFAULT true;
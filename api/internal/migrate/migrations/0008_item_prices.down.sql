@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS "item_prices";
+ALTER TABLE "order_items" DROP COLUMN IF EXISTS "unit_price";
+ALTER TABLE "order_items" DROP COLUMN IF EXISTS "abbr";
+ALTER TABLE "order_items" DROP COLUMN IF EXISTS "name";
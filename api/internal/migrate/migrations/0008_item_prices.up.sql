@@ -0,0 +1,19 @@
+-- 0008: オーダーアイテムの注文時点の内容と価格の履歴
+ALTER TABLE "order_items" ADD COLUMN IF NOT EXISTS "name" text NOT NULL DEFAULT '';
+ALTER TABLE "order_items" ADD COLUMN IF NOT EXISTS "abbr" text NOT NULL DEFAULT '';
+ALTER TABLE "order_items" ADD COLUMN IF NOT EXISTS "unit_price" bigint NOT NULL DEFAULT 0;
+-- 単価の記録がない過去のオーダーアイテムに現在のアイテムの内容を入れる（これまでは起動のたびに実行していた）
+UPDATE "order_items" SET "name" = "items"."name", "abbr" = "items"."abbr", "unit_price" = "items"."price"
+FROM "items"
+WHERE "items"."id" = "order_items"."item_id" AND "order_items"."name" = '';
+
+CREATE TABLE IF NOT EXISTS "item_prices" (
+  "id" uuid DEFAULT uuid_generate_v4(),
+  "item_id" uuid NOT NULL,
+  "price" bigint NOT NULL,
+  "effective_from" timestamptz NOT NULL,
+  "reason" text,
+  "created_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_item_prices_item_effective" ON "item_prices" ("item_id","effective_from");
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "modifiers";
+DROP TABLE IF EXISTS "modifier_groups";
+ALTER TABLE "order_items" DROP COLUMN IF EXISTS "modifiers";
@@ -0,0 +1,28 @@
+-- 0009: 選択肢（オプション）
+ALTER TABLE "order_items" ADD COLUMN IF NOT EXISTS "modifiers" jsonb NOT NULL DEFAULT '[]';
+
+CREATE TABLE IF NOT EXISTS "modifier_groups" (
+  "id" uuid DEFAULT uuid_generate_v4(),
+  "name" text NOT NULL,
+  "display_name" text NOT NULL,
+  "min_select" bigint NOT NULL DEFAULT 0,
+  "max_select" bigint NOT NULL DEFAULT 1,
+  "item_id" uuid,
+  "item_type_id" uuid,
+  "deleted" timestamptz,
+  PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_modifier_groups_deleted" ON "modifier_groups" ("deleted");
+CREATE INDEX IF NOT EXISTS "idx_modifier_groups_item_type_id" ON "modifier_groups" ("item_type_id");
+CREATE INDEX IF NOT EXISTS "idx_modifier_groups_item_id" ON "modifier_groups" ("item_id");
+
+CREATE TABLE IF NOT EXISTS "modifiers" (
+  "id" uuid DEFAULT uuid_generate_v4(),
+  "group_id" uuid NOT NULL,
+  "name" text NOT NULL,
+  "price_delta" bigint NOT NULL DEFAULT 0,
+  "deleted" timestamptz,
+  PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_modifiers_deleted" ON "modifiers" ("deleted");
+CREATE INDEX IF NOT EXISTS "idx_modifiers_group_id" ON "modifiers" ("group_id");
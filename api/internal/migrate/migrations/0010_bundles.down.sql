@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "bundle_components";
+ALTER TABLE "order_items" DROP COLUMN IF EXISTS "components";
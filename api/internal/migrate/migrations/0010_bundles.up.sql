@@ -0,0 +1,11 @@
+-- 0010: セット商品
+ALTER TABLE "order_items" ADD COLUMN IF NOT EXISTS "components" jsonb NOT NULL DEFAULT '[]';
+
+CREATE TABLE IF NOT EXISTS "bundle_components" (
+  "id" uuid DEFAULT uuid_generate_v4(),
+  "bundle_item_id" uuid NOT NULL,
+  "component_item_id" uuid NOT NULL,
+  "quantity" bigint NOT NULL DEFAULT 1,
+  PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_bundle_components_bundle_item_id" ON "bundle_components" ("bundle_item_id");
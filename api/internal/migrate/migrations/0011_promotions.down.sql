@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "promotions";
+ALTER TABLE "orders" DROP COLUMN IF EXISTS "applied_promotions";
+ALTER TABLE "orders" DROP COLUMN IF EXISTS "discount";
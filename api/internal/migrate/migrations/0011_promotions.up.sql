@@ -0,0 +1,26 @@
+-- 0011: 割引ルール
+ALTER TABLE "orders" ADD COLUMN IF NOT EXISTS "discount" bigint NOT NULL DEFAULT 0;
+ALTER TABLE "orders" ADD COLUMN IF NOT EXISTS "applied_promotions" jsonb NOT NULL DEFAULT '[]';
+
+CREATE TABLE IF NOT EXISTS "promotions" (
+  "id" uuid DEFAULT uuid_generate_v4(),
+  "name" text NOT NULL,
+  "type" text NOT NULL,
+  "active" boolean NOT NULL DEFAULT true,
+  "priority" bigint NOT NULL DEFAULT 0,
+  "amount" bigint NOT NULL DEFAULT 0,
+  "percent" bigint NOT NULL DEFAULT 0,
+  "item_type_id" uuid,
+  "item_id" uuid,
+  "buy_quantity" bigint NOT NULL DEFAULT 0,
+  "free_quantity" bigint NOT NULL DEFAULT 0,
+  "min_subtotal" bigint NOT NULL DEFAULT 0,
+  "starts_at" timestamptz,
+  "ends_at" timestamptz,
+  "daily_start" text,
+  "daily_end" text,
+  "created_at" timestamptz NOT NULL,
+  "deleted" timestamptz,
+  PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_promotions_deleted" ON "promotions" ("deleted");
@@ -0,0 +1,28 @@
+-- 0012: クーポン
+CREATE TABLE IF NOT EXISTS "voucher_batches" (
+  "id" uuid DEFAULT uuid_generate_v4(),
+  "name" text NOT NULL,
+  "type" text NOT NULL,
+  "amount" bigint NOT NULL DEFAULT 0,
+  "percent" bigint NOT NULL DEFAULT 0,
+  "item_type_id" uuid,
+  "item_id" uuid,
+  "buy_quantity" bigint NOT NULL DEFAULT 0,
+  "free_quantity" bigint NOT NULL DEFAULT 0,
+  "min_subtotal" bigint NOT NULL DEFAULT 0,
+  "expires_at" timestamptz,
+  "created_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+
+CREATE TABLE IF NOT EXISTS "vouchers" (
+  "id" uuid DEFAULT uuid_generate_v4(),
+  "batch_id" uuid NOT NULL,
+  "code" text NOT NULL,
+  "redeemed_order_id" uuid,
+  "redeemed_at" timestamptz,
+  PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_vouchers_redeemed_order_id" ON "vouchers" ("redeemed_order_id");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_vouchers_code" ON "vouchers" ("code");
+CREATE INDEX IF NOT EXISTS "idx_vouchers_batch_id" ON "vouchers" ("batch_id");
@@ -0,0 +1,17 @@
+-- 0013: 印刷ジョブ
+CREATE TABLE IF NOT EXISTS "print_jobs" (
+  "id" uuid DEFAULT uuid_generate_v4(),
+  "order_id" uuid NOT NULL,
+  "kind" text NOT NULL,
+  "printer" text NOT NULL,
+  "status" text NOT NULL,
+  "attempts" bigint NOT NULL DEFAULT 0,
+  "last_error" text NOT NULL DEFAULT '',
+  "next_attempt_at" timestamptz NOT NULL,
+  "printed_at" timestamptz,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+CREATE INDEX IF NOT EXISTS "idx_print_jobs_status_next_attempt" ON "print_jobs" ("status","next_attempt_at");
+CREATE INDEX IF NOT EXISTS "idx_print_jobs_order_id" ON "print_jobs" ("order_id");
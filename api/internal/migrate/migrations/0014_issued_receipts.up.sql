@@ -0,0 +1,11 @@
+-- 0014: 領収書
+CREATE TABLE IF NOT EXISTS "issued_receipts" (
+  "id" uuid DEFAULT uuid_generate_v4(),
+  "order_id" uuid NOT NULL,
+  "number" bigint NOT NULL,
+  "addressee" text NOT NULL DEFAULT '',
+  "issued_at" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_issued_receipts_number" ON "issued_receipts" ("number");
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_issued_receipts_order_id" ON "issued_receipts" ("order_id");
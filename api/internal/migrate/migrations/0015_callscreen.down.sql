@@ -0,0 +1,3 @@
+ALTER TABLE "orders" DROP COLUMN IF EXISTS "recall_count";
+ALTER TABLE "orders" DROP COLUMN IF EXISTS "last_called_at";
+ALTER TABLE "orders" DROP COLUMN IF EXISTS "called_at";
@@ -0,0 +1,4 @@
+-- 0015: 呼び出し画面
+ALTER TABLE "orders" ADD COLUMN IF NOT EXISTS "called_at" timestamptz;
+ALTER TABLE "orders" ADD COLUMN IF NOT EXISTS "last_called_at" timestamptz;
+ALTER TABLE "orders" ADD COLUMN IF NOT EXISTS "recall_count" bigint NOT NULL DEFAULT 0;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS "idx_orders_tracking_token";
+ALTER TABLE "orders" DROP COLUMN IF EXISTS "tracking_token";
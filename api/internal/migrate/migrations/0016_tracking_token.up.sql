@@ -0,0 +1,3 @@
+-- 0016: お客様向けの注文状況の URL
+ALTER TABLE "orders" ADD COLUMN IF NOT EXISTS "tracking_token" text NOT NULL DEFAULT '';
+CREATE INDEX IF NOT EXISTS "idx_orders_tracking_token" ON "orders" ("tracking_token");
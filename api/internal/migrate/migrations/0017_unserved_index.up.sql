@@ -0,0 +1,2 @@
+-- 0017: 未提供のオーダーの待ち行列
+CREATE INDEX IF NOT EXISTS "idx_orders_unserved" ON "orders" ("session_id","created_at") WHERE served_at IS NULL;
@@ -0,0 +1,2 @@
+ALTER TABLE "order_items" DROP CONSTRAINT "order_items_pkey";
+ALTER TABLE "order_items" DROP COLUMN "id";
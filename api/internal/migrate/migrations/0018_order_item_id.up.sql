@@ -0,0 +1,4 @@
+-- 0018: order_items に主キーを付ける
+-- 既存の行には一行ずつ別の UUID が入る
+ALTER TABLE "order_items" ADD COLUMN "id" uuid NOT NULL DEFAULT uuid_generate_v4();
+ALTER TABLE "order_items" ADD CONSTRAINT "order_items_pkey" PRIMARY KEY ("id");
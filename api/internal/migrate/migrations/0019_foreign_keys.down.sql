@@ -0,0 +1,37 @@
+-- 0019 で消した行は戻さない
+DROP INDEX "idx_voucher_batches_item_id";
+DROP INDEX "idx_voucher_batches_item_type_id";
+DROP INDEX "idx_promotions_item_id";
+DROP INDEX "idx_promotions_item_type_id";
+DROP INDEX "idx_bundle_components_component_item_id";
+DROP INDEX "idx_refund_items_item_id";
+DROP INDEX "idx_items_item_type_id";
+ALTER TABLE "vouchers" DROP CONSTRAINT "fk_vouchers_redeemed_order";
+ALTER TABLE "issued_receipts" DROP CONSTRAINT "fk_issued_receipts_order";
+ALTER TABLE "print_jobs" DROP CONSTRAINT "fk_print_jobs_order";
+ALTER TABLE "refund_items" DROP CONSTRAINT "fk_refund_items_item";
+ALTER TABLE "refund_items" DROP CONSTRAINT "fk_refund_items_refund";
+ALTER TABLE "refunds" DROP CONSTRAINT "fk_refunds_order";
+ALTER TABLE "payments" DROP CONSTRAINT "fk_payments_order";
+ALTER TABLE "comments" DROP CONSTRAINT "fk_comments_order";
+ALTER TABLE "order_items" DROP CONSTRAINT "fk_order_items_item";
+ALTER TABLE "order_items" DROP CONSTRAINT "fk_order_items_order";
+ALTER TABLE "vouchers" DROP CONSTRAINT "fk_vouchers_batch";
+ALTER TABLE "voucher_batches" DROP CONSTRAINT "fk_voucher_batches_item";
+ALTER TABLE "voucher_batches" DROP CONSTRAINT "fk_voucher_batches_item_type";
+ALTER TABLE "promotions" DROP CONSTRAINT "fk_promotions_item";
+ALTER TABLE "promotions" DROP CONSTRAINT "fk_promotions_item_type";
+ALTER TABLE "modifiers" DROP CONSTRAINT "fk_modifiers_group";
+ALTER TABLE "modifier_groups" DROP CONSTRAINT "fk_modifier_groups_item_type";
+ALTER TABLE "modifier_groups" DROP CONSTRAINT "fk_modifier_groups_item";
+ALTER TABLE "bundle_components" DROP CONSTRAINT "fk_bundle_components_component_item";
+ALTER TABLE "bundle_components" DROP CONSTRAINT "fk_bundle_components_bundle_item";
+ALTER TABLE "item_prices" DROP CONSTRAINT "fk_item_prices_item";
+ALTER TABLE "items" DROP CONSTRAINT "fk_items_item_type";
+ALTER TABLE "cash_closeout_denominations" DROP CONSTRAINT "fk_cash_closeout_denominations_closeout";
+ALTER TABLE "refunds" DROP CONSTRAINT "fk_refunds_session";
+ALTER TABLE "drawer_stocks" DROP CONSTRAINT "fk_drawer_stocks_session";
+ALTER TABLE "cash_closeouts" DROP CONSTRAINT "fk_cash_closeouts_session";
+ALTER TABLE "cash_movements" DROP CONSTRAINT "fk_cash_movements_session";
+ALTER TABLE "master_states" DROP CONSTRAINT "fk_master_states_session";
+ALTER TABLE "orders" DROP CONSTRAINT "fk_orders_session";
@@ -0,0 +1,62 @@
+-- 0019: 外部キーと、外部キーの列に足りていなかったインデックス
+-- オーダーにぶら下がる行はオーダーと一緒に消す（DELETE /api/orders と cafeore-admin purge-orders）
+-- マスタ（アイテム・種別・セッション）は論理削除しかしないので ON DELETE は付けない
+
+-- これまで外部キーがなかったので、消したオーダーを指す行が残っていることがある
+DELETE FROM "refund_items" WHERE "refund_id" IN (
+	SELECT "id" FROM "refunds" WHERE NOT EXISTS (SELECT 1 FROM "orders" WHERE "orders"."id" = "refunds"."order_id")
+);
+DELETE FROM "refunds" WHERE NOT EXISTS (SELECT 1 FROM "orders" WHERE "orders"."id" = "refunds"."order_id");
+DELETE FROM "payments" WHERE NOT EXISTS (SELECT 1 FROM "orders" WHERE "orders"."id" = "payments"."order_id");
+DELETE FROM "comments" WHERE NOT EXISTS (SELECT 1 FROM "orders" WHERE "orders"."id" = "comments"."order_id");
+DELETE FROM "order_items" WHERE NOT EXISTS (SELECT 1 FROM "orders" WHERE "orders"."id" = "order_items"."order_id");
+DELETE FROM "print_jobs" WHERE NOT EXISTS (SELECT 1 FROM "orders" WHERE "orders"."id" = "print_jobs"."order_id");
+DELETE FROM "issued_receipts" WHERE NOT EXISTS (SELECT 1 FROM "orders" WHERE "orders"."id" = "issued_receipts"."order_id");
+-- purge-orders で消したオーダーに使われたクーポンは使われていないことにする（ON DELETE SET NULL と同じ）
+UPDATE "vouchers" SET "redeemed_order_id" = NULL
+WHERE "redeemed_order_id" IS NOT NULL
+	AND NOT EXISTS (SELECT 1 FROM "orders" WHERE "orders"."id" = "vouchers"."redeemed_order_id");
+
+-- セッション
+ALTER TABLE "orders" ADD CONSTRAINT "fk_orders_session" FOREIGN KEY ("session_id") REFERENCES "sessions" ("id");
+ALTER TABLE "master_states" ADD CONSTRAINT "fk_master_states_session" FOREIGN KEY ("session_id") REFERENCES "sessions" ("id");
+ALTER TABLE "cash_movements" ADD CONSTRAINT "fk_cash_movements_session" FOREIGN KEY ("session_id") REFERENCES "sessions" ("id");
+ALTER TABLE "cash_closeouts" ADD CONSTRAINT "fk_cash_closeouts_session" FOREIGN KEY ("session_id") REFERENCES "sessions" ("id");
+ALTER TABLE "drawer_stocks" ADD CONSTRAINT "fk_drawer_stocks_session" FOREIGN KEY ("session_id") REFERENCES "sessions" ("id");
+ALTER TABLE "refunds" ADD CONSTRAINT "fk_refunds_session" FOREIGN KEY ("session_id") REFERENCES "sessions" ("id");
+ALTER TABLE "cash_closeout_denominations" ADD CONSTRAINT "fk_cash_closeout_denominations_closeout" FOREIGN KEY ("closeout_id") REFERENCES "cash_closeouts" ("id") ON DELETE CASCADE;
+
+-- アイテム
+ALTER TABLE "items" ADD CONSTRAINT "fk_items_item_type" FOREIGN KEY ("item_type_id") REFERENCES "item_types" ("id");
+ALTER TABLE "item_prices" ADD CONSTRAINT "fk_item_prices_item" FOREIGN KEY ("item_id") REFERENCES "items" ("id") ON DELETE CASCADE;
+ALTER TABLE "bundle_components" ADD CONSTRAINT "fk_bundle_components_bundle_item" FOREIGN KEY ("bundle_item_id") REFERENCES "items" ("id") ON DELETE CASCADE;
+ALTER TABLE "bundle_components" ADD CONSTRAINT "fk_bundle_components_component_item" FOREIGN KEY ("component_item_id") REFERENCES "items" ("id");
+ALTER TABLE "modifier_groups" ADD CONSTRAINT "fk_modifier_groups_item" FOREIGN KEY ("item_id") REFERENCES "items" ("id");
+ALTER TABLE "modifier_groups" ADD CONSTRAINT "fk_modifier_groups_item_type" FOREIGN KEY ("item_type_id") REFERENCES "item_types" ("id");
+ALTER TABLE "modifiers" ADD CONSTRAINT "fk_modifiers_group" FOREIGN KEY ("group_id") REFERENCES "modifier_groups" ("id") ON DELETE CASCADE;
+ALTER TABLE "promotions" ADD CONSTRAINT "fk_promotions_item_type" FOREIGN KEY ("item_type_id") REFERENCES "item_types" ("id");
+ALTER TABLE "promotions" ADD CONSTRAINT "fk_promotions_item" FOREIGN KEY ("item_id") REFERENCES "items" ("id");
+ALTER TABLE "voucher_batches" ADD CONSTRAINT "fk_voucher_batches_item_type" FOREIGN KEY ("item_type_id") REFERENCES "item_types" ("id");
+ALTER TABLE "voucher_batches" ADD CONSTRAINT "fk_voucher_batches_item" FOREIGN KEY ("item_id") REFERENCES "items" ("id");
+ALTER TABLE "vouchers" ADD CONSTRAINT "fk_vouchers_batch" FOREIGN KEY ("batch_id") REFERENCES "voucher_batches" ("id") ON DELETE CASCADE;
+
+-- オーダー
+ALTER TABLE "order_items" ADD CONSTRAINT "fk_order_items_order" FOREIGN KEY ("order_id") REFERENCES "orders" ("id") ON DELETE CASCADE;
+ALTER TABLE "order_items" ADD CONSTRAINT "fk_order_items_item" FOREIGN KEY ("item_id") REFERENCES "items" ("id");
+ALTER TABLE "comments" ADD CONSTRAINT "fk_comments_order" FOREIGN KEY ("order_id") REFERENCES "orders" ("id") ON DELETE CASCADE;
+ALTER TABLE "payments" ADD CONSTRAINT "fk_payments_order" FOREIGN KEY ("order_id") REFERENCES "orders" ("id") ON DELETE CASCADE;
+ALTER TABLE "refunds" ADD CONSTRAINT "fk_refunds_order" FOREIGN KEY ("order_id") REFERENCES "orders" ("id") ON DELETE CASCADE;
+ALTER TABLE "refund_items" ADD CONSTRAINT "fk_refund_items_refund" FOREIGN KEY ("refund_id") REFERENCES "refunds" ("id") ON DELETE CASCADE;
+ALTER TABLE "refund_items" ADD CONSTRAINT "fk_refund_items_item" FOREIGN KEY ("item_id") REFERENCES "items" ("id");
+ALTER TABLE "print_jobs" ADD CONSTRAINT "fk_print_jobs_order" FOREIGN KEY ("order_id") REFERENCES "orders" ("id") ON DELETE CASCADE;
+ALTER TABLE "issued_receipts" ADD CONSTRAINT "fk_issued_receipts_order" FOREIGN KEY ("order_id") REFERENCES "orders" ("id") ON DELETE CASCADE;
+ALTER TABLE "vouchers" ADD CONSTRAINT "fk_vouchers_redeemed_order" FOREIGN KEY ("redeemed_order_id") REFERENCES "orders" ("id") ON DELETE SET NULL;
+
+-- 既存のインデックスや主キーの先頭になっていない外部キーの列のインデックス（ないと親を消すたびに全件なめる）
+CREATE INDEX "idx_items_item_type_id" ON "items" ("item_type_id");
+CREATE INDEX "idx_refund_items_item_id" ON "refund_items" ("item_id");
+CREATE INDEX "idx_bundle_components_component_item_id" ON "bundle_components" ("component_item_id");
+CREATE INDEX "idx_promotions_item_type_id" ON "promotions" ("item_type_id");
+CREATE INDEX "idx_promotions_item_id" ON "promotions" ("item_id");
+CREATE INDEX "idx_voucher_batches_item_type_id" ON "voucher_batches" ("item_type_id");
+CREATE INDEX "idx_voucher_batches_item_id" ON "voucher_batches" ("item_id");
@@ -0,0 +1,15 @@
+DROP INDEX "idx_refund_items_order_item_id";
+ALTER TABLE "refund_items" DROP CONSTRAINT "fk_refund_items_order_item";
+ALTER TABLE "refund_items" DROP CONSTRAINT "refund_items_pkey";
+ALTER TABLE "refund_items" ADD COLUMN "quantity" bigint;
+
+-- 行ごとの記録をアイテムごとの個数にまとめ直す
+WITH "old" AS (
+	DELETE FROM "refund_items" RETURNING "refund_id", "item_id"
+)
+INSERT INTO "refund_items" ("refund_id", "item_id", "quantity")
+SELECT "refund_id", "item_id", COUNT(*) FROM "old" GROUP BY "refund_id", "item_id";
+
+ALTER TABLE "refund_items" DROP COLUMN "order_item_id";
+ALTER TABLE "refund_items" ALTER COLUMN "quantity" SET NOT NULL;
+ALTER TABLE "refund_items" ADD CONSTRAINT "refund_items_pkey" PRIMARY KEY ("refund_id","item_id");
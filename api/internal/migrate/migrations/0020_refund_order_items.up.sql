@@ -0,0 +1,28 @@
+-- 0020: 返金・作り直しの対象をオーダー内の行で記録する
+-- これまでは (アイテム, 個数) で記録していたので、同じアイテムの行に古い返金から順に割り当てる
+ALTER TABLE "refund_items" ADD COLUMN "order_item_id" uuid;
+ALTER TABLE "refund_items" DROP CONSTRAINT "refund_items_pkey";
+
+WITH "old" AS (
+	DELETE FROM "refund_items" RETURNING "refund_id", "item_id", "quantity"
+), "units" AS (
+	SELECT "old"."refund_id", "old"."item_id", "refunds"."order_id",
+		ROW_NUMBER() OVER (PARTITION BY "refunds"."order_id", "old"."item_id" ORDER BY "refunds"."created_at", "refunds"."id") AS "n"
+	FROM "old"
+	JOIN "refunds" ON "refunds"."id" = "old"."refund_id"
+	CROSS JOIN generate_series(1, "old"."quantity")
+), "lines" AS (
+	SELECT "id", "order_id", "item_id",
+		ROW_NUMBER() OVER (PARTITION BY "order_id", "item_id" ORDER BY "id") AS "n"
+	FROM "order_items"
+)
+INSERT INTO "refund_items" ("refund_id", "item_id", "order_item_id")
+SELECT "units"."refund_id", "units"."item_id", "lines"."id"
+FROM "units"
+JOIN "lines" ON "lines"."order_id" = "units"."order_id" AND "lines"."item_id" = "units"."item_id" AND "lines"."n" = "units"."n";
+
+ALTER TABLE "refund_items" DROP COLUMN "quantity";
+ALTER TABLE "refund_items" ALTER COLUMN "order_item_id" SET NOT NULL;
+ALTER TABLE "refund_items" ADD CONSTRAINT "refund_items_pkey" PRIMARY KEY ("refund_id","order_item_id");
+ALTER TABLE "refund_items" ADD CONSTRAINT "fk_refund_items_order_item" FOREIGN KEY ("order_item_id") REFERENCES "order_items" ("id") ON DELETE CASCADE;
+CREATE INDEX "idx_refund_items_order_item_id" ON "refund_items" ("order_item_id");
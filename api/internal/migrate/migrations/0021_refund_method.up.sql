@@ -0,0 +1,3 @@
+-- 0021: 返金した決済手段
+-- これまでの返金はすべて現金としてレジの在庫から出していたので cash にする
+ALTER TABLE "refunds" ADD COLUMN IF NOT EXISTS "method" text NOT NULL DEFAULT 'cash';
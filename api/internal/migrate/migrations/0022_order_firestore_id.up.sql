@@ -0,0 +1,3 @@
+-- 0022: Firestore から取り込んだオーダーのドキュメント ID
+ALTER TABLE "orders" ADD COLUMN IF NOT EXISTS "firestore_id" text;
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_orders_firestore_id" ON "orders" ("firestore_id");
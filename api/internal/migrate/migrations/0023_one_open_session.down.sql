@@ -0,0 +1 @@
+DROP INDEX IF EXISTS "idx_sessions_open";
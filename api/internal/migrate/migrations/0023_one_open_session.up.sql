@@ -0,0 +1,2 @@
+-- 0023: 営業中のセッションは同時に一つまで（同時に開始されても二つ目は一意制約で失敗する）
+CREATE UNIQUE INDEX IF NOT EXISTS "idx_sessions_open" ON "sessions" ((true)) WHERE closed_at IS NULL;
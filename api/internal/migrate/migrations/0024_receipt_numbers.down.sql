@@ -0,0 +1,6 @@
+-- オーダーを消した領収書の記録は戻せないので消す
+DROP SEQUENCE IF EXISTS "issued_receipts_number_seq";
+DELETE FROM "issued_receipts" WHERE "order_id" IS NULL;
+ALTER TABLE "issued_receipts" DROP CONSTRAINT "fk_issued_receipts_order";
+ALTER TABLE "issued_receipts" ALTER COLUMN "order_id" SET NOT NULL;
+ALTER TABLE "issued_receipts" ADD CONSTRAINT "fk_issued_receipts_order" FOREIGN KEY ("order_id") REFERENCES "orders" ("id") ON DELETE CASCADE;
@@ -0,0 +1,7 @@
+-- 0024: 領収書番号を使い回さない
+-- オーダーを消しても発行した領収書は order_id を空にして残し、番号はシーケンスから取る
+ALTER TABLE "issued_receipts" DROP CONSTRAINT "fk_issued_receipts_order";
+ALTER TABLE "issued_receipts" ALTER COLUMN "order_id" DROP NOT NULL;
+ALTER TABLE "issued_receipts" ADD CONSTRAINT "fk_issued_receipts_order" FOREIGN KEY ("order_id") REFERENCES "orders" ("id") ON DELETE SET NULL;
+CREATE SEQUENCE IF NOT EXISTS "issued_receipts_number_seq" OWNED BY "issued_receipts"."number";
+SELECT setval('issued_receipts_number_seq', COALESCE((SELECT MAX("number") FROM "issued_receipts"), 0) + 1, false);
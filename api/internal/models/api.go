@@ -9,6 +9,291 @@ import (
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+// Defines values for CashMovementCreateRequestType.
+const (
+	CashMovementCreateRequestTypeFloat  CashMovementCreateRequestType = "float"
+	CashMovementCreateRequestTypePayIn  CashMovementCreateRequestType = "pay_in"
+	CashMovementCreateRequestTypePayOut CashMovementCreateRequestType = "pay_out"
+)
+
+// Defines values for CashMovementResponseType.
+const (
+	CashMovementResponseTypeFloat  CashMovementResponseType = "float"
+	CashMovementResponseTypePayIn  CashMovementResponseType = "pay_in"
+	CashMovementResponseTypePayOut CashMovementResponseType = "pay_out"
+)
+
+// Defines values for ErrorCode.
+const (
+	ErrorCodeBillingAmountMismatch  ErrorCode = "BILLING_AMOUNT_MISMATCH"
+	ErrorCodeCloseoutExists         ErrorCode = "CLOSEOUT_EXISTS"
+	ErrorCodeCloseoutNotFound       ErrorCode = "CLOSEOUT_NOT_FOUND"
+	ErrorCodeDiscountAlreadyUsed    ErrorCode = "DISCOUNT_ALREADY_USED"
+	ErrorCodeDiscountOrderNotFound  ErrorCode = "DISCOUNT_ORDER_NOT_FOUND"
+	ErrorCodeInsufficientPayment    ErrorCode = "INSUFFICIENT_PAYMENT"
+	ErrorCodeInternal               ErrorCode = "INTERNAL"
+	ErrorCodeItemNotFound           ErrorCode = "ITEM_NOT_FOUND"
+	ErrorCodeItemTypeNotFound       ErrorCode = "ITEM_TYPE_NOT_FOUND"
+	ErrorCodeItemUnavailable        ErrorCode = "ITEM_UNAVAILABLE"
+	ErrorCodeModifierGroupNotFound  ErrorCode = "MODIFIER_GROUP_NOT_FOUND"
+	ErrorCodeNoOpenSession          ErrorCode = "NO_OPEN_SESSION"
+	ErrorCodeNotFound               ErrorCode = "NOT_FOUND"
+	ErrorCodeOrderAlreadyCalled     ErrorCode = "ORDER_ALREADY_CALLED"
+	ErrorCodeOrderAlreadyServed     ErrorCode = "ORDER_ALREADY_SERVED"
+	ErrorCodeOrderNotCalled         ErrorCode = "ORDER_NOT_CALLED"
+	ErrorCodeOrderNotFound          ErrorCode = "ORDER_NOT_FOUND"
+	ErrorCodeOrderNotServed         ErrorCode = "ORDER_NOT_SERVED"
+	ErrorCodeOrderNumberInUse       ErrorCode = "ORDER_NUMBER_IN_USE"
+	ErrorCodePriceAlreadyEffective  ErrorCode = "PRICE_ALREADY_EFFECTIVE"
+	ErrorCodePriceNotFound          ErrorCode = "PRICE_NOT_FOUND"
+	ErrorCodePrintJobNotFailed      ErrorCode = "PRINT_JOB_NOT_FAILED"
+	ErrorCodePrintJobNotFound       ErrorCode = "PRINT_JOB_NOT_FOUND"
+	ErrorCodePromotionNotFound      ErrorCode = "PROMOTION_NOT_FOUND"
+	ErrorCodeRateLimited            ErrorCode = "RATE_LIMITED"
+	ErrorCodeSessionAlreadyClosed   ErrorCode = "SESSION_ALREADY_CLOSED"
+	ErrorCodeSessionAlreadyOpen     ErrorCode = "SESSION_ALREADY_OPEN"
+	ErrorCodeSessionNotFound        ErrorCode = "SESSION_NOT_FOUND"
+	ErrorCodeValidationFailed       ErrorCode = "VALIDATION_FAILED"
+	ErrorCodeVoucherAlreadyRedeemed ErrorCode = "VOUCHER_ALREADY_REDEEMED"
+	ErrorCodeVoucherBatchNotFound   ErrorCode = "VOUCHER_BATCH_NOT_FOUND"
+	ErrorCodeVoucherExpired         ErrorCode = "VOUCHER_EXPIRED"
+	ErrorCodeVoucherNotApplicable   ErrorCode = "VOUCHER_NOT_APPLICABLE"
+	ErrorCodeVoucherNotFound        ErrorCode = "VOUCHER_NOT_FOUND"
+)
+
+// Defines values for PaymentCreateSimulate.
+const (
+	PaymentCreateSimulateApprove PaymentCreateSimulate = "approve"
+	PaymentCreateSimulateDecline PaymentCreateSimulate = "decline"
+	PaymentCreateSimulateTimeout PaymentCreateSimulate = "timeout"
+)
+
+// Defines values for PaymentResponseStatus.
+const (
+	PaymentResponseStatusApproved PaymentResponseStatus = "approved"
+	PaymentResponseStatusDeclined PaymentResponseStatus = "declined"
+	PaymentResponseStatusTimeout  PaymentResponseStatus = "timeout"
+	PaymentResponseStatusVoided   PaymentResponseStatus = "voided"
+)
+
+// Defines values for PaymentResultStatus.
+const (
+	Approved PaymentResultStatus = "approved"
+	Declined PaymentResultStatus = "declined"
+	Timeout  PaymentResultStatus = "timeout"
+	Voided   PaymentResultStatus = "voided"
+)
+
+// Defines values for PrintJobStatus.
+const (
+	Done     PrintJobStatus = "done"
+	Failed   PrintJobStatus = "failed"
+	Printing PrintJobStatus = "printing"
+	Queued   PrintJobStatus = "queued"
+)
+
+// Defines values for PrintKind.
+const (
+	Labels  PrintKind = "labels"
+	Receipt PrintKind = "receipt"
+)
+
+// Defines values for PromotionType.
+const (
+	BuyNGetM           PromotionType = "buy_n_get_m"
+	FixedOff           PromotionType = "fixed_off"
+	HappyHour          PromotionType = "happy_hour"
+	PerCupReturn       PromotionType = "per_cup_return"
+	PercentOffItemType PromotionType = "percent_off_item_type"
+)
+
+// Defines values for RefundCreateRequestType.
+const (
+	RefundCreateRequestTypeRefund RefundCreateRequestType = "refund"
+	RefundCreateRequestTypeRemake RefundCreateRequestType = "remake"
+)
+
+// Defines values for RefundResponseType.
+const (
+	RefundResponseTypeRefund RefundResponseType = "refund"
+	RefundResponseTypeRemake RefundResponseType = "remake"
+)
+
+// Defines values for TrackingStatus.
+const (
+	Calling   TrackingStatus = "calling"
+	Preparing TrackingStatus = "preparing"
+	Ready     TrackingStatus = "ready"
+	Served    TrackingStatus = "served"
+)
+
+// Defines values for ExportDatasetParamsFormat.
+const (
+	ExportDatasetParamsFormatCsv    ExportDatasetParamsFormat = "csv"
+	ExportDatasetParamsFormatNdjson ExportDatasetParamsFormat = "ndjson"
+	ExportDatasetParamsFormatXlsx   ExportDatasetParamsFormat = "xlsx"
+)
+
+// Defines values for ExportDatasetParamsDataset.
+const (
+	Comments     ExportDatasetParamsDataset = "comments"
+	MasterStates ExportDatasetParamsDataset = "master-states"
+	OrderItems   ExportDatasetParamsDataset = "order-items"
+	Orders       ExportDatasetParamsDataset = "orders"
+)
+
+// Defines values for GetOrderReceiptParamsFormat.
+const (
+	Html GetOrderReceiptParamsFormat = "html"
+	Pdf  GetOrderReceiptParamsFormat = "pdf"
+)
+
+// Defines values for ExportVoucherBatchParamsFormat.
+const (
+	ExportVoucherBatchParamsFormatCsv    ExportVoucherBatchParamsFormat = "csv"
+	ExportVoucherBatchParamsFormatNdjson ExportVoucherBatchParamsFormat = "ndjson"
+	ExportVoucherBatchParamsFormatXlsx   ExportVoucherBatchParamsFormat = "xlsx"
+)
+
+// AppliedPromotion defines model for AppliedPromotion.
+type AppliedPromotion struct {
+	// Amount 割引額
+	Amount      int                `json:"amount"`
+	Name        string             `json:"name"`
+	PromotionId openapi_types.UUID `json:"promotion_id"`
+
+	// Type 割引ルールの種類
+	// - per_cup_return: カップ返却で一杯あたり amount 円引き（返却したオーダーの杯数まで）
+	// - fixed_off: 小計が min_subtotal 円以上なら amount 円引き
+	// - percent_off_item_type: item_type_id の種別のアイテムを percent % 引き
+	// - buy_n_get_m: 対象のアイテムを buy_quantity 個買うと free_quantity 個無料（安いものから）
+	// - happy_hour: 毎日 daily_start〜daily_end の間、対象のアイテムを percent % 引き、または一つあたり amount 円引き
+	Type PromotionType `json:"type"`
+}
+
+// BundleComponentRequest defines model for BundleComponentRequest.
+type BundleComponentRequest struct {
+	ItemId   openapi_types.UUID `json:"item_id"`
+	Quantity *int               `json:"quantity,omitempty"`
+}
+
+// BundleComponentResponse defines model for BundleComponentResponse.
+type BundleComponentResponse struct {
+	Abbr         string             `json:"abbr"`
+	ItemId       openapi_types.UUID `json:"item_id"`
+	ItemTypeName string             `json:"item_type_name"`
+	Name         string             `json:"name"`
+	Quantity     int                `json:"quantity"`
+}
+
+// CallscreenCall defines model for CallscreenCall.
+type CallscreenCall struct {
+	CalledAt     time.Time `json:"called_at"`
+	ExpiresAt    time.Time `json:"expires_at"`
+	LastCalledAt time.Time `json:"last_called_at"`
+	OrderNumber  int       `json:"order_number"`
+	RecallCount  int       `json:"recall_count"`
+}
+
+// CallscreenResponse defines model for CallscreenResponse.
+type CallscreenResponse struct {
+	// CallExpirySeconds 呼び出しが期限切れになるまでの秒数
+	CallExpirySeconds int `json:"call_expiry_seconds"`
+
+	// Calling 呼び出し中（最初に呼び出した順）
+	Calling []CallscreenCall `json:"calling"`
+
+	// Expired 呼び出したが受け取られずに期限切れになったオーダー番号
+	Expired     []int     `json:"expired"`
+	GeneratedAt time.Time `json:"generated_at"`
+
+	// NextUp まだ呼び出していないオーダー番号（注文順）
+	NextUp []int `json:"next_up"`
+
+	// RecentlyServed 最近提供したオーダー（新しい順）
+	RecentlyServed []CallscreenServed `json:"recently_served"`
+}
+
+// CallscreenServed defines model for CallscreenServed.
+type CallscreenServed struct {
+	OrderNumber int       `json:"order_number"`
+	ServedAt    time.Time `json:"served_at"`
+}
+
+// CashCloseoutCreateRequest defines model for CashCloseoutCreateRequest.
+type CashCloseoutCreateRequest struct {
+	CountedBy     string              `json:"counted_by"`
+	Denominations []DenominationCount `json:"denominations"`
+	Register      *string             `json:"register,omitempty"`
+
+	// SessionId 省略時は営業中のセッション
+	SessionId *openapi_types.UUID `json:"session_id,omitempty"`
+}
+
+// CashCloseoutResponse defines model for CashCloseoutResponse.
+type CashCloseoutResponse struct {
+	CashSales     int                 `json:"cash_sales"`
+	Counted       int                 `json:"counted"`
+	CountedBy     string              `json:"counted_by"`
+	CreatedAt     time.Time           `json:"created_at"`
+	Denominations []DenominationCount `json:"denominations"`
+	Expected      int                 `json:"expected"`
+	Id            openapi_types.UUID  `json:"id"`
+	OpeningFloat  int                 `json:"opening_float"`
+	PayIns        int                 `json:"pay_ins"`
+	PayOuts       int                 `json:"pay_outs"`
+	Refunds       int                 `json:"refunds"`
+	Register      string              `json:"register"`
+	SessionId     openapi_types.UUID  `json:"session_id"`
+
+	// Variance 実査額 - 理論値（マイナスは不足）
+	Variance int `json:"variance"`
+}
+
+// CashMovementCreateRequest defines model for CashMovementCreateRequest.
+type CashMovementCreateRequest struct {
+	Amount   int                           `json:"amount"`
+	Author   string                        `json:"author"`
+	Reason   *string                       `json:"reason,omitempty"`
+	Register *string                       `json:"register,omitempty"`
+	Type     CashMovementCreateRequestType `json:"type"`
+}
+
+// CashMovementCreateRequestType defines model for CashMovementCreateRequest.Type.
+type CashMovementCreateRequestType string
+
+// CashMovementResponse defines model for CashMovementResponse.
+type CashMovementResponse struct {
+	Amount    int                      `json:"amount"`
+	Author    string                   `json:"author"`
+	CreatedAt time.Time                `json:"created_at"`
+	Id        openapi_types.UUID       `json:"id"`
+	Reason    string                   `json:"reason"`
+	Register  string                   `json:"register"`
+	SessionId openapi_types.UUID       `json:"session_id"`
+	Type      CashMovementResponseType `json:"type"`
+}
+
+// CashMovementResponseType defines model for CashMovementResponse.Type.
+type CashMovementResponseType string
+
+// CashSummaryResponse defines model for CashSummaryResponse.
+type CashSummaryResponse struct {
+	// CashSales お預かり金額からお釣りを引いた現金売上
+	CashSales int `json:"cash_sales"`
+
+	// Expected レジにあるはずの現金
+	Expected     int `json:"expected"`
+	OpeningFloat int `json:"opening_float"`
+	PayIns       int `json:"pay_ins"`
+	PayOuts      int `json:"pay_outs"`
+
+	// Refunds 現金での返金
+	Refunds   int                `json:"refunds"`
+	Register  string             `json:"register"`
+	SessionId openapi_types.UUID `json:"session_id"`
+}
+
 // CommentCreateRequest defines model for CommentCreateRequest.
 type CommentCreateRequest struct {
 	Author string `json:"author"`
@@ -23,40 +308,234 @@ type CommentResponse struct {
 	Text      string             `json:"text"`
 }
 
-// ErrorResponse defines model for ErrorResponse.
+// DenominationCount defines model for DenominationCount.
+type DenominationCount struct {
+	Count        int `json:"count"`
+	Denomination int `json:"denomination"`
+}
+
+// DrawerStockCount defines model for DrawerStockCount.
+type DrawerStockCount struct {
+	Count        int `json:"count"`
+	Denomination int `json:"denomination"`
+
+	// LowThreshold 省略時は金種ごとの既定値
+	LowThreshold *int `json:"low_threshold,omitempty"`
+}
+
+// DrawerStockEntry defines model for DrawerStockEntry.
+type DrawerStockEntry struct {
+	Count        int `json:"count"`
+	Denomination int `json:"denomination"`
+
+	// LowThreshold この枚数を下回ると警告する
+	LowThreshold int `json:"low_threshold"`
+}
+
+// DrawerStockResponse defines model for DrawerStockResponse.
+type DrawerStockResponse struct {
+	Denominations []DrawerStockEntry `json:"denominations"`
+	Register      string             `json:"register"`
+	SessionId     openapi_types.UUID `json:"session_id"`
+	Total         int                `json:"total"`
+	Warnings      []DrawerWarning    `json:"warnings"`
+}
+
+// DrawerStockUpdateRequest defines model for DrawerStockUpdateRequest.
+type DrawerStockUpdateRequest struct {
+	Denominations []DrawerStockCount `json:"denominations"`
+	Register      *string            `json:"register,omitempty"`
+}
+
+// DrawerWarning defines model for DrawerWarning.
+type DrawerWarning struct {
+	Count        int    `json:"count"`
+	Denomination int    `json:"denomination"`
+	LowThreshold int    `json:"low_threshold"`
+	Message      string `json:"message"`
+}
+
+// DurationPercentiles 所要時間（秒）のパーセンタイル。対象がない場合は null
+type DurationPercentiles struct {
+	Count int      `json:"count"`
+	P50   *float32 `json:"p50"`
+	P90   *float32 `json:"p90"`
+	P99   *float32 `json:"p99"`
+}
+
+// ErrorCode エラーの種類
+// - VALIDATION_FAILED: リクエストの内容が正しくない（details に項目ごとの理由）
+// - NOT_FOUND: 対象が見つからない
+// - ORDER_NOT_FOUND: オーダーが見つからない
+// - ITEM_NOT_FOUND: アイテムが見つからない
+// - ITEM_TYPE_NOT_FOUND: アイテム種別が見つからない
+// - MODIFIER_GROUP_NOT_FOUND: 選択肢グループが見つからない
+// - PRICE_NOT_FOUND: 価格変更が見つからない
+// - PROMOTION_NOT_FOUND: 割引ルールが見つからない
+// - VOUCHER_NOT_FOUND: クーポンが見つからない
+// - VOUCHER_BATCH_NOT_FOUND: クーポンのバッチが見つからない
+// - SESSION_NOT_FOUND: セッションが見つからない
+// - CLOSEOUT_NOT_FOUND: レジ締めが見つからない
+// - PRINT_JOB_NOT_FOUND: 印刷ジョブが見つからない
+// - NO_OPEN_SESSION: 営業中のセッションがない
+// - SESSION_ALREADY_OPEN: 別のセッションが営業中
+// - SESSION_ALREADY_CLOSED: セッションは終了済み
+// - CLOSEOUT_EXISTS: このレジはセッション内でレジ締め済み
+// - ORDER_NUMBER_IN_USE: オーダー番号がセッション内で使われている
+// - ORDER_NOT_SERVED: オーダーがまだ提供されていない
+// - ORDER_ALREADY_SERVED: オーダーは提供済み
+// - ORDER_ALREADY_CALLED: オーダーは呼び出し済み
+// - ORDER_NOT_CALLED: オーダーがまだ呼び出されていない
+// - PRICE_ALREADY_EFFECTIVE: 価格変更はすでに有効になっている
+// - PRINT_JOB_NOT_FAILED: 印刷ジョブは失敗していない
+// - ITEM_UNAVAILABLE: 注文できないアイテムが含まれている
+// - DISCOUNT_ORDER_NOT_FOUND: 割引に使うオーダーが見つからない
+// - DISCOUNT_ALREADY_USED: 割引に使うオーダーは使用済み
+// - VOUCHER_ALREADY_REDEEMED: クーポンは使用済み
+// - VOUCHER_EXPIRED: クーポンの有効期限が切れている
+// - VOUCHER_NOT_APPLICABLE: クーポンがこのオーダーに適用されない
+// - BILLING_AMOUNT_MISMATCH: 請求額がサーバーで計算した金額と一致しない
+// - INSUFFICIENT_PAYMENT: お預かりが現金の支払い分に足りない
+// - RATE_LIMITED: リクエストが多すぎる
+// - INTERNAL: サーバー内部のエラー
+type ErrorCode string
+
+// ErrorResponse エラーの内容。クライアントは code で表示するメッセージを選ぶ
 type ErrorResponse struct {
+	// Code エラーの種類
+	// - VALIDATION_FAILED: リクエストの内容が正しくない（details に項目ごとの理由）
+	// - NOT_FOUND: 対象が見つからない
+	// - ORDER_NOT_FOUND: オーダーが見つからない
+	// - ITEM_NOT_FOUND: アイテムが見つからない
+	// - ITEM_TYPE_NOT_FOUND: アイテム種別が見つからない
+	// - MODIFIER_GROUP_NOT_FOUND: 選択肢グループが見つからない
+	// - PRICE_NOT_FOUND: 価格変更が見つからない
+	// - PROMOTION_NOT_FOUND: 割引ルールが見つからない
+	// - VOUCHER_NOT_FOUND: クーポンが見つからない
+	// - VOUCHER_BATCH_NOT_FOUND: クーポンのバッチが見つからない
+	// - SESSION_NOT_FOUND: セッションが見つからない
+	// - CLOSEOUT_NOT_FOUND: レジ締めが見つからない
+	// - PRINT_JOB_NOT_FOUND: 印刷ジョブが見つからない
+	// - NO_OPEN_SESSION: 営業中のセッションがない
+	// - SESSION_ALREADY_OPEN: 別のセッションが営業中
+	// - SESSION_ALREADY_CLOSED: セッションは終了済み
+	// - CLOSEOUT_EXISTS: このレジはセッション内でレジ締め済み
+	// - ORDER_NUMBER_IN_USE: オーダー番号がセッション内で使われている
+	// - ORDER_NOT_SERVED: オーダーがまだ提供されていない
+	// - ORDER_ALREADY_SERVED: オーダーは提供済み
+	// - ORDER_ALREADY_CALLED: オーダーは呼び出し済み
+	// - ORDER_NOT_CALLED: オーダーがまだ呼び出されていない
+	// - PRICE_ALREADY_EFFECTIVE: 価格変更はすでに有効になっている
+	// - PRINT_JOB_NOT_FAILED: 印刷ジョブは失敗していない
+	// - ITEM_UNAVAILABLE: 注文できないアイテムが含まれている
+	// - DISCOUNT_ORDER_NOT_FOUND: 割引に使うオーダーが見つからない
+	// - DISCOUNT_ALREADY_USED: 割引に使うオーダーは使用済み
+	// - VOUCHER_ALREADY_REDEEMED: クーポンは使用済み
+	// - VOUCHER_EXPIRED: クーポンの有効期限が切れている
+	// - VOUCHER_NOT_APPLICABLE: クーポンがこのオーダーに適用されない
+	// - BILLING_AMOUNT_MISMATCH: 請求額がサーバーで計算した金額と一致しない
+	// - INSUFFICIENT_PAYMENT: お預かりが現金の支払い分に足りない
+	// - RATE_LIMITED: リクエストが多すぎる
+	// - INTERNAL: サーバー内部のエラー
+	Code ErrorCode `json:"code"`
+
+	// Details 項目ごとの理由（VALIDATION_FAILED の場合）
+	Details *[]FieldError `json:"details,omitempty"`
+
+	// Error 開発者向けのメッセージ（英語）
 	Error string `json:"error"`
 }
 
+// FieldError defines model for FieldError.
+type FieldError struct {
+	// Field 項目の名前（クエリ・パスのパラメーター名、またはリクエストボディの JSON Pointer）
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
 // ItemCreateRequest defines model for ItemCreateRequest.
 type ItemCreateRequest struct {
-	Abbr       string             `json:"abbr"`
-	ItemTypeId openapi_types.UUID `json:"item_type_id"`
-	Key        string             `json:"key"`
-	Name       string             `json:"name"`
-	Price      int                `json:"price"`
+	Abbr      string `json:"abbr"`
+	Available *bool  `json:"available,omitempty"`
+
+	// Components セット商品の構成アイテム（省略時は変更しない、空配列でセットを解除）
+	Components *[]BundleComponentRequest `json:"components,omitempty"`
+	ItemTypeId openapi_types.UUID        `json:"item_type_id"`
+	Key        string                    `json:"key"`
+	Name       string                    `json:"name"`
+	Price      int                       `json:"price"`
 }
 
 // ItemInfo defines model for ItemInfo.
 type ItemInfo struct {
-	Assignee *string      `json:"assignee"`
-	Item     ItemResponse `json:"item"`
+	Assignee *string `json:"assignee"`
+
+	// Components 注文時点のセット商品の構成アイテム
+	Components *[]BundleComponentResponse `json:"components,omitempty"`
+	Item       ItemResponse               `json:"item"`
+	Modifiers  *[]SelectedModifier        `json:"modifiers,omitempty"`
+
+	// OrderItemId オーダー内のアイテムのID（返金・作り直しの対象の指定に使う）
+	OrderItemId openapi_types.UUID `json:"order_item_id"`
 }
 
 // ItemInfoCreate defines model for ItemInfoCreate.
 type ItemInfoCreate struct {
 	Assignee *string            `json:"assignee"`
 	ItemId   openapi_types.UUID `json:"item_id"`
+
+	// ModifierIds 選んだ選択肢のID
+	ModifierIds *[]openapi_types.UUID `json:"modifier_ids,omitempty"`
+}
+
+// ItemPriceCreateRequest defines model for ItemPriceCreateRequest.
+type ItemPriceCreateRequest struct {
+	// EffectiveFrom 適用開始日時（省略時は即時）
+	EffectiveFrom *time.Time `json:"effective_from,omitempty"`
+	Price         int        `json:"price"`
+	Reason        *string    `json:"reason,omitempty"`
+}
+
+// ItemPriceResponse defines model for ItemPriceResponse.
+type ItemPriceResponse struct {
+	CreatedAt     time.Time          `json:"created_at"`
+	EffectiveFrom time.Time          `json:"effective_from"`
+	Id            openapi_types.UUID `json:"id"`
+	ItemId        openapi_types.UUID `json:"item_id"`
+	Price         int                `json:"price"`
+	Reason        *string            `json:"reason,omitempty"`
+
+	// Scheduled まだ適用されていない予定の価格かどうか
+	Scheduled bool `json:"scheduled"`
 }
 
 // ItemResponse defines model for ItemResponse.
 type ItemResponse struct {
-	Abbr     string             `json:"abbr"`
-	Id       openapi_types.UUID `json:"id"`
-	ItemType ItemTypeResponse   `json:"item_type"`
-	Key      string             `json:"key"`
-	Name     string             `json:"name"`
-	Price    int                `json:"price"`
+	Abbr string `json:"abbr"`
+
+	// Available false の場合は売り切れなどで注文できない
+	Available bool `json:"available"`
+
+	// Components セット商品の構成アイテム（セット商品でなければ空）
+	Components *[]BundleComponentResponse `json:"components,omitempty"`
+	Id         openapi_types.UUID         `json:"id"`
+	ItemType   ItemTypeResponse           `json:"item_type"`
+	Key        string                     `json:"key"`
+	Name       string                     `json:"name"`
+	Price      int                        `json:"price"`
+}
+
+// ItemSalesReport defines model for ItemSalesReport.
+type ItemSalesReport struct {
+	Abbr         string             `json:"abbr"`
+	ItemId       openapi_types.UUID `json:"item_id"`
+	ItemTypeId   openapi_types.UUID `json:"item_type_id"`
+	ItemTypeName string             `json:"item_type_name"`
+	Name         string             `json:"name"`
+	Quantity     int                `json:"quantity"`
+
+	// Sales 割引前の売上
+	Sales int `json:"sales"`
 }
 
 // ItemTypeCreateRequest defines model for ItemTypeCreateRequest.
@@ -72,6 +551,16 @@ type ItemTypeResponse struct {
 	Name        string             `json:"name"`
 }
 
+// ItemTypeSalesReport defines model for ItemTypeSalesReport.
+type ItemTypeSalesReport struct {
+	ItemTypeId openapi_types.UUID `json:"item_type_id"`
+	Name       string             `json:"name"`
+	Quantity   int                `json:"quantity"`
+
+	// Sales 割引前の売上
+	Sales int `json:"sales"`
+}
+
 // ItemTypeUpdateRequest defines model for ItemTypeUpdateRequest.
 type ItemTypeUpdateRequest struct {
 	DisplayName string             `json:"display_name"`
@@ -81,18 +570,23 @@ type ItemTypeUpdateRequest struct {
 
 // ItemUpdateRequest defines model for ItemUpdateRequest.
 type ItemUpdateRequest struct {
-	Abbr       string             `json:"abbr"`
-	Id         openapi_types.UUID `json:"id"`
-	ItemTypeId openapi_types.UUID `json:"item_type_id"`
-	Key        string             `json:"key"`
-	Name       string             `json:"name"`
-	Price      int                `json:"price"`
+	Abbr      string `json:"abbr"`
+	Available *bool  `json:"available,omitempty"`
+
+	// Components セット商品の構成アイテム（省略時は変更しない、空配列でセットを解除）
+	Components *[]BundleComponentRequest `json:"components,omitempty"`
+	Id         openapi_types.UUID        `json:"id"`
+	ItemTypeId openapi_types.UUID        `json:"item_type_id"`
+	Key        string                    `json:"key"`
+	Name       string                    `json:"name"`
+	Price      int                       `json:"price"`
 }
 
 // MasterStateResponse defines model for MasterStateResponse.
 type MasterStateResponse struct {
-	CreatedAt time.Time `json:"created_at"`
-	Type      string    `json:"type"`
+	CreatedAt time.Time           `json:"created_at"`
+	SessionId *openapi_types.UUID `json:"session_id"`
+	Type      string              `json:"type"`
 }
 
 // MasterStateUpdateRequest defines model for MasterStateUpdateRequest.
@@ -100,30 +594,136 @@ type MasterStateUpdateRequest struct {
 	Type string `json:"type"`
 }
 
+// ModifierGroupRequest defines model for ModifierGroupRequest.
+type ModifierGroupRequest struct {
+	// DisplayName 省略時は name と同じ
+	DisplayName *string `json:"display_name,omitempty"`
+
+	// ItemId item_id と item_type_id のどちらか一方を指定する
+	ItemId     *openapi_types.UUID `json:"item_id"`
+	ItemTypeId *openapi_types.UUID `json:"item_type_id"`
+	MaxSelect  *int                `json:"max_select,omitempty"`
+	MinSelect  *int                `json:"min_select,omitempty"`
+	Modifiers  []ModifierRequest   `json:"modifiers"`
+	Name       string              `json:"name"`
+}
+
+// ModifierGroupResponse defines model for ModifierGroupResponse.
+type ModifierGroupResponse struct {
+	DisplayName string              `json:"display_name"`
+	Id          openapi_types.UUID  `json:"id"`
+	ItemId      *openapi_types.UUID `json:"item_id"`
+	ItemTypeId  *openapi_types.UUID `json:"item_type_id"`
+
+	// MaxSelect 選べる数の上限（0 の場合は制限なし）
+	MaxSelect int                `json:"max_select"`
+	MinSelect int                `json:"min_select"`
+	Modifiers []ModifierResponse `json:"modifiers"`
+	Name      string             `json:"name"`
+}
+
+// ModifierRequest defines model for ModifierRequest.
+type ModifierRequest struct {
+	Name       string `json:"name"`
+	PriceDelta *int   `json:"price_delta,omitempty"`
+}
+
+// ModifierResponse defines model for ModifierResponse.
+type ModifierResponse struct {
+	Id   openapi_types.UUID `json:"id"`
+	Name string             `json:"name"`
+
+	// PriceDelta 価格差（マイナスも可）
+	PriceDelta int `json:"price_delta"`
+}
+
 // OrderCreateRequest defines model for OrderCreateRequest.
 type OrderCreateRequest struct {
+	// BillingAmount 割引後の請求額。サーバーで割引ルールを適用した金額と一致させる
 	BillingAmount     int                     `json:"billing_amount"`
 	Comments          *[]CommentCreateRequest `json:"comments,omitempty"`
 	DiscountOrderCups *int                    `json:"discount_order_cups,omitempty"`
 	DiscountOrderId   *int                    `json:"discount_order_id"`
 	ItemIds           []ItemInfoCreate        `json:"item_ids"`
-	OrderId           int                     `json:"order_id"`
-	Received          int                     `json:"received"`
+
+	// OrderId 省略時は営業中のセッションで次の番号を採番する
+	OrderId *int `json:"order_id,omitempty"`
+
+	// Payments 支払いの内訳（省略時は請求額の全額を現金で支払う）。金額の合計は請求額と一致させる
+	Payments *[]PaymentCreate `json:"payments,omitempty"`
+	Received int              `json:"received"`
+
+	// ReceivedDenominations お預かりした金種の内訳（省略時は金額から推定する）
+	ReceivedDenominations *[]DenominationCount `json:"received_denominations,omitempty"`
+
+	// Register 会計したレジ名
+	Register *string `json:"register,omitempty"`
+
+	// VoucherCode 使用するクーポンコード（オーダーの作成と同時に使用済みにする）
+	VoucherCode *string `json:"voucher_code,omitempty"`
 }
 
 // OrderResponse defines model for OrderResponse.
 type OrderResponse struct {
-	BillingAmount     int                `json:"billing_amount"`
-	Comments          *[]CommentResponse `json:"comments,omitempty"`
-	CreatedAt         time.Time          `json:"created_at"`
-	DiscountOrderCups *int               `json:"discount_order_cups,omitempty"`
-	DiscountOrderId   *int               `json:"discount_order_id"`
-	Id                openapi_types.UUID `json:"id"`
-	Items             []ItemInfo         `json:"items"`
-	OrderId           int                `json:"order_id"`
-	ReadyAt           *time.Time         `json:"ready_at"`
-	Received          int                `json:"received"`
-	ServedAt          *time.Time         `json:"served_at"`
+	// AppliedPromotions 適用した割引ルール
+	AppliedPromotions *[]AppliedPromotion `json:"applied_promotions,omitempty"`
+	BillingAmount     int                 `json:"billing_amount"`
+
+	// CalledAt 呼び出し画面で最初に呼び出した時刻
+	CalledAt *time.Time `json:"called_at"`
+
+	// Change お釣り
+	Change int `json:"change"`
+
+	// ChangeBreakdown お釣りの金種内訳（オーダー作成時のみ）
+	ChangeBreakdown *[]DenominationCount `json:"change_breakdown,omitempty"`
+
+	// CoffeeCups 割引の対象になるコーヒーの杯数（セット商品の構成アイテムを含む）
+	CoffeeCups *int               `json:"coffee_cups,omitempty"`
+	Comments   *[]CommentResponse `json:"comments,omitempty"`
+	CreatedAt  time.Time          `json:"created_at"`
+
+	// Discount 割引額の合計
+	Discount          *int `json:"discount,omitempty"`
+	DiscountOrderCups *int `json:"discount_order_cups,omitempty"`
+	DiscountOrderId   *int `json:"discount_order_id"`
+
+	// DrawerWarnings レジの釣り銭不足の警告（オーダー作成時のみ）
+	DrawerWarnings *[]DrawerWarning `json:"drawer_warnings,omitempty"`
+
+	// DripperSplit ドリッパーを3人以上確保する注文かどうか
+	DripperSplit *bool              `json:"dripper_split,omitempty"`
+	Id           openapi_types.UUID `json:"id"`
+	Items        []ItemInfo         `json:"items"`
+
+	// LastCalledAt 最後に呼び出した時刻（再呼び出しで更新される）
+	LastCalledAt *time.Time `json:"last_called_at"`
+
+	// NetAmount 請求額から返金額を引いた金額
+	NetAmount int                `json:"net_amount"`
+	OrderId   int                `json:"order_id"`
+	Payments  *[]PaymentResponse `json:"payments,omitempty"`
+
+	// PrepTasks 作るもの一覧（セット商品は構成アイテムに展開する）
+	PrepTasks *[]PrepTask `json:"prep_tasks,omitempty"`
+
+	// QueuePosition 提供待ちの列での順番（1から。オーダー作成時のみ）
+	QueuePosition *int       `json:"queue_position,omitempty"`
+	ReadyAt       *time.Time `json:"ready_at"`
+
+	// RecallCount 再呼び出しの回数
+	RecallCount *int `json:"recall_count,omitempty"`
+	Received    int  `json:"received"`
+
+	// RefundedAmount 返金済みの金額
+	RefundedAmount int                 `json:"refunded_amount"`
+	Refunds        *[]RefundResponse   `json:"refunds,omitempty"`
+	Register       string              `json:"register"`
+	ServedAt       *time.Time          `json:"served_at"`
+	SessionId      *openapi_types.UUID `json:"session_id"`
+
+	// TrackingToken お客様が注文状況を確認するための追跡コード（ラベルに印刷する）
+	TrackingToken *string `json:"tracking_token,omitempty"`
 }
 
 // OrderUpdateRequest defines model for OrderUpdateRequest.
@@ -139,6 +739,375 @@ type OrderUpdateRequest struct {
 	ServedAt          *time.Time         `json:"served_at"`
 }
 
+// PaymentCreate defines model for PaymentCreate.
+type PaymentCreate struct {
+	Amount int `json:"amount"`
+
+	// Method 決済手段（GET /api/payment-methods で取得できるもの）
+	Method string `json:"method"`
+
+	// Simulate モック決済端末の応答を指定する（開発・練習用）
+	Simulate *PaymentCreateSimulate `json:"simulate,omitempty"`
+}
+
+// PaymentCreateSimulate モック決済端末の応答を指定する（開発・練習用）
+type PaymentCreateSimulate string
+
+// PaymentFailedResponse defines model for PaymentFailedResponse.
+type PaymentFailedResponse struct {
+	Error string `json:"error"`
+
+	// Payments 各支払いの結果（承認済みのものは取り消される）
+	Payments []PaymentResult `json:"payments"`
+}
+
+// PaymentResponse defines model for PaymentResponse.
+type PaymentResponse struct {
+	Amount    int       `json:"amount"`
+	CreatedAt time.Time `json:"created_at"`
+
+	// ExternalReference 決済端末の取引番号
+	ExternalReference *string               `json:"external_reference,omitempty"`
+	Id                openapi_types.UUID    `json:"id"`
+	Message           *string               `json:"message,omitempty"`
+	Method            string                `json:"method"`
+	OrderId           openapi_types.UUID    `json:"order_id"`
+	Status            PaymentResponseStatus `json:"status"`
+}
+
+// PaymentResponseStatus defines model for PaymentResponse.Status.
+type PaymentResponseStatus string
+
+// PaymentResult defines model for PaymentResult.
+type PaymentResult struct {
+	Amount  int                 `json:"amount"`
+	Message *string             `json:"message,omitempty"`
+	Method  string              `json:"method"`
+	Status  PaymentResultStatus `json:"status"`
+}
+
+// PaymentResultStatus defines model for PaymentResult.Status.
+type PaymentResultStatus string
+
+// PrepTask defines model for PrepTask.
+type PrepTask struct {
+	Abbr     string  `json:"abbr"`
+	Assignee *string `json:"assignee"`
+
+	// BundleItemId セット商品から展開した場合のセット商品のID
+	BundleItemId *openapi_types.UUID `json:"bundle_item_id,omitempty"`
+	ItemId       openapi_types.UUID  `json:"item_id"`
+	ItemTypeName string              `json:"item_type_name"`
+	Name         string              `json:"name"`
+}
+
+// PrintJobCreateRequest defines model for PrintJobCreateRequest.
+type PrintJobCreateRequest struct {
+	// Kind 印刷物の種類
+	// - labels: コーヒー一杯ごとのラベルと明細
+	// - receipt: レシート
+	Kind    *PrintKind         `json:"kind,omitempty"`
+	OrderId openapi_types.UUID `json:"order_id"`
+
+	// Printer 送り先のプリンター（host:port、省略時は種類ごとの設定）
+	Printer *string `json:"printer,omitempty"`
+}
+
+// PrintJobResponse defines model for PrintJobResponse.
+type PrintJobResponse struct {
+	// Attempts 送信を試みた回数
+	Attempts  int                `json:"attempts"`
+	CreatedAt time.Time          `json:"created_at"`
+	Id        openapi_types.UUID `json:"id"`
+
+	// Kind 印刷物の種類
+	// - labels: コーヒー一杯ごとのラベルと明細
+	// - receipt: レシート
+	Kind PrintKind `json:"kind"`
+
+	// LastError 最後に失敗したときのエラー
+	LastError *string `json:"last_error,omitempty"`
+
+	// NextAttemptAt 次に送信を試みる時刻（queued の場合）
+	NextAttemptAt *time.Time         `json:"next_attempt_at,omitempty"`
+	OrderId       openapi_types.UUID `json:"order_id"`
+
+	// OrderNumber オーダー番号
+	OrderNumber int        `json:"order_number"`
+	PrintedAt   *time.Time `json:"printed_at,omitempty"`
+
+	// Printer 送り先のプリンター（host:port）
+	Printer string `json:"printer"`
+
+	// Status 印刷ジョブの状態
+	// - queued: 送信待ち（再試行待ちを含む）
+	// - printing: 送信中
+	// - done: 送信済み
+	// - failed: 再試行しても送れなかった
+	Status    PrintJobStatus `json:"status"`
+	UpdatedAt time.Time      `json:"updated_at"`
+}
+
+// PrintJobStatus 印刷ジョブの状態
+// - queued: 送信待ち（再試行待ちを含む）
+// - printing: 送信中
+// - done: 送信済み
+// - failed: 再試行しても送れなかった
+type PrintJobStatus string
+
+// PrintKind 印刷物の種類
+// - labels: コーヒー一杯ごとのラベルと明細
+// - receipt: レシート
+type PrintKind string
+
+// PromotionEvaluateRequest defines model for PromotionEvaluateRequest.
+type PromotionEvaluateRequest struct {
+	DiscountOrderCups *int             `json:"discount_order_cups,omitempty"`
+	DiscountOrderId   *int             `json:"discount_order_id"`
+	ItemIds           []ItemInfoCreate `json:"item_ids"`
+	VoucherCode       *string          `json:"voucher_code,omitempty"`
+}
+
+// PromotionEvaluation defines model for PromotionEvaluation.
+type PromotionEvaluation struct {
+	AppliedPromotions []AppliedPromotion `json:"applied_promotions"`
+
+	// BillingAmount 割引後の請求額
+	BillingAmount int `json:"billing_amount"`
+	Discount      int `json:"discount"`
+
+	// Subtotal 割引前の金額
+	Subtotal int `json:"subtotal"`
+}
+
+// PromotionRequest defines model for PromotionRequest.
+type PromotionRequest struct {
+	Active      *bool `json:"active,omitempty"`
+	Amount      *int  `json:"amount,omitempty"`
+	BuyQuantity *int  `json:"buy_quantity,omitempty"`
+
+	// DailyEnd HH:MM（日本時間）
+	DailyEnd *string `json:"daily_end"`
+
+	// DailyStart HH:MM（日本時間）
+	DailyStart   *string             `json:"daily_start"`
+	EndsAt       *time.Time          `json:"ends_at"`
+	FreeQuantity *int                `json:"free_quantity,omitempty"`
+	ItemId       *openapi_types.UUID `json:"item_id"`
+	ItemTypeId   *openapi_types.UUID `json:"item_type_id"`
+	MinSubtotal  *int                `json:"min_subtotal,omitempty"`
+	Name         string              `json:"name"`
+	Percent      *int                `json:"percent,omitempty"`
+	Priority     *int                `json:"priority,omitempty"`
+	StartsAt     *time.Time          `json:"starts_at"`
+
+	// Type 割引ルールの種類
+	// - per_cup_return: カップ返却で一杯あたり amount 円引き（返却したオーダーの杯数まで）
+	// - fixed_off: 小計が min_subtotal 円以上なら amount 円引き
+	// - percent_off_item_type: item_type_id の種別のアイテムを percent % 引き
+	// - buy_n_get_m: 対象のアイテムを buy_quantity 個買うと free_quantity 個無料（安いものから）
+	// - happy_hour: 毎日 daily_start〜daily_end の間、対象のアイテムを percent % 引き、または一つあたり amount 円引き
+	Type PromotionType `json:"type"`
+}
+
+// PromotionResponse defines model for PromotionResponse.
+type PromotionResponse struct {
+	Active       bool                `json:"active"`
+	Amount       int                 `json:"amount"`
+	BuyQuantity  int                 `json:"buy_quantity"`
+	DailyEnd     *string             `json:"daily_end"`
+	DailyStart   *string             `json:"daily_start"`
+	EndsAt       *time.Time          `json:"ends_at"`
+	FreeQuantity int                 `json:"free_quantity"`
+	Id           openapi_types.UUID  `json:"id"`
+	ItemId       *openapi_types.UUID `json:"item_id"`
+	ItemTypeId   *openapi_types.UUID `json:"item_type_id"`
+	MinSubtotal  int                 `json:"min_subtotal"`
+	Name         string              `json:"name"`
+	Percent      int                 `json:"percent"`
+
+	// Priority 小さいものから順に適用する
+	Priority int        `json:"priority"`
+	StartsAt *time.Time `json:"starts_at"`
+
+	// Type 割引ルールの種類
+	// - per_cup_return: カップ返却で一杯あたり amount 円引き（返却したオーダーの杯数まで）
+	// - fixed_off: 小計が min_subtotal 円以上なら amount 円引き
+	// - percent_off_item_type: item_type_id の種別のアイテムを percent % 引き
+	// - buy_n_get_m: 対象のアイテムを buy_quantity 個買うと free_quantity 個無料（安いものから）
+	// - happy_hour: 毎日 daily_start〜daily_end の間、対象のアイテムを percent % 引き、または一つあたり amount 円引き
+	Type PromotionType `json:"type"`
+}
+
+// PromotionType 割引ルールの種類
+// - per_cup_return: カップ返却で一杯あたり amount 円引き（返却したオーダーの杯数まで）
+// - fixed_off: 小計が min_subtotal 円以上なら amount 円引き
+// - percent_off_item_type: item_type_id の種別のアイテムを percent % 引き
+// - buy_n_get_m: 対象のアイテムを buy_quantity 個買うと free_quantity 個無料（安いものから）
+// - happy_hour: 毎日 daily_start〜daily_end の間、対象のアイテムを percent % 引き、または一つあたり amount 円引き
+type PromotionType string
+
+// QueueEntry defines model for QueueEntry.
+type QueueEntry struct {
+	// AgeSeconds 注文からの経過秒数
+	AgeSeconds int       `json:"age_seconds"`
+	CreatedAt  time.Time `json:"created_at"`
+
+	// Cups 作るものの数（セット商品は構成アイテムに展開）
+	Cups        int                `json:"cups"`
+	Id          openapi_types.UUID `json:"id"`
+	OrderNumber int                `json:"order_number"`
+
+	// Position 列での順番（1から）
+	Position int `json:"position"`
+
+	// Ready 提供可能になっているか
+	Ready bool `json:"ready"`
+}
+
+// QueueItemTypeCups defines model for QueueItemTypeCups.
+type QueueItemTypeCups struct {
+	Cups        int    `json:"cups"`
+	DisplayName string `json:"display_name"`
+
+	// ItemType アイテム種別の名前
+	ItemType string `json:"item_type"`
+}
+
+// QueueResponse defines model for QueueResponse.
+type QueueResponse struct {
+	GeneratedAt time.Time `json:"generated_at"`
+
+	// OldestCreatedAt 一番長く待っているオーダーの注文時刻
+	OldestCreatedAt *time.Time `json:"oldest_created_at,omitempty"`
+
+	// OldestWaitSeconds 一番長く待っているオーダーの経過秒数（列が空なら 0）
+	OldestWaitSeconds int `json:"oldest_wait_seconds"`
+
+	// Orders 未提供のオーダー（注文順）
+	Orders []QueueEntry `json:"orders"`
+
+	// WaitingCups 提供可能になっていないオーダーで作るものの数（アイテム種別ごと）
+	WaitingCups []QueueItemTypeCups `json:"waiting_cups"`
+}
+
+// RefundCreateRequest defines model for RefundCreateRequest.
+type RefundCreateRequest struct {
+	// Amount 返金額（省略時は対象アイテムの価格の合計）
+	Amount *int                 `json:"amount,omitempty"`
+	Author string               `json:"author"`
+	Items  *[]RefundItemRequest `json:"items,omitempty"`
+
+	// Method 返金する決済手段（オーダーの支払いに使われたもの）
+	// 省略時はオーダーの支払いの決済手段。複数の決済手段で支払ったオーダーの返金では必須。
+	// 現金の返金だけがレジの在庫と現金集計から引かれる
+	Method *string `json:"method,omitempty"`
+	Reason string  `json:"reason"`
+
+	// Register 返金したレジ名（省略時はオーダーのレジ）
+	Register *string `json:"register,omitempty"`
+
+	// Type refund は返金、remake は作り直し（返金なし）
+	Type RefundCreateRequestType `json:"type"`
+}
+
+// RefundCreateRequestType refund は返金、remake は作り直し（返金なし）
+type RefundCreateRequestType string
+
+// RefundItemRequest defines model for RefundItemRequest.
+type RefundItemRequest struct {
+	// OrderItemId オーダー内のアイテムのID（ItemInfo の order_item_id）
+	OrderItemId openapi_types.UUID `json:"order_item_id"`
+}
+
+// RefundItemResponse defines model for RefundItemResponse.
+type RefundItemResponse struct {
+	ItemId      openapi_types.UUID `json:"item_id"`
+	OrderItemId openapi_types.UUID `json:"order_item_id"`
+}
+
+// RefundResponse defines model for RefundResponse.
+type RefundResponse struct {
+	Amount    int                  `json:"amount"`
+	Author    string               `json:"author"`
+	CreatedAt time.Time            `json:"created_at"`
+	Id        openapi_types.UUID   `json:"id"`
+	Items     []RefundItemResponse `json:"items"`
+
+	// Method 返金した決済手段（作り直しは返金がないので cash）
+	Method    string             `json:"method"`
+	OrderId   openapi_types.UUID `json:"order_id"`
+	Reason    string             `json:"reason"`
+	Register  string             `json:"register"`
+	SessionId openapi_types.UUID `json:"session_id"`
+	Type      RefundResponseType `json:"type"`
+}
+
+// RefundResponseType defines model for RefundResponse.Type.
+type RefundResponseType string
+
+// SalesSummaryReport defines model for SalesSummaryReport.
+type SalesSummaryReport struct {
+	// AverageTicket 1オーダーあたりの平均請求額
+	AverageTicket float32 `json:"average_ticket"`
+
+	// BillingTotal 請求額の合計
+	BillingTotal int `json:"billing_total"`
+
+	// CupCount コーヒーの杯数（セット商品は構成アイテムを数え、milk と others は含まない）
+	CupCount int `json:"cup_count"`
+
+	// DiscountTotal 割引額の合計（割引前の売上 - 請求額の合計）
+	DiscountTotal        int `json:"discount_total"`
+	DiscountedOrderCount int `json:"discounted_order_count"`
+
+	// GrossSales 割引前の売上（アイテム価格の合計）
+	GrossSales int `json:"gross_sales"`
+
+	// NetSales 請求額の合計 - 返金額の合計
+	NetSales   int `json:"net_sales"`
+	OrderCount int `json:"order_count"`
+
+	// RefundTotal 対象オーダーの返金額の合計
+	RefundTotal int `json:"refund_total"`
+}
+
+// SalesTimelineBucket defines model for SalesTimelineBucket.
+type SalesTimelineBucket struct {
+	BillingTotal int       `json:"billing_total"`
+	BucketStart  time.Time `json:"bucket_start"`
+	CupCount     int       `json:"cup_count"`
+	OrderCount   int       `json:"order_count"`
+}
+
+// SelectedModifier defines model for SelectedModifier.
+type SelectedModifier struct {
+	GroupName  string             `json:"group_name"`
+	ModifierId openapi_types.UUID `json:"modifier_id"`
+	Name       string             `json:"name"`
+	PriceDelta int                `json:"price_delta"`
+}
+
+// SessionCreateRequest defines model for SessionCreateRequest.
+type SessionCreateRequest struct {
+	Name         string `json:"name"`
+	OpeningFloat *int   `json:"opening_float,omitempty"`
+}
+
+// SessionResponse defines model for SessionResponse.
+type SessionResponse struct {
+	ClosedAt *time.Time         `json:"closed_at"`
+	Id       openapi_types.UUID `json:"id"`
+	Name     string             `json:"name"`
+
+	// NextOrderId セッション内で次に採番されるオーダー番号
+	NextOrderId int       `json:"next_order_id"`
+	OpenedAt    time.Time `json:"opened_at"`
+
+	// OpeningFloat 開始時の釣り銭準備金
+	OpeningFloat int `json:"opening_float"`
+}
+
 // StatusResponse defines model for StatusResponse.
 type StatusResponse struct {
 	Database  string    `json:"database"`
@@ -147,6 +1116,332 @@ type StatusResponse struct {
 	Version   string    `json:"version"`
 }
 
+// ThroughputBucket defines model for ThroughputBucket.
+type ThroughputBucket struct {
+	BucketStart time.Time       `json:"bucket_start"`
+	Stats       ThroughputStats `json:"stats"`
+}
+
+// ThroughputReport defines model for ThroughputReport.
+type ThroughputReport struct {
+	// Buckets オーダー作成日時で区切った統計
+	Buckets []ThroughputBucket `json:"buckets"`
+	Overall ThroughputStats    `json:"overall"`
+}
+
+// ThroughputStats defines model for ThroughputStats.
+type ThroughputStats struct {
+	// CreatedToReady 所要時間（秒）のパーセンタイル。対象がない場合は null
+	CreatedToReady DurationPercentiles `json:"created_to_ready"`
+
+	// CreatedToServed 所要時間（秒）のパーセンタイル。対象がない場合は null
+	CreatedToServed DurationPercentiles `json:"created_to_served"`
+
+	// ReadyToServed 所要時間（秒）のパーセンタイル。対象がない場合は null
+	ReadyToServed DurationPercentiles `json:"ready_to_served"`
+}
+
+// TrackingResponse defines model for TrackingResponse.
+type TrackingResponse struct {
+	// EstimatedReadyAt 提供可能になる目安の時刻（準備中の場合のみ）
+	EstimatedReadyAt *time.Time `json:"estimated_ready_at,omitempty"`
+	OrderNumber      int        `json:"order_number"`
+
+	// QueuePosition 準備中のオーダーの中での順番（自分を含めて1から。準備中の場合のみ）
+	QueuePosition *int `json:"queue_position,omitempty"`
+
+	// Status お客様向けの注文の状態
+	// - preparing: 準備中
+	// - calling: 呼び出し中
+	// - ready: 提供可能（呼び出しの期限切れを含む）
+	// - served: 提供済み
+	Status    TrackingStatus `json:"status"`
+	UpdatedAt time.Time      `json:"updated_at"`
+}
+
+// TrackingStatus お客様向けの注文の状態
+// - preparing: 準備中
+// - calling: 呼び出し中
+// - ready: 提供可能（呼び出しの期限切れを含む）
+// - served: 提供済み
+type TrackingStatus string
+
+// VoucherBatchCreateRequest defines model for VoucherBatchCreateRequest.
+type VoucherBatchCreateRequest struct {
+	Amount      *int `json:"amount,omitempty"`
+	BuyQuantity *int `json:"buy_quantity,omitempty"`
+
+	// Count 発行する枚数
+	Count        int                 `json:"count"`
+	ExpiresAt    *time.Time          `json:"expires_at"`
+	FreeQuantity *int                `json:"free_quantity,omitempty"`
+	ItemId       *openapi_types.UUID `json:"item_id"`
+	ItemTypeId   *openapi_types.UUID `json:"item_type_id"`
+	MinSubtotal  *int                `json:"min_subtotal,omitempty"`
+	Name         string              `json:"name"`
+	Percent      *int                `json:"percent,omitempty"`
+
+	// Type 割引ルールの種類
+	// - per_cup_return: カップ返却で一杯あたり amount 円引き（返却したオーダーの杯数まで）
+	// - fixed_off: 小計が min_subtotal 円以上なら amount 円引き
+	// - percent_off_item_type: item_type_id の種別のアイテムを percent % 引き
+	// - buy_n_get_m: 対象のアイテムを buy_quantity 個買うと free_quantity 個無料（安いものから）
+	// - happy_hour: 毎日 daily_start〜daily_end の間、対象のアイテムを percent % 引き、または一つあたり amount 円引き
+	Type PromotionType `json:"type"`
+}
+
+// VoucherBatchResponse defines model for VoucherBatchResponse.
+type VoucherBatchResponse struct {
+	Amount      int       `json:"amount"`
+	BuyQuantity int       `json:"buy_quantity"`
+	CreatedAt   time.Time `json:"created_at"`
+
+	// DiscountTotal 使用したオーダーの割引額の合計
+	DiscountTotal int                `json:"discount_total"`
+	ExpiresAt     *time.Time         `json:"expires_at"`
+	FreeQuantity  int                `json:"free_quantity"`
+	Id            openapi_types.UUID `json:"id"`
+
+	// Issued 発行した枚数
+	Issued      int                 `json:"issued"`
+	ItemId      *openapi_types.UUID `json:"item_id"`
+	ItemTypeId  *openapi_types.UUID `json:"item_type_id"`
+	MinSubtotal int                 `json:"min_subtotal"`
+	Name        string              `json:"name"`
+	Percent     int                 `json:"percent"`
+
+	// Redeemed 使用済みの枚数
+	Redeemed int `json:"redeemed"`
+
+	// Type 割引ルールの種類
+	// - per_cup_return: カップ返却で一杯あたり amount 円引き（返却したオーダーの杯数まで）
+	// - fixed_off: 小計が min_subtotal 円以上なら amount 円引き
+	// - percent_off_item_type: item_type_id の種別のアイテムを percent % 引き
+	// - buy_n_get_m: 対象のアイテムを buy_quantity 個買うと free_quantity 個無料（安いものから）
+	// - happy_hour: 毎日 daily_start〜daily_end の間、対象のアイテムを percent % 引き、または一つあたり amount 円引き
+	Type PromotionType `json:"type"`
+}
+
+// VoucherResponse defines model for VoucherResponse.
+type VoucherResponse struct {
+	BatchId    openapi_types.UUID `json:"batch_id"`
+	BatchName  string             `json:"batch_name"`
+	Code       string             `json:"code"`
+	ExpiresAt  *time.Time         `json:"expires_at"`
+	RedeemedAt *time.Time         `json:"redeemed_at"`
+
+	// RedeemedOrderId 使用したオーダーの番号
+	RedeemedOrderId   *int                `json:"redeemed_order_id"`
+	RedeemedOrderUuid *openapi_types.UUID `json:"redeemed_order_uuid"`
+
+	// Usable 未使用で期限内なら true
+	Usable bool `json:"usable"`
+}
+
+// GetCallscreenParams defines parameters for GetCallscreen.
+type GetCallscreenParams struct {
+	// SessionId セッションID（省略時は営業中または直近のセッション）
+	SessionId *openapi_types.UUID `form:"session_id,omitempty" json:"session_id,omitempty"`
+}
+
+// GetCashCloseoutsParams defines parameters for GetCashCloseouts.
+type GetCashCloseoutsParams struct {
+	// SessionId セッションID（省略時は営業中のセッション）
+	SessionId *openapi_types.UUID `form:"session_id,omitempty" json:"session_id,omitempty"`
+}
+
+// GetDrawerStockParams defines parameters for GetDrawerStock.
+type GetDrawerStockParams struct {
+	// SessionId セッションID（省略時は営業中のセッション）
+	SessionId *openapi_types.UUID `form:"session_id,omitempty" json:"session_id,omitempty"`
+
+	// Register レジ名（省略時は main）
+	Register *string `form:"register,omitempty" json:"register,omitempty"`
+}
+
+// GetCashMovementsParams defines parameters for GetCashMovements.
+type GetCashMovementsParams struct {
+	// SessionId セッションID（省略時は営業中のセッション）
+	SessionId *openapi_types.UUID `form:"session_id,omitempty" json:"session_id,omitempty"`
+
+	// Register レジ名（省略時は全レジ）
+	Register *string `form:"register,omitempty" json:"register,omitempty"`
+}
+
+// GetCashSummaryParams defines parameters for GetCashSummary.
+type GetCashSummaryParams struct {
+	// SessionId セッションID（省略時は営業中のセッション）
+	SessionId *openapi_types.UUID `form:"session_id,omitempty" json:"session_id,omitempty"`
+
+	// Register レジ名（省略時は全レジ）
+	Register *string `form:"register,omitempty" json:"register,omitempty"`
+}
+
+// ExportDatasetParams defines parameters for ExportDataset.
+type ExportDatasetParams struct {
+	// Format 出力形式
+	Format *ExportDatasetParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// SessionId セッションID
+	SessionId *openapi_types.UUID `form:"session_id,omitempty" json:"session_id,omitempty"`
+
+	// From 出力開始日時（作成日時がこれ以降）
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To 出力終了日時（作成日時がこれより前）
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// ExportDatasetParamsFormat defines parameters for ExportDataset.
+type ExportDatasetParamsFormat string
+
+// ExportDatasetParamsDataset defines parameters for ExportDataset.
+type ExportDatasetParamsDataset string
+
+// GetMasterStatusParams defines parameters for GetMasterStatus.
+type GetMasterStatusParams struct {
+	// SessionId セッションID（省略時は営業中のセッション）
+	SessionId *openapi_types.UUID `form:"session_id,omitempty" json:"session_id,omitempty"`
+}
+
+// GetOrdersParams defines parameters for GetOrders.
+type GetOrdersParams struct {
+	// SessionId セッションID（省略時は営業中のセッション）
+	SessionId *openapi_types.UUID `form:"session_id,omitempty" json:"session_id,omitempty"`
+}
+
+// GetOrderLabelsParams defines parameters for GetOrderLabels.
+type GetOrderLabelsParams struct {
+	Kind *PrintKind `form:"kind,omitempty" json:"kind,omitempty"`
+}
+
+// GetOrderReceiptParams defines parameters for GetOrderReceipt.
+type GetOrderReceiptParams struct {
+	Format *GetOrderReceiptParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// Addressee 宛名（省略時は発行済みの宛名、なければ既定の宛名）
+	Addressee *string `form:"addressee,omitempty" json:"addressee,omitempty"`
+}
+
+// GetOrderReceiptParamsFormat defines parameters for GetOrderReceipt.
+type GetOrderReceiptParamsFormat string
+
+// GetPrintJobsParams defines parameters for GetPrintJobs.
+type GetPrintJobsParams struct {
+	Status *PrintJobStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// OrderId オーダーID
+	OrderId *openapi_types.UUID `form:"order_id,omitempty" json:"order_id,omitempty"`
+	Limit   *int                `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetQueueParams defines parameters for GetQueue.
+type GetQueueParams struct {
+	// SessionId セッションID（省略時は営業中または直近のセッション）
+	SessionId *openapi_types.UUID `form:"session_id,omitempty" json:"session_id,omitempty"`
+}
+
+// GetRefundsParams defines parameters for GetRefunds.
+type GetRefundsParams struct {
+	// SessionId セッションID（省略時は営業中のセッション）
+	SessionId *openapi_types.UUID `form:"session_id,omitempty" json:"session_id,omitempty"`
+}
+
+// GetItemTypeSalesReportParams defines parameters for GetItemTypeSalesReport.
+type GetItemTypeSalesReportParams struct {
+	// SessionId セッションID（from/to も省略した場合は営業中のセッション）
+	SessionId *openapi_types.UUID `form:"session_id,omitempty" json:"session_id,omitempty"`
+
+	// From 集計開始日時（オーダー作成日時がこれ以降）
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To 集計終了日時（オーダー作成日時がこれより前）
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// GetItemSalesReportParams defines parameters for GetItemSalesReport.
+type GetItemSalesReportParams struct {
+	// SessionId セッションID（from/to も省略した場合は営業中のセッション）
+	SessionId *openapi_types.UUID `form:"session_id,omitempty" json:"session_id,omitempty"`
+
+	// From 集計開始日時（オーダー作成日時がこれ以降）
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To 集計終了日時（オーダー作成日時がこれより前）
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// GetSalesSummaryReportParams defines parameters for GetSalesSummaryReport.
+type GetSalesSummaryReportParams struct {
+	// SessionId セッションID（from/to も省略した場合は営業中のセッション）
+	SessionId *openapi_types.UUID `form:"session_id,omitempty" json:"session_id,omitempty"`
+
+	// From 集計開始日時（オーダー作成日時がこれ以降）
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To 集計終了日時（オーダー作成日時がこれより前）
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// GetThroughputReportParams defines parameters for GetThroughputReport.
+type GetThroughputReportParams struct {
+	// SessionId セッションID（from/to も省略した場合は営業中のセッション）
+	SessionId *openapi_types.UUID `form:"session_id,omitempty" json:"session_id,omitempty"`
+
+	// From 集計開始日時（オーダー作成日時がこれ以降）
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To 集計終了日時（オーダー作成日時がこれより前）
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// Interval 集計の間隔（Go の time.Duration 形式、1分以上。日本時間の 0 時を起点に区切る）
+	Interval *string `form:"interval,omitempty" json:"interval,omitempty"`
+}
+
+// GetSalesTimelineReportParams defines parameters for GetSalesTimelineReport.
+type GetSalesTimelineReportParams struct {
+	// SessionId セッションID（from/to も省略した場合は営業中のセッション）
+	SessionId *openapi_types.UUID `form:"session_id,omitempty" json:"session_id,omitempty"`
+
+	// From 集計開始日時（オーダー作成日時がこれ以降）
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To 集計終了日時（オーダー作成日時がこれより前）
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// Interval 集計の間隔（Go の time.Duration 形式、1分以上。日本時間の 0 時を起点に区切る）
+	Interval *string `form:"interval,omitempty" json:"interval,omitempty"`
+}
+
+// TrackOrderParams defines parameters for TrackOrder.
+type TrackOrderParams struct {
+	// Token 追跡コード（大文字・小文字、ハイフンは区別しない）
+	Token string `form:"token" json:"token"`
+}
+
+// TrackOrderEventsParams defines parameters for TrackOrderEvents.
+type TrackOrderEventsParams struct {
+	// Token 追跡コード（大文字・小文字、ハイフンは区別しない）
+	Token string `form:"token" json:"token"`
+}
+
+// ExportVoucherBatchParams defines parameters for ExportVoucherBatch.
+type ExportVoucherBatchParams struct {
+	Format *ExportVoucherBatchParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// ExportVoucherBatchParamsFormat defines parameters for ExportVoucherBatch.
+type ExportVoucherBatchParamsFormat string
+
+// CreateCashCloseoutJSONRequestBody defines body for CreateCashCloseout for application/json ContentType.
+type CreateCashCloseoutJSONRequestBody = CashCloseoutCreateRequest
+
+// UpdateDrawerStockJSONRequestBody defines body for UpdateDrawerStock for application/json ContentType.
+type UpdateDrawerStockJSONRequestBody = DrawerStockUpdateRequest
+
+// CreateCashMovementJSONRequestBody defines body for CreateCashMovement for application/json ContentType.
+type CreateCashMovementJSONRequestBody = CashMovementCreateRequest
+
 // CreateItemTypeJSONRequestBody defines body for CreateItemType for application/json ContentType.
 type CreateItemTypeJSONRequestBody = ItemTypeCreateRequest
 
@@ -159,8 +1454,17 @@ type CreateItemJSONRequestBody = ItemCreateRequest
 // UpdateItemJSONRequestBody defines body for UpdateItem for application/json ContentType.
 type UpdateItemJSONRequestBody = ItemUpdateRequest
 
-// UpdateMasterStateJSONRequestBody defines body for UpdateMasterState for application/json ContentType.
-type UpdateMasterStateJSONRequestBody = MasterStateUpdateRequest
+// CreateItemPriceJSONRequestBody defines body for CreateItemPrice for application/json ContentType.
+type CreateItemPriceJSONRequestBody = ItemPriceCreateRequest
+
+// UpdateMasterStatusJSONRequestBody defines body for UpdateMasterStatus for application/json ContentType.
+type UpdateMasterStatusJSONRequestBody = MasterStateUpdateRequest
+
+// CreateModifierGroupJSONRequestBody defines body for CreateModifierGroup for application/json ContentType.
+type CreateModifierGroupJSONRequestBody = ModifierGroupRequest
+
+// UpdateModifierGroupJSONRequestBody defines body for UpdateModifierGroup for application/json ContentType.
+type UpdateModifierGroupJSONRequestBody = ModifierGroupRequest
 
 // CreateOrderJSONRequestBody defines body for CreateOrder for application/json ContentType.
 type CreateOrderJSONRequestBody = OrderCreateRequest
@@ -170,3 +1474,24 @@ type UpdateOrderJSONRequestBody = OrderUpdateRequest
 
 // CreateOrderCommentJSONRequestBody defines body for CreateOrderComment for application/json ContentType.
 type CreateOrderCommentJSONRequestBody = CommentCreateRequest
+
+// CreateOrderRefundJSONRequestBody defines body for CreateOrderRefund for application/json ContentType.
+type CreateOrderRefundJSONRequestBody = RefundCreateRequest
+
+// CreatePrintJobJSONRequestBody defines body for CreatePrintJob for application/json ContentType.
+type CreatePrintJobJSONRequestBody = PrintJobCreateRequest
+
+// CreatePromotionJSONRequestBody defines body for CreatePromotion for application/json ContentType.
+type CreatePromotionJSONRequestBody = PromotionRequest
+
+// EvaluatePromotionsJSONRequestBody defines body for EvaluatePromotions for application/json ContentType.
+type EvaluatePromotionsJSONRequestBody = PromotionEvaluateRequest
+
+// UpdatePromotionJSONRequestBody defines body for UpdatePromotion for application/json ContentType.
+type UpdatePromotionJSONRequestBody = PromotionRequest
+
+// OpenSessionJSONRequestBody defines body for OpenSession for application/json ContentType.
+type OpenSessionJSONRequestBody = SessionCreateRequest
+
+// CreateVoucherBatchJSONRequestBody defines body for CreateVoucherBatch for application/json ContentType.
+type CreateVoucherBatchJSONRequestBody = VoucherBatchCreateRequest
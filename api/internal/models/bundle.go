@@ -0,0 +1,171 @@
+// api/internal/models/bundle.go
+package models
+
+import (
+	"database/sql/driver"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// セット商品の構成アイテム
+// 構成アイテムを持つアイテムがセット商品になる（セット商品の中にセット商品は入れられない）
+type BundleComponent struct {
+	ID              uuid.UUID `gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	BundleItemID    uuid.UUID `gorm:"type:uuid;not null;index"`
+	ComponentItemID uuid.UUID `gorm:"type:uuid;not null"`
+	Quantity        int       `gorm:"not null;default:1"`
+
+	ComponentItem Item `gorm:"foreignKey:ComponentItemID;references:ID"`
+}
+
+func (b *BundleComponent) BeforeCreate(tx *gorm.DB) error {
+	if b.ID == uuid.Nil {
+		b.ID = uuid.New()
+	}
+	return nil
+}
+
+// 注文時点のセット商品の構成アイテム
+type OrderItemComponent struct {
+	ItemID       uuid.UUID `json:"item_id"`
+	Name         string    `json:"name"`
+	Abbr         string    `json:"abbr"`
+	ItemTypeName string    `json:"item_type_name"`
+	Quantity     int       `json:"quantity"`
+}
+
+// OrderItem に jsonb で保存する構成アイテムの一覧
+type OrderItemComponents []OrderItemComponent
+
+func (s OrderItemComponents) Value() (driver.Value, error) {
+	return jsonbValue(s)
+}
+
+func (s *OrderItemComponents) Scan(value interface{}) error {
+	return jsonbScan((*[]OrderItemComponent)(s), value, "OrderItemComponents")
+}
+
+func (OrderItemComponents) GormDataType() string {
+	return "jsonb"
+}
+
+// セット商品の構成アイテムを注文時点の内容として記録する
+// item.Components と構成アイテムの ItemType がロードされている必要がある
+func bundleSnapshot(item *Item) OrderItemComponents {
+	components := OrderItemComponents{}
+	for _, c := range item.Components {
+		components = append(components, OrderItemComponent{
+			ItemID:       c.ComponentItemID,
+			Name:         c.ComponentItem.Name,
+			Abbr:         c.ComponentItem.Abbr,
+			ItemTypeName: c.ComponentItem.ItemType.Name,
+			Quantity:     c.Quantity,
+		})
+	}
+	return components
+}
+
+// 作るもの一つ分（セット商品は構成アイテムに展開する）
+type PrepItem struct {
+	ItemID       uuid.UUID
+	Name         string
+	Abbr         string
+	ItemTypeName string
+	Assignee     *string
+	// セット商品から展開した場合のセット商品のID
+	BundleItemID *uuid.UUID
+}
+
+func (p *PrepItem) IsCoffee() bool {
+	return isCoffeeTypeName(p.ItemTypeName)
+}
+
+// OrderItem を作るもの一覧に展開する
+// セット商品でない場合は OrderItem.Item.ItemType がロードされている必要がある
+func (oi *OrderItem) PrepItems() []PrepItem {
+	if len(oi.Components) == 0 {
+		return []PrepItem{{
+			ItemID:       oi.ItemID,
+			Name:         oi.Name,
+			Abbr:         oi.Abbr,
+			ItemTypeName: oi.Item.ItemType.Name,
+			Assignee:     oi.Assignee,
+		}}
+	}
+	bundleItemID := oi.ItemID
+	var prepItems []PrepItem
+	for _, c := range oi.Components {
+		for i := 0; i < c.Quantity; i++ {
+			prepItems = append(prepItems, PrepItem{
+				ItemID:       c.ItemID,
+				Name:         c.Name,
+				Abbr:         c.Abbr,
+				ItemTypeName: c.ItemTypeName,
+				Assignee:     oi.Assignee,
+				BundleItemID: &bundleItemID,
+			})
+		}
+	}
+	return prepItems
+}
+
+// オーダーの作るもの一覧
+func (o *Order) PrepItems() []PrepItem {
+	var prepItems []PrepItem
+	for i := range o.OrderItems {
+		prepItems = append(prepItems, o.OrderItems[i].PrepItems()...)
+	}
+	return prepItems
+}
+
+// 割引の対象になるコーヒーの杯数（セット商品は構成アイテムのうちコーヒーの数量）
+// 杯数の数え方はここだけで決める（レポートの SQL も NonCoffeeTypeNames から作る）
+func (oi *OrderItem) CoffeeCups() int {
+	cups := 0
+	for _, p := range oi.PrepItems() {
+		if p.IsCoffee() {
+			cups++
+		}
+	}
+	return cups
+}
+
+// 割引の対象になるコーヒーの杯数（セット商品の構成アイテムを含む）
+func (o *Order) CoffeeCups() int {
+	cups := 0
+	for i := range o.OrderItems {
+		cups += o.OrderItems[i].CoffeeCups()
+	}
+	return cups
+}
+
+// ドリッパーを3人以上確保する注文かどうか
+// modules/common の shouldSplitOrder と同じ条件
+//   - コーヒーの種類が1種類なら4杯まで
+//   - コーヒーの種類が2種類なら、1種類につき2杯まで
+//   - コーヒーの種類が3種類以上なら常に分割する
+func (o *Order) NeedsDripperSplit() bool {
+	counts := map[uuid.UUID]int{}
+	for _, p := range o.PrepItems() {
+		if p.IsCoffee() {
+			counts[p.ItemID]++
+		}
+	}
+	switch len(counts) {
+	case 0:
+		return false
+	case 1:
+		for _, n := range counts {
+			return n >= 5
+		}
+	case 2:
+		for _, n := range counts {
+			if n >= 3 {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
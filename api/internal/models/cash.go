@@ -0,0 +1,82 @@
+// api/internal/models/cash.go
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// レジ名を指定しない場合に使うレジ
+const DefaultRegister = "main"
+
+// 入出金の種類
+const (
+	CashMovementFloat  = "float"   // 釣り銭準備金
+	CashMovementPayIn  = "pay_in"  // 入金
+	CashMovementPayOut = "pay_out" // 出金
+)
+
+// レジの手動の入出金
+type CashMovement struct {
+	ID        uuid.UUID `gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	SessionID uuid.UUID `gorm:"type:uuid;not null;index"`
+	Register  string    `gorm:"not null;default:'main'"`
+	Type      string    `gorm:"not null"`
+	Amount    int       `gorm:"not null"`
+	Reason    string    `gorm:"not null;default:''"`
+	Author    string    `gorm:"not null"`
+	CreatedAt time.Time `gorm:"not null"`
+}
+
+func (m *CashMovement) BeforeCreate(tx *gorm.DB) error {
+	if m.ID == uuid.Nil {
+		m.ID = uuid.New()
+	}
+	return nil
+}
+
+// レジごと・セッションごとの締め処理レポート
+// 理論値は締め処理時点の値をそのまま保存する
+type CashCloseout struct {
+	ID           uuid.UUID `gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	SessionID    uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_cash_closeouts_session_register"`
+	Register     string    `gorm:"not null;uniqueIndex:idx_cash_closeouts_session_register"`
+	OpeningFloat int       `gorm:"not null"`
+	CashSales    int       `gorm:"not null"`
+	PayIns       int       `gorm:"not null"`
+	PayOuts      int       `gorm:"not null"`
+	Refunds      int       `gorm:"not null;default:0"`
+	Expected     int       `gorm:"not null"`
+	Counted      int       `gorm:"not null"`
+	Variance     int       `gorm:"not null"`
+	CountedBy    string    `gorm:"not null"`
+	CreatedAt    time.Time `gorm:"not null"`
+
+	Denominations []CashCloseoutDenomination `gorm:"foreignKey:CloseoutID;references:ID"`
+}
+
+func (co *CashCloseout) BeforeCreate(tx *gorm.DB) error {
+	if co.ID == uuid.Nil {
+		co.ID = uuid.New()
+	}
+	return nil
+}
+
+// 締め処理時の金種別の枚数
+type CashCloseoutDenomination struct {
+	CloseoutID   uuid.UUID `gorm:"type:uuid;not null;primary_key"`
+	Denomination int       `gorm:"not null;primary_key"`
+	Count        int       `gorm:"not null"`
+}
+
+// レジ内の金種別の在庫
+// オーダーごとにお預かりした金種を加算し、お釣りの金種を減算する
+type DrawerStock struct {
+	SessionID    uuid.UUID `gorm:"type:uuid;not null;primary_key"`
+	Register     string    `gorm:"not null;primary_key"`
+	Denomination int       `gorm:"not null;primary_key"`
+	Count        int       `gorm:"not null"`
+	LowThreshold int       `gorm:"not null;default:0"`
+}
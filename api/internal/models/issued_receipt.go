@@ -0,0 +1,28 @@
+// api/internal/models/issued_receipt.go
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// 発行した領収書
+// 領収書番号は全体の通し番号で、同じオーダーには同じ番号の領収書を出す
+// オーダーを消しても番号を使い回さないように、記録は OrderID を nil にして残す
+type IssuedReceipt struct {
+	ID      uuid.UUID  `gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	OrderID *uuid.UUID `gorm:"type:uuid;uniqueIndex"`
+	Number  int        `gorm:"not null;uniqueIndex"`
+	// 宛名（空の場合は設定の既定の宛名を使う）
+	Addressee string    `gorm:"not null;default:''"`
+	IssuedAt  time.Time `gorm:"not null"`
+}
+
+func (r *IssuedReceipt) BeforeCreate(tx *gorm.DB) error {
+	if r.ID == uuid.Nil {
+		r.ID = uuid.New()
+	}
+	return nil
+}
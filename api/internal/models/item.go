@@ -7,16 +7,26 @@ import (
 )
 
 type Item struct {
-	ID         uuid.UUID      `gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
-	Name       string         `gorm:"not null"`
-	Abbr       string         `gorm:"not null"`
-	Price      int            `gorm:"not null"`
-	Key        string         `gorm:"not null"`
-	Deleted    gorm.DeletedAt `gorm:"index"`
-	Assignee   string         `json:"assignee"`
+	ID       uuid.UUID      `gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	Name     string         `gorm:"not null"`
+	Abbr     string         `gorm:"not null"`
+	Price    int            `gorm:"not null"`
+	Key      string         `gorm:"not null"`
+	Deleted  gorm.DeletedAt `gorm:"index"`
+	Assignee string         `json:"assignee"`
+	// false の場合は売り切れなどで注文できない
+	Available bool `gorm:"not null;default:true"`
 
-	ItemTypeID uuid.UUID      `gorm:"type:uuid;not null"`
-	ItemType   ItemType       `gorm:"foreignKey:ItemTypeID" json:"item_type,omitempty"`
+	ItemTypeID uuid.UUID `gorm:"type:uuid;not null"`
+	ItemType   ItemType  `gorm:"foreignKey:ItemTypeID" json:"item_type,omitempty"`
+
+	// セット商品の構成アイテム
+	Components []BundleComponent `gorm:"foreignKey:BundleItemID;references:ID" json:"components,omitempty"`
+}
+
+// セット商品かどうか（Components がロードされている必要がある）
+func (item *Item) IsBundle() bool {
+	return len(item.Components) > 0
 }
 
 func (item *Item) BeforeCreate(tx *gorm.DB) error {
@@ -0,0 +1,28 @@
+// api/internal/models/item_price.go
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// アイテムの価格の履歴
+// effective_from 以降は次の価格が有効になるまでこの価格で販売する
+// 未来の effective_from を入れておけば予定した時刻に自動で切り替わる
+type ItemPrice struct {
+	ID            uuid.UUID `gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	ItemID        uuid.UUID `gorm:"type:uuid;not null;index:idx_item_prices_item_effective"`
+	Price         int       `gorm:"not null"`
+	EffectiveFrom time.Time `gorm:"not null;index:idx_item_prices_item_effective"`
+	Reason        string
+	CreatedAt     time.Time `gorm:"not null"`
+}
+
+func (p *ItemPrice) BeforeCreate(tx *gorm.DB) error {
+	if p.ID == uuid.Nil {
+		p.ID = uuid.New()
+	}
+	return nil
+}
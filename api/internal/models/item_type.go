@@ -19,3 +19,21 @@ func (item_type *ItemType) BeforeCreate(tx *gorm.DB) error {
 	}
 	return nil
 }
+
+// 割引（一杯あたりの値引き）の対象になるコーヒーかどうか
+// milk と others 以外が対象（modules/common の getCoffeeCups と同じ）
+func (item_type *ItemType) IsCoffee() bool {
+	return isCoffeeTypeName(item_type.Name)
+}
+
+// コーヒーでない ItemType の名前
+var NonCoffeeTypeNames = []string{"milk", "others"}
+
+func isCoffeeTypeName(name string) bool {
+	for _, n := range NonCoffeeTypeNames {
+		if name == n {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,37 @@
+// api/internal/models/jsonb.go
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// jsonb の列に保存する一覧の値（nil は空の配列にする）
+func jsonbValue[T any](s []T) (driver.Value, error) {
+	if s == nil {
+		return "[]", nil
+	}
+	b, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+// jsonb の列から一覧を読む（name はエラーに出す型の名前）
+func jsonbScan[T any](s *[]T, value interface{}, name string) error {
+	var b []byte
+	switch v := value.(type) {
+	case nil:
+		*s = nil
+		return nil
+	case []byte:
+		b = v
+	case string:
+		b = []byte(v)
+	default:
+		return fmt.Errorf("invalid type for %s", name)
+	}
+	return json.Unmarshal(b, s)
+}
@@ -3,10 +3,12 @@ package models
 
 import (
 	"time"
+
+	"github.com/google/uuid"
 )
 
 type MasterState struct {
-	CreatedAt time.Time `gorm:"not null;default:CURRENT_TIMESTAMP;primary_key"`	
-	Type      string    `gorm:"not null"`
+	CreatedAt time.Time  `gorm:"not null;default:CURRENT_TIMESTAMP;primary_key"`
+	Type      string     `gorm:"not null"`
+	SessionID *uuid.UUID `gorm:"type:uuid;index"`
 }
-
@@ -0,0 +1,85 @@
+// api/internal/models/modifier.go
+package models
+
+import (
+	"database/sql/driver"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// 温度・サイズ・ミルクなどの選択肢のグループ
+// アイテムかアイテム種別のどちらかに紐づける
+type ModifierGroup struct {
+	ID          uuid.UUID `gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	Name        string    `gorm:"not null"`
+	DisplayName string    `gorm:"not null"`
+	// 選ぶ数の下限・上限（上限が 0 の場合は制限なし）
+	MinSelect  int            `gorm:"not null;default:0"`
+	MaxSelect  int            `gorm:"not null;default:1"`
+	ItemID     *uuid.UUID     `gorm:"type:uuid;index"`
+	ItemTypeID *uuid.UUID     `gorm:"type:uuid;index"`
+	Deleted    gorm.DeletedAt `gorm:"index"`
+
+	Modifiers []Modifier `gorm:"foreignKey:GroupID;references:ID"`
+}
+
+func (g *ModifierGroup) BeforeCreate(tx *gorm.DB) error {
+	if g.ID == uuid.Nil {
+		g.ID = uuid.New()
+	}
+	return nil
+}
+
+// アイテムで選べるグループか（アイテムかアイテム種別に紐づいている）
+func (g *ModifierGroup) AppliesTo(item *Item) bool {
+	return (g.ItemID != nil && *g.ItemID == item.ID) || (g.ItemTypeID != nil && *g.ItemTypeID == item.ItemTypeID)
+}
+
+// 選択肢（ホット・アイス、ショット追加など）
+type Modifier struct {
+	ID         uuid.UUID      `gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	GroupID    uuid.UUID      `gorm:"type:uuid;not null;index"`
+	Name       string         `gorm:"not null"`
+	PriceDelta int            `gorm:"not null;default:0"`
+	Deleted    gorm.DeletedAt `gorm:"index"`
+}
+
+func (m *Modifier) BeforeCreate(tx *gorm.DB) error {
+	if m.ID == uuid.Nil {
+		m.ID = uuid.New()
+	}
+	return nil
+}
+
+// 注文時に選ばれた選択肢（注文時点の名前と価格差を記録する）
+type OrderItemModifier struct {
+	ModifierID uuid.UUID `json:"modifier_id"`
+	GroupName  string    `json:"group_name"`
+	Name       string    `json:"name"`
+	PriceDelta int       `json:"price_delta"`
+}
+
+// OrderItem に jsonb で保存する選択肢の一覧
+type OrderItemModifiers []OrderItemModifier
+
+func (s OrderItemModifiers) Value() (driver.Value, error) {
+	return jsonbValue(s)
+}
+
+func (s *OrderItemModifiers) Scan(value interface{}) error {
+	return jsonbScan((*[]OrderItemModifier)(s), value, "OrderItemModifiers")
+}
+
+func (OrderItemModifiers) GormDataType() string {
+	return "jsonb"
+}
+
+// 選択肢による価格差の合計
+func (s OrderItemModifiers) PriceDelta() int {
+	total := 0
+	for _, m := range s {
+		total += m.PriceDelta
+	}
+	return total
+}
@@ -9,18 +9,44 @@ import (
 )
 
 type Order struct {
-	ID                uuid.UUID      `gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
-	OrderId           int            `gorm:"not null"`
-	CreatedAt         time.Time      `gorm:"not null"`
-	ReadyAt           *time.Time     
-	ServedAt          *time.Time     
-	BillingAmount     int            `gorm:"not null"`
-	Received          int            `gorm:"not null"`
+	ID uuid.UUID `gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	// 提供待ちの列は未提供のオーダーだけの部分インデックスで読む
+	SessionID *uuid.UUID `gorm:"type:uuid;uniqueIndex:idx_orders_session_order_id;index:idx_orders_unserved,priority:1,where:served_at IS NULL"`
+	Register  string     `gorm:"not null;default:'main'"`
+	OrderId   int        `gorm:"not null;uniqueIndex:idx_orders_session_order_id"`
+	CreatedAt time.Time  `gorm:"not null;index:idx_orders_unserved,priority:2,where:served_at IS NULL"`
+	ReadyAt   *time.Time
+	ServedAt  *time.Time
+	// 呼び出し画面での呼び出し（LastCalledAt は再呼び出しで更新する）
+	CalledAt     *time.Time
+	LastCalledAt *time.Time
+	RecallCount  int `gorm:"not null;default:0"`
+	// お客様が注文の状況を確認するためのコード（ラベルに印刷する）
+	TrackingToken string `gorm:"not null;default:'';index"`
+	// Firestore から取り込んだオーダーのドキュメント ID（同じオーダーを二重に取り込まないため）
+	FirestoreID       *string `gorm:"uniqueIndex"`
+	BillingAmount     int     `gorm:"not null"`
+	Received          int     `gorm:"not null"`
+	Change            int     `gorm:"not null;default:0"`
 	DiscountOrderId   int
 	DiscountOrderCups int
+	// 割引額の合計と適用した割引ルール
+	Discount          int             `gorm:"not null;default:0"`
+	AppliedPromotions OrderPromotions `gorm:"type:jsonb;not null;default:'[]'"`
 
-	OrderItems    []OrderItem    `gorm:"foreignKey:OrderID;references:ID"`
-	Comments []Comment `gorm:"foreignKey:OrderID;references:ID"`
+	OrderItems []OrderItem `gorm:"foreignKey:OrderID;references:ID"`
+	Comments   []Comment   `gorm:"foreignKey:OrderID;references:ID"`
+	Refunds    []Refund    `gorm:"foreignKey:OrderID;references:ID"`
+	Payments   []Payment   `gorm:"foreignKey:OrderID;references:ID"`
+}
+
+// 返金済みの金額
+func (o *Order) RefundedAmount() int {
+	total := 0
+	for _, r := range o.Refunds {
+		total += r.Amount
+	}
+	return total
 }
 
 func (o *Order) BeforeCreate(tx *gorm.DB) error {
@@ -2,14 +2,40 @@ package models
 
 import (
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 type OrderItem struct {
-  OrderID  uuid.UUID `gorm:"type:uuid;not null;index"`
-	ItemID   uuid.UUID `gorm:"type:uuid;not null;index"`
+	ID      uuid.UUID `gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	OrderID uuid.UUID `gorm:"type:uuid;not null;index"`
+	ItemID  uuid.UUID `gorm:"type:uuid;not null;index"`
 
-	Order    Order     `gorm:"foreignKey:OrderID;references:ID;"`
-	Item     Item      `gorm:"foreignKey:ItemID;references:ID;"`
+	Item Item `gorm:"foreignKey:ItemID;references:ID;"`
 
 	Assignee *string
+
+	// 注文時点のアイテムの名前・略称・単価（選択肢の価格差を含む）
+	// 後からアイテムを変更しても過去のオーダーの内容は変わらない
+	Name      string             `gorm:"not null;default:''"`
+	Abbr      string             `gorm:"not null;default:''"`
+	UnitPrice int                `gorm:"not null;default:0"`
+	Modifiers OrderItemModifiers `gorm:"type:jsonb;not null;default:'[]'"`
+	// セット商品の場合の構成アイテム
+	Components OrderItemComponents `gorm:"type:jsonb;not null;default:'[]'"`
+}
+
+func (oi *OrderItem) BeforeCreate(tx *gorm.DB) error {
+	if oi.ID == uuid.Nil {
+		oi.ID = uuid.New()
+	}
+	return nil
+}
+
+// 注文時点のアイテムの内容を記録する
+func (oi *OrderItem) Snapshot(item *Item, modifiers OrderItemModifiers) {
+	oi.Name = item.Name
+	oi.Abbr = item.Abbr
+	oi.Modifiers = modifiers
+	oi.Components = bundleSnapshot(item)
+	oi.UnitPrice = item.Price + modifiers.PriceDelta()
 }
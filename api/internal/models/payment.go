@@ -0,0 +1,29 @@
+// api/internal/models/payment.go
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// オーダーの支払い
+// 分割払いの場合は一つのオーダーに複数の支払いが紐づく
+type Payment struct {
+	ID          uuid.UUID `gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	OrderID     uuid.UUID `gorm:"type:uuid;not null;index"`
+	Method      string    `gorm:"not null"`
+	Amount      int       `gorm:"not null"`
+	Status      string    `gorm:"not null"`
+	ExternalRef string
+	Message     string
+	CreatedAt   time.Time `gorm:"not null"`
+}
+
+func (p *Payment) BeforeCreate(tx *gorm.DB) error {
+	if p.ID == uuid.Nil {
+		p.ID = uuid.New()
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+// api/internal/models/print_job.go
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ネットワークプリンターへの印刷ジョブ
+// 印刷データは送るたびにオーダーから作り直すので保存しない
+type PrintJob struct {
+	ID      uuid.UUID `gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	OrderID uuid.UUID `gorm:"type:uuid;not null;index"`
+	// labels / receipt
+	Kind string `gorm:"not null"`
+	// 送り先（host:port）
+	Printer string `gorm:"not null"`
+	// queued / printing / done / failed
+	Status        string    `gorm:"not null;index:idx_print_jobs_status_next_attempt"`
+	Attempts      int       `gorm:"not null;default:0"`
+	LastError     string    `gorm:"not null;default:''"`
+	NextAttemptAt time.Time `gorm:"not null;index:idx_print_jobs_status_next_attempt"`
+	PrintedAt     *time.Time
+	CreatedAt     time.Time `gorm:"not null"`
+	UpdatedAt     time.Time `gorm:"not null"`
+
+	Order *Order `gorm:"foreignKey:OrderID;references:ID"`
+}
+
+func (j *PrintJob) BeforeCreate(tx *gorm.DB) error {
+	if j.ID == uuid.Nil {
+		j.ID = uuid.New()
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+// api/internal/models/promotion.go
+package models
+
+import (
+	"database/sql/driver"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// 割引ルール
+// 種類ごとに使う項目は PromotionType の説明を参照
+type Promotion struct {
+	ID     uuid.UUID `gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	Name   string    `gorm:"not null"`
+	Type   string    `gorm:"not null"`
+	Active bool      `gorm:"not null;default:true"`
+	// 小さいものから順に適用する
+	Priority int `gorm:"not null;default:0"`
+
+	Amount       int        `gorm:"not null;default:0"`
+	Percent      int        `gorm:"not null;default:0"`
+	ItemTypeID   *uuid.UUID `gorm:"type:uuid"`
+	ItemID       *uuid.UUID `gorm:"type:uuid"`
+	BuyQuantity  int        `gorm:"not null;default:0"`
+	FreeQuantity int        `gorm:"not null;default:0"`
+	MinSubtotal  int        `gorm:"not null;default:0"`
+
+	// 有効期間（nil の場合は制限なし）
+	StartsAt *time.Time
+	EndsAt   *time.Time
+	// happy_hour の毎日の時間帯（日本時間の HH:MM）
+	DailyStart *string
+	DailyEnd   *string
+
+	CreatedAt time.Time      `gorm:"not null"`
+	Deleted   gorm.DeletedAt `gorm:"index"`
+}
+
+func (p *Promotion) BeforeCreate(tx *gorm.DB) error {
+	if p.ID == uuid.Nil {
+		p.ID = uuid.New()
+	}
+	return nil
+}
+
+// オーダーに適用した割引ルール（適用時点の名前と割引額を記録する）
+type OrderPromotion struct {
+	PromotionID uuid.UUID `json:"promotion_id"`
+	Name        string    `json:"name"`
+	Type        string    `json:"type"`
+	Amount      int       `json:"amount"`
+}
+
+// Order に jsonb で保存する適用した割引ルールの一覧
+type OrderPromotions []OrderPromotion
+
+func (s OrderPromotions) Value() (driver.Value, error) {
+	return jsonbValue(s)
+}
+
+func (s *OrderPromotions) Scan(value interface{}) error {
+	return jsonbScan((*[]OrderPromotion)(s), value, "OrderPromotions")
+}
+
+func (OrderPromotions) GormDataType() string {
+	return "jsonb"
+}
@@ -0,0 +1,48 @@
+// api/internal/models/refund.go
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// 返金の種類
+const (
+	RefundTypeRefund = "refund" // 返金
+	RefundTypeRemake = "remake" // 作り直し（返金なし）
+)
+
+// 提供済みオーダーの返金・作り直しの記録
+// 現金の移動は返金した日のセッション・レジに計上する
+type Refund struct {
+	ID        uuid.UUID `gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	OrderID   uuid.UUID `gorm:"type:uuid;not null;index"`
+	SessionID uuid.UUID `gorm:"type:uuid;not null;index"`
+	Register  string    `gorm:"not null;default:'main'"`
+	Type      string    `gorm:"not null"`
+	Reason    string    `gorm:"not null"`
+	Amount    int       `gorm:"not null"`
+	// 返金した決済手段（現金の返金だけレジの在庫から出す）
+	Method    string    `gorm:"not null;default:'cash'"`
+	Author    string    `gorm:"not null"`
+	CreatedAt time.Time `gorm:"not null"`
+
+	Items []RefundItem `gorm:"foreignKey:RefundID;references:ID"`
+}
+
+func (r *Refund) BeforeCreate(tx *gorm.DB) error {
+	if r.ID == uuid.Nil {
+		r.ID = uuid.New()
+	}
+	return nil
+}
+
+// 返金・作り直しの対象になったオーダー内のアイテム（オーダー内の 1 行ごと）
+// オーダー内の同じ行は返金・作り直しを合わせて一度しか対象にできない
+type RefundItem struct {
+	RefundID    uuid.UUID `gorm:"type:uuid;not null;primary_key"`
+	OrderItemID uuid.UUID `gorm:"type:uuid;not null;primary_key;index"`
+	ItemID      uuid.UUID `gorm:"type:uuid;not null"`
+}
@@ -0,0 +1,30 @@
+// api/internal/models/session.go
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// 営業日・イベント単位のセッション
+// オーダー番号やマスターステートはセッションごとに管理する
+type Session struct {
+	ID           uuid.UUID  `gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	Name         string     `gorm:"not null"`
+	OpenedAt     time.Time  `gorm:"not null"`
+	ClosedAt     *time.Time `gorm:"index"`
+	OpeningFloat int        `gorm:"not null;default:0"`
+}
+
+func (s *Session) BeforeCreate(tx *gorm.DB) error {
+	if s.ID == uuid.Nil {
+		s.ID = uuid.New()
+	}
+	return nil
+}
+
+func (s *Session) IsOpen() bool {
+	return s.ClosedAt == nil
+}
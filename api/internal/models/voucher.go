@@ -0,0 +1,65 @@
+// api/internal/models/voucher.go
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// まとめて発行したクーポン
+// 割引の内容は割引ルール（Promotion）と同じ項目で指定する
+type VoucherBatch struct {
+	ID           uuid.UUID  `gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	Name         string     `gorm:"not null"`
+	Type         string     `gorm:"not null"`
+	Amount       int        `gorm:"not null;default:0"`
+	Percent      int        `gorm:"not null;default:0"`
+	ItemTypeID   *uuid.UUID `gorm:"type:uuid"`
+	ItemID       *uuid.UUID `gorm:"type:uuid"`
+	BuyQuantity  int        `gorm:"not null;default:0"`
+	FreeQuantity int        `gorm:"not null;default:0"`
+	MinSubtotal  int        `gorm:"not null;default:0"`
+	ExpiresAt    *time.Time
+	CreatedAt    time.Time `gorm:"not null"`
+
+	Vouchers []Voucher `gorm:"foreignKey:BatchID;references:ID"`
+}
+
+func (b *VoucherBatch) BeforeCreate(tx *gorm.DB) error {
+	if b.ID == uuid.Nil {
+		b.ID = uuid.New()
+	}
+	return nil
+}
+
+// 期限内かどうか
+func (b *VoucherBatch) Expired(at time.Time) bool {
+	return b.ExpiresAt != nil && !at.Before(*b.ExpiresAt)
+}
+
+// クーポン一枚（一度だけ使える）
+type Voucher struct {
+	ID      uuid.UUID `gorm:"type:uuid;primary_key;default:uuid_generate_v4()"`
+	BatchID uuid.UUID `gorm:"type:uuid;not null;index"`
+	// ハイフンなしの大文字で保存する
+	Code string `gorm:"not null;uniqueIndex"`
+
+	RedeemedOrderID *uuid.UUID `gorm:"type:uuid;index"`
+	RedeemedAt      *time.Time
+
+	Batch         VoucherBatch `gorm:"foreignKey:BatchID;references:ID"`
+	RedeemedOrder *Order       `gorm:"foreignKey:RedeemedOrderID;references:ID"`
+}
+
+func (v *Voucher) BeforeCreate(tx *gorm.DB) error {
+	if v.ID == uuid.Nil {
+		v.ID = uuid.New()
+	}
+	return nil
+}
+
+func (v *Voucher) Redeemed() bool {
+	return v.RedeemedOrderID != nil
+}
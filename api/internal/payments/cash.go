@@ -0,0 +1,23 @@
+// api/internal/payments/cash.go
+package payments
+
+import "context"
+
+// 現金決済。レジで受け取るので常に承認する
+type CashProvider struct{}
+
+func NewCashProvider() *CashProvider {
+	return &CashProvider{}
+}
+
+func (p *CashProvider) Method() string {
+	return MethodCash
+}
+
+func (p *CashProvider) Authorize(ctx context.Context, req Request) (Result, error) {
+	return Result{Status: StatusApproved}, nil
+}
+
+func (p *CashProvider) Void(ctx context.Context, externalRef string) error {
+	return nil
+}
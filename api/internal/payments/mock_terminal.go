@@ -0,0 +1,75 @@
+// api/internal/payments/mock_terminal.go
+package payments
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ローカルで動くキャッシュレス決済端末のモック
+// Request.Simulate で承認・拒否・タイムアウトを切り替えられる
+type MockTerminal struct {
+	latency time.Duration
+	timeout time.Duration
+
+	mu       sync.Mutex
+	approved map[string]bool
+}
+
+func NewMockTerminal(latency, timeout time.Duration) *MockTerminal {
+	return &MockTerminal{
+		latency:  latency,
+		timeout:  timeout,
+		approved: map[string]bool{},
+	}
+}
+
+func (t *MockTerminal) Method() string {
+	return MethodTerminal
+}
+
+func (t *MockTerminal) Authorize(ctx context.Context, req Request) (Result, error) {
+	if req.Amount <= 0 {
+		return Result{Status: StatusDeclined, Message: "invalid amount"}, nil
+	}
+
+	// 端末の応答を待つ。timeout の指示があれば応答しない
+	ctx, cancel := context.WithTimeout(ctx, t.timeout)
+	defer cancel()
+	wait := t.latency
+	if req.Simulate == "timeout" {
+		wait = t.timeout + time.Second
+	}
+	select {
+	case <-time.After(wait):
+	case <-ctx.Done():
+		if ctx.Err() == context.DeadlineExceeded {
+			return Result{Status: StatusTimeout, Message: "terminal did not respond"}, nil
+		}
+		return Result{}, ctx.Err()
+	}
+
+	if req.Simulate == "decline" {
+		return Result{Status: StatusDeclined, Message: "declined by terminal"}, nil
+	}
+
+	ref := fmt.Sprintf("mock-%s", uuid.NewString())
+	t.mu.Lock()
+	t.approved[ref] = true
+	t.mu.Unlock()
+	return Result{Status: StatusApproved, ExternalRef: ref}, nil
+}
+
+func (t *MockTerminal) Void(ctx context.Context, externalRef string) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if !t.approved[externalRef] {
+		return fmt.Errorf("unknown transaction: %s", externalRef)
+	}
+	delete(t.approved, externalRef)
+	return nil
+}
@@ -0,0 +1,84 @@
+// api/internal/payments/provider.go
+package payments
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/google/uuid"
+)
+
+// 決済手段
+const (
+	MethodCash     = "cash"
+	MethodTerminal = "terminal"
+)
+
+// 決済の状態
+type Status string
+
+const (
+	StatusApproved Status = "approved"
+	StatusDeclined Status = "declined"
+	StatusTimeout  Status = "timeout"
+	StatusVoided   Status = "voided"
+)
+
+var ErrUnknownMethod = errors.New("Unknown payment method")
+
+// 決済の依頼内容
+type Request struct {
+	OrderID uuid.UUID
+	Amount  int
+	// 決済端末のシミュレーション用の指示（approve / decline / timeout）
+	// 実際の決済端末では使わない
+	Simulate string
+}
+
+// 決済の結果
+type Result struct {
+	Status      Status
+	ExternalRef string
+	Message     string
+}
+
+// 決済手段ごとの処理
+type Provider interface {
+	// 決済手段の名前（cash, terminal など）
+	Method() string
+	// 決済を承認する。拒否やタイムアウトは error ではなく Result.Status で返す
+	Authorize(ctx context.Context, req Request) (Result, error)
+	// 承認済みの決済を取り消す
+	Void(ctx context.Context, externalRef string) error
+}
+
+// 利用できる決済手段の一覧
+type Registry struct {
+	providers map[string]Provider
+}
+
+func NewRegistry(providers ...Provider) *Registry {
+	r := &Registry{providers: map[string]Provider{}}
+	for _, p := range providers {
+		r.providers[p.Method()] = p
+	}
+	return r
+}
+
+func (r *Registry) Get(method string) (Provider, error) {
+	p, ok := r.providers[method]
+	if !ok {
+		return nil, ErrUnknownMethod
+	}
+	return p, nil
+}
+
+func (r *Registry) Methods() []string {
+	methods := make([]string, 0, len(r.providers))
+	for m := range r.providers {
+		methods = append(methods, m)
+	}
+	sort.Strings(methods)
+	return methods
+}
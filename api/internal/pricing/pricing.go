@@ -0,0 +1,97 @@
+// api/internal/pricing/pricing.go
+package pricing
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"cafeore-pos/api/internal/models"
+)
+
+// 指定した時刻に有効な価格を返す
+// 価格の履歴がないアイテムは結果に含まれない（Item.Price を使う）
+func EffectivePrices(db *gorm.DB, itemIDs []uuid.UUID, at time.Time) (map[uuid.UUID]int, error) {
+	prices := map[uuid.UUID]int{}
+	if len(itemIDs) == 0 {
+		return prices, nil
+	}
+
+	var rows []models.ItemPrice
+	if err := db.Raw(`
+SELECT DISTINCT ON (item_id) *
+FROM item_prices
+WHERE item_id IN ? AND effective_from <= ?
+ORDER BY item_id, effective_from DESC, created_at DESC`, itemIDs, at).Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		prices[row.ItemID] = row.Price
+	}
+	return prices, nil
+}
+
+// 価格の履歴から指定した時刻に有効な価格を選ぶ（EffectivePrices の SQL と同じ規則）
+// 有効になった時刻が新しいもの、同じなら後から記録したものを使う
+func Select(history []models.ItemPrice, at time.Time) map[uuid.UUID]int {
+	latest := map[uuid.UUID]models.ItemPrice{}
+	for _, p := range history {
+		if p.EffectiveFrom.After(at) {
+			continue
+		}
+		cur, ok := latest[p.ItemID]
+		if !ok || p.EffectiveFrom.After(cur.EffectiveFrom) ||
+			(p.EffectiveFrom.Equal(cur.EffectiveFrom) && !p.CreatedAt.Before(cur.CreatedAt)) {
+			latest[p.ItemID] = p
+		}
+	}
+	prices := map[uuid.UUID]int{}
+	for id, p := range latest {
+		prices[id] = p.Price
+	}
+	return prices
+}
+
+// アイテムごとの有効な価格を返す（価格の履歴がないアイテムは含まない）
+type Lookup func(itemIDs []uuid.UUID) (map[uuid.UUID]int, error)
+
+// アイテムの価格を lookup の返す有効な価格に置き換える
+func ApplyWith(items []models.Item, lookup Lookup) error {
+	ids := make([]uuid.UUID, len(items))
+	for i, item := range items {
+		ids[i] = item.ID
+	}
+	prices, err := lookup(ids)
+	if err != nil {
+		return err
+	}
+	for i := range items {
+		if price, ok := prices[items[i].ID]; ok {
+			items[i].Price = price
+		}
+	}
+	return nil
+}
+
+// アイテムの価格を指定した時刻に有効な価格に置き換える
+func Apply(db *gorm.DB, items []models.Item, at time.Time) error {
+	return ApplyWith(items, func(ids []uuid.UUID) (map[uuid.UUID]int, error) {
+		return EffectivePrices(db, ids, at)
+	})
+}
+
+// 価格の変更を履歴に記録する
+func Record(db *gorm.DB, itemID uuid.UUID, price int, effectiveFrom time.Time, reason string) (*models.ItemPrice, error) {
+	record := models.ItemPrice{
+		ItemID:        itemID,
+		Price:         price,
+		EffectiveFrom: effectiveFrom,
+		Reason:        reason,
+		CreatedAt:     time.Now(),
+	}
+	if err := db.Create(&record).Error; err != nil {
+		return nil, err
+	}
+	return &record, nil
+}
@@ -0,0 +1,104 @@
+// api/internal/printing/printer.go
+package printing
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"cafeore-pos/api/internal/models"
+)
+
+// ポートを省略したときの raw 印刷のポート
+const DefaultPort = "9100"
+
+// 印刷ジョブの設定
+type Config struct {
+	// 種類ごとの送り先（host:port）。設定した種類はオーダー作成時に自動で印刷する
+	Printers map[models.PrintKind]string
+	// 送信を試みる回数の上限（超えたら failed にする）
+	MaxAttempts int
+	// 再試行までの間隔（試行回数に比例して延ばす）
+	RetryInterval time.Duration
+	// 接続・送信のタイムアウト
+	Timeout time.Duration
+	// 通知がなくてもキューを確認する間隔
+	PollInterval time.Duration
+}
+
+// 環境変数から設定を読む
+//
+//	LABEL_PRINTER_ADDR, RECEIPT_PRINTER_ADDR, PRINT_MAX_ATTEMPTS, PRINT_RETRY_INTERVAL, PRINT_TIMEOUT
+func ConfigFromEnv() Config {
+	cfg := Config{
+		Printers:      map[models.PrintKind]string{},
+		MaxAttempts:   5,
+		RetryInterval: 5 * time.Second,
+		Timeout:       5 * time.Second,
+		PollInterval:  5 * time.Second,
+	}
+	if v := os.Getenv("LABEL_PRINTER_ADDR"); v != "" {
+		cfg.Printers[models.Labels] = Address(v)
+	}
+	if v := os.Getenv("RECEIPT_PRINTER_ADDR"); v != "" {
+		cfg.Printers[models.Receipt] = Address(v)
+	}
+	var attempts int
+	if _, err := fmt.Sscan(os.Getenv("PRINT_MAX_ATTEMPTS"), &attempts); err == nil && attempts > 0 {
+		cfg.MaxAttempts = attempts
+	}
+	if d, err := time.ParseDuration(os.Getenv("PRINT_RETRY_INTERVAL")); err == nil && d > 0 {
+		cfg.RetryInterval = d
+	}
+	if d, err := time.ParseDuration(os.Getenv("PRINT_TIMEOUT")); err == nil && d > 0 {
+		cfg.Timeout = d
+	}
+	return cfg
+}
+
+// ポートがなければ 9100 を付ける
+func Address(addr string) string {
+	addr = strings.TrimSpace(addr)
+	if _, _, err := net.SplitHostPort(addr); err == nil {
+		return addr
+	}
+	return net.JoinHostPort(addr, DefaultPort)
+}
+
+// プリンターの raw ポートにデータを送る
+func Send(ctx context.Context, addr string, data []byte, timeout time.Duration) error {
+	dialer := net.Dialer{Timeout: timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", Address(addr))
+	if err != nil {
+		return err
+	}
+	if err := conn.SetDeadline(time.Now().Add(timeout)); err != nil {
+		conn.Close()
+		return err
+	}
+	if _, err := conn.Write(data); err != nil {
+		conn.Close()
+		return err
+	}
+	return conn.Close()
+}
+
+// 送信結果からジョブの次の状態を決める
+func (c Config) finish(job *models.PrintJob, err error, now time.Time) {
+	if err == nil {
+		job.Status = string(models.Done)
+		job.LastError = ""
+		job.PrintedAt = &now
+		return
+	}
+	job.LastError = err.Error()
+	if job.Attempts >= c.MaxAttempts {
+		job.Status = string(models.Failed)
+		return
+	}
+	job.Status = string(models.Queued)
+	job.NextAttemptAt = now.Add(c.RetryInterval * time.Duration(job.Attempts))
+}
@@ -0,0 +1,110 @@
+// api/internal/printing/printer_test.go
+package printing
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"cafeore-pos/api/internal/models"
+)
+
+// 受け取ったデータを返すだけのプリンター
+func fakePrinter(t *testing.T) (string, <-chan []byte) {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+
+	received := make(chan []byte, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		data, _ := io.ReadAll(conn)
+		received <- data
+	}()
+	return ln.Addr().String(), received
+}
+
+func TestSend(t *testing.T) {
+	addr, received := fakePrinter(t)
+	data := []byte("\x1b@hello\x1dVB\x00")
+
+	if err := Send(context.Background(), addr, data, time.Second); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+	select {
+	case got := <-received:
+		if !bytes.Equal(got, data) {
+			t.Errorf("printer received %q, want %q", got, data)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("printer received nothing")
+	}
+}
+
+func TestSendUnreachable(t *testing.T) {
+	// 閉じたポートには接続できない
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := ln.Addr().String()
+	ln.Close()
+
+	if err := Send(context.Background(), addr, []byte("x"), time.Second); err == nil {
+		t.Fatal("Send to a closed port succeeded")
+	}
+}
+
+func TestAddress(t *testing.T) {
+	tests := map[string]string{
+		"192.168.0.50":      "192.168.0.50:9100",
+		"192.168.0.50:9101": "192.168.0.50:9101",
+		" printer.local ":   "printer.local:9100",
+	}
+	for in, want := range tests {
+		if got := Address(in); got != want {
+			t.Errorf("Address(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestFinish(t *testing.T) {
+	cfg := Config{MaxAttempts: 3, RetryInterval: 10 * time.Second}
+	now := time.Date(2025, 11, 1, 10, 0, 0, 0, time.UTC)
+	sendErr := errors.New("connection refused")
+
+	job := models.PrintJob{Status: string(models.Printing), Attempts: 2}
+	cfg.finish(&job, sendErr, now)
+	if job.Status != string(models.Queued) {
+		t.Errorf("status after attempt 2 = %s, want queued", job.Status)
+	}
+	if want := now.Add(20 * time.Second); !job.NextAttemptAt.Equal(want) {
+		t.Errorf("next attempt = %v, want %v", job.NextAttemptAt, want)
+	}
+	if job.LastError != sendErr.Error() {
+		t.Errorf("last error = %q", job.LastError)
+	}
+
+	job.Attempts = 3
+	cfg.finish(&job, sendErr, now)
+	if job.Status != string(models.Failed) {
+		t.Errorf("status after attempt 3 = %s, want failed", job.Status)
+	}
+
+	job.Attempts = 1
+	cfg.finish(&job, nil, now)
+	if job.Status != string(models.Done) || job.PrintedAt == nil || job.LastError != "" {
+		t.Errorf("job after success = %+v", job)
+	}
+}
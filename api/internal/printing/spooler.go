@@ -0,0 +1,205 @@
+// api/internal/printing/spooler.go
+package printing
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"cafeore-pos/api/internal/escpos"
+	"cafeore-pos/api/internal/models"
+)
+
+var ErrNoPrinter = errors.New("No printer configured")
+
+// 印刷ジョブを DB のキューから取り出してプリンターに送る
+// ジョブは一つずつ順番に送る（同じプリンターに並行して送ると印刷が混ざるため）
+type Spooler struct {
+	db       *gorm.DB
+	renderer *escpos.Renderer
+	cfg      Config
+	wake     chan struct{}
+}
+
+func NewSpooler(db *gorm.DB, renderer *escpos.Renderer, cfg Config) *Spooler {
+	return &Spooler{
+		db:       db,
+		renderer: renderer,
+		cfg:      cfg,
+		wake:     make(chan struct{}, 1),
+	}
+}
+
+func (s *Spooler) Config() Config {
+	return s.cfg
+}
+
+// 印刷に必要な関連データをロードしてオーダーを取得する
+func LoadOrder(db *gorm.DB, orderID uuid.UUID) (*models.Order, error) {
+	var order models.Order
+	if err := db.Preload("OrderItems.Item.ItemType").Preload("Payments").First(&order, "id = ?", orderID).Error; err != nil {
+		return nil, err
+	}
+	return &order, nil
+}
+
+// 印刷ジョブを作る（printer が空なら種類ごとの設定を使う）
+// 送信は Notify の後に Run の中で行う
+func (s *Spooler) Enqueue(tx *gorm.DB, orderID uuid.UUID, kind models.PrintKind, printer string) (*models.PrintJob, error) {
+	if printer == "" {
+		printer = s.cfg.Printers[kind]
+	}
+	if printer == "" {
+		return nil, ErrNoPrinter
+	}
+	job := models.PrintJob{
+		OrderID:       orderID,
+		Kind:          string(kind),
+		Printer:       Address(printer),
+		Status:        string(models.Queued),
+		NextAttemptAt: time.Now(),
+	}
+	if err := tx.Create(&job).Error; err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// オーダー作成時の印刷ジョブ（プリンターが設定された種類だけ）
+// 保存はオーダーを作るトランザクションで行う
+func (s *Spooler) OrderJobs(orderID uuid.UUID) []models.PrintJob {
+	var jobs []models.PrintJob
+	for _, kind := range []models.PrintKind{models.Labels, models.Receipt} {
+		printer := s.cfg.Printers[kind]
+		if printer == "" {
+			continue
+		}
+		jobs = append(jobs, models.PrintJob{
+			OrderID:       orderID,
+			Kind:          string(kind),
+			Printer:       Address(printer),
+			Status:        string(models.Queued),
+			NextAttemptAt: time.Now(),
+		})
+	}
+	return jobs
+}
+
+// 新しいジョブがあることを Run に知らせる
+func (s *Spooler) Notify() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+// ctx が終わるまでキューのジョブを送り続ける
+func (s *Spooler) Run(ctx context.Context) {
+	// 前回の起動で送信中のまま止まったジョブは送り直す
+	if err := s.db.Model(&models.PrintJob{}).
+		Where("status = ?", models.Printing).
+		Update("status", models.Queued).Error; err != nil {
+		log.Printf("failed to requeue print jobs: %v", err)
+	}
+
+	ticker := time.NewTicker(s.cfg.PollInterval)
+	defer ticker.Stop()
+	for {
+		s.drain(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.wake:
+		case <-ticker.C:
+		}
+	}
+}
+
+// 送れるジョブがなくなるまで送る
+func (s *Spooler) drain(ctx context.Context) {
+	for ctx.Err() == nil {
+		job, err := s.claim(ctx)
+		if err != nil {
+			log.Printf("failed to fetch print job: %v", err)
+			return
+		}
+		if job == nil {
+			return
+		}
+		s.process(ctx, job)
+	}
+}
+
+// 送信時刻になったジョブを一つ取り出して送信中にする
+func (s *Spooler) claim(ctx context.Context) (*models.PrintJob, error) {
+	var job models.PrintJob
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? AND next_attempt_at <= ?", models.Queued, time.Now()).
+			Order("next_attempt_at, created_at").
+			First(&job).Error; err != nil {
+			return err
+		}
+		job.Status = string(models.Printing)
+		job.Attempts++
+		return tx.Model(&job).Updates(map[string]interface{}{
+			"status":   job.Status,
+			"attempts": job.Attempts,
+		}).Error
+	})
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// ジョブの印刷データを作って送り、結果を記録する
+func (s *Spooler) process(ctx context.Context, job *models.PrintJob) {
+	data, err := s.render(job)
+	if err == nil {
+		err = Send(ctx, job.Printer, data, s.cfg.Timeout)
+	}
+	if err != nil {
+		log.Printf("print job %s (%s to %s) failed: %v", job.ID, job.Kind, job.Printer, err)
+	}
+	s.cfg.finish(job, err, time.Now())
+
+	if err := s.db.Model(job).Updates(map[string]interface{}{
+		"status":          job.Status,
+		"last_error":      job.LastError,
+		"next_attempt_at": job.NextAttemptAt,
+		"printed_at":      job.PrintedAt,
+	}).Error; err != nil {
+		log.Printf("failed to update print job %s: %v", job.ID, err)
+	}
+}
+
+func (s *Spooler) render(job *models.PrintJob) ([]byte, error) {
+	order, err := LoadOrder(s.db, job.OrderID)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	switch models.PrintKind(job.Kind) {
+	case models.Labels:
+		err = s.renderer.RenderLabels(&buf, order)
+	case models.Receipt:
+		err = s.renderer.RenderReceipt(&buf, order)
+	default:
+		err = fmt.Errorf("unknown print kind: %s", job.Kind)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
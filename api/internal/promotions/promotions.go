@@ -0,0 +1,273 @@
+// api/internal/promotions/promotions.go
+package promotions
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"cafeore-pos/api/internal/jst"
+	"cafeore-pos/api/internal/models"
+)
+
+// 割引の計算に使うオーダーの一品
+type Line struct {
+	ItemID     uuid.UUID
+	ItemTypeID uuid.UUID
+	UnitPrice  int
+	// 割引の対象になるコーヒーの杯数（セット商品は構成アイテムの杯数）
+	CoffeeCups int
+}
+
+// 割引を計算するオーダーの内容
+type Cart struct {
+	Lines []Line
+	// カップを返却したオーダーの杯数
+	ReturnedCups int
+	At           time.Time
+}
+
+func (c *Cart) Subtotal() int {
+	total := 0
+	for _, l := range c.Lines {
+		total += l.UnitPrice
+	}
+	return total
+}
+
+func (c *Cart) CoffeeCups() int {
+	cups := 0
+	for _, l := range c.Lines {
+		cups += l.CoffeeCups
+	}
+	return cups
+}
+
+// 割引の計算結果
+type Result struct {
+	Subtotal int
+	Discount int
+	Applied  models.OrderPromotions
+}
+
+func (r *Result) BillingAmount() int {
+	return r.Subtotal - r.Discount
+}
+
+// ルールが割引に使われたかどうか
+func (r *Result) AppliedTo(ruleID uuid.UUID) bool {
+	for _, a := range r.Applied {
+		if a.PromotionID == ruleID {
+			return true
+		}
+	}
+	return false
+}
+
+// 有効な割引ルールを優先度順に適用する
+// 各ルールの割引額は割引前の価格で計算し、割引額の合計は小計を超えない
+func Evaluate(rules []models.Promotion, cart Cart) Result {
+	sorted := make([]models.Promotion, len(rules))
+	copy(sorted, rules)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Priority != sorted[j].Priority {
+			return sorted[i].Priority < sorted[j].Priority
+		}
+		return sorted[i].CreatedAt.Before(sorted[j].CreatedAt)
+	})
+
+	result := Result{Subtotal: cart.Subtotal(), Applied: models.OrderPromotions{}}
+	for _, rule := range sorted {
+		if !Applies(&rule, cart.At) {
+			continue
+		}
+		amount := discount(&rule, &cart)
+		if remaining := result.Subtotal - result.Discount; amount > remaining {
+			amount = remaining
+		}
+		if amount <= 0 {
+			continue
+		}
+		result.Discount += amount
+		result.Applied = append(result.Applied, models.OrderPromotion{
+			PromotionID: rule.ID,
+			Name:        rule.Name,
+			Type:        rule.Type,
+			Amount:      amount,
+		})
+	}
+	return result
+}
+
+// ルールが有効で、at が有効期間（happy_hour は時間帯）に含まれるか
+func Applies(rule *models.Promotion, at time.Time) bool {
+	if !rule.Active {
+		return false
+	}
+	if rule.StartsAt != nil && at.Before(*rule.StartsAt) {
+		return false
+	}
+	if rule.EndsAt != nil && !at.Before(*rule.EndsAt) {
+		return false
+	}
+	if models.PromotionType(rule.Type) == models.HappyHour {
+		return inDailyWindow(rule, at)
+	}
+	return true
+}
+
+func discount(rule *models.Promotion, cart *Cart) int {
+	switch models.PromotionType(rule.Type) {
+	case models.PerCupReturn:
+		cups := cart.CoffeeCups()
+		if cart.ReturnedCups < cups {
+			cups = cart.ReturnedCups
+		}
+		return cups * rule.Amount
+
+	case models.FixedOff:
+		if cart.Subtotal() < rule.MinSubtotal {
+			return 0
+		}
+		return rule.Amount
+
+	case models.PercentOffItemType:
+		return percentOf(targetLines(rule, cart), rule.Percent)
+
+	case models.BuyNGetM:
+		lines := targetLines(rule, cart)
+		set := rule.BuyQuantity + rule.FreeQuantity
+		if set <= 0 {
+			return 0
+		}
+		// 安いものから無料にする
+		prices := make([]int, len(lines))
+		for i, l := range lines {
+			prices[i] = l.UnitPrice
+		}
+		sort.Ints(prices)
+		free := len(prices) / set * rule.FreeQuantity
+		total := 0
+		for _, p := range prices[:free] {
+			total += p
+		}
+		return total
+
+	case models.HappyHour:
+		lines := targetLines(rule, cart)
+		if rule.Percent > 0 {
+			return percentOf(lines, rule.Percent)
+		}
+		total := 0
+		for _, l := range lines {
+			amount := rule.Amount
+			if amount > l.UnitPrice {
+				amount = l.UnitPrice
+			}
+			total += amount
+		}
+		return total
+	}
+	return 0
+}
+
+// ルールの対象になる品（item_id、item_type_id の順に絞り込み、どちらもなければすべて）
+func targetLines(rule *models.Promotion, cart *Cart) []Line {
+	var lines []Line
+	for _, l := range cart.Lines {
+		if rule.ItemID != nil && l.ItemID != *rule.ItemID {
+			continue
+		}
+		if rule.ItemID == nil && rule.ItemTypeID != nil && l.ItemTypeID != *rule.ItemTypeID {
+			continue
+		}
+		lines = append(lines, l)
+	}
+	return lines
+}
+
+// 1円未満は切り捨てる
+func percentOf(lines []Line, percent int) int {
+	total := 0
+	for _, l := range lines {
+		total += l.UnitPrice
+	}
+	return total * percent / 100
+}
+
+func inDailyWindow(rule *models.Promotion, at time.Time) bool {
+	if rule.DailyStart == nil || rule.DailyEnd == nil {
+		return false
+	}
+	start, err := parseClock(*rule.DailyStart)
+	if err != nil {
+		return false
+	}
+	end, err := parseClock(*rule.DailyEnd)
+	if err != nil {
+		return false
+	}
+	local := at.In(jst.Location)
+	now := local.Hour()*60 + local.Minute()
+	if start <= end {
+		return start <= now && now < end
+	}
+	// 日付をまたぐ時間帯
+	return now >= start || now < end
+}
+
+// HH:MM を0時からの分に変換する
+func parseClock(s string) (int, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q (HH:MM)", s)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// ルールの種類ごとに必要な項目が揃っているか確認する
+func Validate(rule *models.Promotion) error {
+	if rule.Name == "" {
+		return errors.New("name is required")
+	}
+	if rule.Amount < 0 || rule.Percent < 0 || rule.Percent > 100 || rule.MinSubtotal < 0 {
+		return errors.New("amount, percent and min_subtotal must not be negative (percent is at most 100)")
+	}
+	if rule.StartsAt != nil && rule.EndsAt != nil && !rule.StartsAt.Before(*rule.EndsAt) {
+		return errors.New("starts_at must be before ends_at")
+	}
+
+	switch models.PromotionType(rule.Type) {
+	case models.PerCupReturn, models.FixedOff:
+		if rule.Amount <= 0 {
+			return fmt.Errorf("%s requires amount", rule.Type)
+		}
+	case models.PercentOffItemType:
+		if rule.ItemTypeID == nil || rule.Percent <= 0 {
+			return fmt.Errorf("%s requires item_type_id and percent", rule.Type)
+		}
+	case models.BuyNGetM:
+		if rule.BuyQuantity <= 0 || rule.FreeQuantity <= 0 {
+			return fmt.Errorf("%s requires buy_quantity and free_quantity", rule.Type)
+		}
+	case models.HappyHour:
+		if rule.DailyStart == nil || rule.DailyEnd == nil {
+			return fmt.Errorf("%s requires daily_start and daily_end", rule.Type)
+		}
+		if _, err := parseClock(*rule.DailyStart); err != nil {
+			return err
+		}
+		if _, err := parseClock(*rule.DailyEnd); err != nil {
+			return err
+		}
+		if (rule.Percent > 0) == (rule.Amount > 0) {
+			return fmt.Errorf("%s requires either percent or amount", rule.Type)
+		}
+	default:
+		return fmt.Errorf("unknown promotion type: %s", rule.Type)
+	}
+	return nil
+}
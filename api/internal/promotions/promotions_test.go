@@ -0,0 +1,209 @@
+// api/internal/promotions/promotions_test.go
+package promotions
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"cafeore-pos/api/internal/jst"
+	"cafeore-pos/api/internal/models"
+)
+
+var (
+	coffeeType = uuid.New()
+	foodType   = uuid.New()
+	blend      = uuid.New()
+)
+
+func coffee(price int) Line {
+	return Line{ItemID: blend, ItemTypeID: coffeeType, UnitPrice: price, CoffeeCups: 1}
+}
+
+func food(price int) Line {
+	return Line{ItemID: uuid.New(), ItemTypeID: foodType, UnitPrice: price}
+}
+
+func clock(s string) *string {
+	return &s
+}
+
+func TestEvaluate(t *testing.T) {
+	noon := time.Date(2025, 11, 1, 12, 0, 0, 0, jst.Location)
+	late := time.Date(2025, 11, 1, 23, 30, 0, 0, jst.Location)
+	early := time.Date(2025, 11, 2, 1, 0, 0, 0, jst.Location)
+	nightly := models.Promotion{
+		Type: string(models.HappyHour), Active: true, Amount: 150,
+		DailyStart: clock("22:00"), DailyEnd: clock("02:00"),
+	}
+
+	tests := []struct {
+		name string
+		rule models.Promotion
+		cart Cart
+		want int
+	}{
+		{
+			name: "per_cup_return は返却した杯数",
+			rule: models.Promotion{Type: string(models.PerCupReturn), Active: true, Amount: 100},
+			cart: Cart{Lines: []Line{coffee(500), coffee(500), coffee(500)}, ReturnedCups: 2, At: noon},
+			want: 200,
+		},
+		{
+			name: "per_cup_return はコーヒーの杯数まで",
+			rule: models.Promotion{Type: string(models.PerCupReturn), Active: true, Amount: 100},
+			cart: Cart{Lines: []Line{coffee(500), food(300)}, ReturnedCups: 3, At: noon},
+			want: 100,
+		},
+		{
+			name: "fixed_off は最低金額に届かなければ割引しない",
+			rule: models.Promotion{Type: string(models.FixedOff), Active: true, Amount: 100, MinSubtotal: 1000},
+			cart: Cart{Lines: []Line{coffee(500), food(300)}, At: noon},
+			want: 0,
+		},
+		{
+			name: "fixed_off は最低金額ちょうどで割引する",
+			rule: models.Promotion{Type: string(models.FixedOff), Active: true, Amount: 100, MinSubtotal: 800},
+			cart: Cart{Lines: []Line{coffee(500), food(300)}, At: noon},
+			want: 100,
+		},
+		{
+			name: "fixed_off は小計を超えない",
+			rule: models.Promotion{Type: string(models.FixedOff), Active: true, Amount: 1000},
+			cart: Cart{Lines: []Line{food(300)}, At: noon},
+			want: 300,
+		},
+		{
+			name: "percent_off_item_type は対象の種別だけで1円未満は切り捨て",
+			rule: models.Promotion{Type: string(models.PercentOffItemType), Active: true, Percent: 15, ItemTypeID: &coffeeType},
+			cart: Cart{Lines: []Line{coffee(450), coffee(333), food(1000)}, At: noon},
+			want: 117,
+		},
+		{
+			name: "buy_n_get_m は安いものから無料",
+			rule: models.Promotion{Type: string(models.BuyNGetM), Active: true, BuyQuantity: 2, FreeQuantity: 1},
+			cart: Cart{Lines: []Line{food(500), food(300), food(400), food(600), food(200), food(450)}, At: noon},
+			want: 500,
+		},
+		{
+			name: "buy_n_get_m は揃わなければ割引しない",
+			rule: models.Promotion{Type: string(models.BuyNGetM), Active: true, BuyQuantity: 2, FreeQuantity: 1},
+			cart: Cart{Lines: []Line{food(500), food(300)}, At: noon},
+			want: 0,
+		},
+		{
+			name: "buy_n_get_m は item_id で絞り込む",
+			rule: models.Promotion{Type: string(models.BuyNGetM), Active: true, BuyQuantity: 1, FreeQuantity: 1, ItemID: &blend},
+			cart: Cart{Lines: []Line{coffee(500), coffee(400), food(100), food(100)}, At: noon},
+			want: 400,
+		},
+		{
+			name: "happy_hour は日付をまたぐ時間帯の前半",
+			rule: nightly,
+			cart: Cart{Lines: []Line{coffee(500), food(100)}, At: late},
+			want: 250,
+		},
+		{
+			name: "happy_hour は日付をまたぐ時間帯の後半",
+			rule: nightly,
+			cart: Cart{Lines: []Line{coffee(500)}, At: early},
+			want: 150,
+		},
+		{
+			name: "happy_hour は時間帯の外では割引しない",
+			rule: nightly,
+			cart: Cart{Lines: []Line{coffee(500)}, At: noon},
+			want: 0,
+		},
+		{
+			name: "無効なルールは割引しない",
+			rule: models.Promotion{Type: string(models.FixedOff), Active: false, Amount: 100},
+			cart: Cart{Lines: []Line{coffee(500)}, At: noon},
+			want: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.rule.ID = uuid.New()
+			result := Evaluate([]models.Promotion{tt.rule}, tt.cart)
+			if result.Discount != tt.want {
+				t.Errorf("discount = %d, want %d", result.Discount, tt.want)
+			}
+			if result.BillingAmount() != tt.cart.Subtotal()-tt.want {
+				t.Errorf("billing amount = %d, want %d", result.BillingAmount(), tt.cart.Subtotal()-tt.want)
+			}
+			if applied := result.AppliedTo(tt.rule.ID); applied != (tt.want > 0) {
+				t.Errorf("applied = %v, want %v", applied, tt.want > 0)
+			}
+		})
+	}
+}
+
+func TestEvaluateCap(t *testing.T) {
+	now := time.Date(2025, 11, 1, 12, 0, 0, 0, jst.Location)
+	fixed := models.Promotion{ID: uuid.New(), Type: string(models.FixedOff), Active: true, Amount: 400, Priority: 1}
+	perCup := models.Promotion{ID: uuid.New(), Type: string(models.PerCupReturn), Active: true, Amount: 100, Priority: 2}
+	percent := models.Promotion{ID: uuid.New(), Type: string(models.PercentOffItemType), Active: true, Percent: 50, ItemTypeID: &coffeeType, Priority: 3}
+	cart := Cart{Lines: []Line{coffee(250), coffee(250)}, ReturnedCups: 2, At: now}
+
+	// 優先度順に適用し、後のルールは残りの小計までしか割引しない
+	result := Evaluate([]models.Promotion{percent, perCup, fixed}, cart)
+	if result.Discount != 500 || result.BillingAmount() != 0 {
+		t.Fatalf("discount = %d, billing = %d, want 500 and 0", result.Discount, result.BillingAmount())
+	}
+	want := []struct {
+		id     uuid.UUID
+		amount int
+	}{{fixed.ID, 400}, {perCup.ID, 100}}
+	if len(result.Applied) != len(want) {
+		t.Fatalf("applied = %+v, want %d promotions", result.Applied, len(want))
+	}
+	for i, w := range want {
+		if result.Applied[i].PromotionID != w.id || result.Applied[i].Amount != w.amount {
+			t.Errorf("applied[%d] = %+v, want %s %d", i, result.Applied[i], w.id, w.amount)
+		}
+	}
+	if result.AppliedTo(percent.ID) {
+		t.Error("percent promotion applied after the subtotal was used up")
+	}
+}
+
+// 割引に使う杯数はオーダーの杯数と同じ数え方（セット商品は構成アイテムのコーヒーだけ）
+func TestNewCartCoffeeCups(t *testing.T) {
+	hot := models.ItemType{ID: uuid.New(), Name: "hot"}
+	milk := models.ItemType{ID: uuid.New(), Name: "milk"}
+	blendItem := models.Item{ID: uuid.New(), Name: "ブレンド", Price: 400, ItemTypeID: hot.ID, ItemType: hot}
+	milkItem := models.Item{ID: uuid.New(), Name: "ミルク", Price: 100, ItemTypeID: milk.ID, ItemType: milk}
+	set := models.Item{ID: uuid.New(), Name: "ペアセット", Price: 800, ItemTypeID: hot.ID, ItemType: hot, Components: []models.BundleComponent{
+		{ComponentItemID: blendItem.ID, ComponentItem: blendItem, Quantity: 2},
+		{ComponentItemID: milkItem.ID, ComponentItem: milkItem, Quantity: 1},
+	}}
+	items := map[uuid.UUID]*models.Item{blendItem.ID: &blendItem, milkItem.ID: &milkItem, set.ID: &set}
+
+	var orderItems []models.OrderItem
+	for _, item := range []*models.Item{&set, &blendItem, &milkItem} {
+		oi := models.OrderItem{ItemID: item.ID}
+		oi.Snapshot(item, nil)
+		orderItems = append(orderItems, oi)
+	}
+	cart := NewCart(orderItems, items, 0, time.Now())
+
+	want := []int{2, 1, 0}
+	total := 0
+	for i, line := range cart.Lines {
+		if line.CoffeeCups != want[i] {
+			t.Errorf("line %d cups = %d, want %d", i, line.CoffeeCups, want[i])
+		}
+		total += line.CoffeeCups
+	}
+
+	// 保存されたオーダーは OrderItem.Item がロードされている
+	order := models.Order{OrderItems: orderItems}
+	for i := range order.OrderItems {
+		order.OrderItems[i].Item = *items[order.OrderItems[i].ItemID]
+	}
+	if got := order.CoffeeCups(); got != total || got != 3 {
+		t.Errorf("order cups = %d, cart cups = %d, want 3", got, total)
+	}
+}
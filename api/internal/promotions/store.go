@@ -0,0 +1,59 @@
+// api/internal/promotions/store.go
+package promotions
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"cafeore-pos/api/internal/models"
+)
+
+// これまで POS で固定していたカップ返却の割引額（一杯あたり）
+const DefaultPerCupReturnAmount = 100
+
+// 有効な割引ルールを読み込む
+func Load(db *gorm.DB) ([]models.Promotion, error) {
+	var rules []models.Promotion
+	if err := db.Where("active = ?", true).Order("priority, created_at").Find(&rules).Error; err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// 割引ルールが一つもなければ（削除済みも含む）カップ返却の割引を作る
+func SeedDefault(db *gorm.DB) error {
+	var count int64
+	if err := db.Unscoped().Model(&models.Promotion{}).Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return nil
+	}
+	return db.Create(&models.Promotion{
+		Name:      "カップ返却割引",
+		Type:      string(models.PerCupReturn),
+		Active:    true,
+		Amount:    DefaultPerCupReturnAmount,
+		CreatedAt: time.Now(),
+	}).Error
+}
+
+// 注文時点の OrderItem から割引を計算するオーダーの内容を作る
+// items は OrderItem のアイテム（種別とセット商品の構成アイテムをロード済み）
+func NewCart(orderItems []models.OrderItem, items map[uuid.UUID]*models.Item, returnedCups int, at time.Time) Cart {
+	lines := make([]Line, 0, len(orderItems))
+	for _, oi := range orderItems {
+		item := items[oi.ItemID]
+		// 杯数はオーダーと同じく OrderItem から数える（セット商品でない場合は種別を使う）
+		oi.Item = *item
+		lines = append(lines, Line{
+			ItemID:     oi.ItemID,
+			ItemTypeID: item.ItemTypeID,
+			UnitPrice:  oi.UnitPrice,
+			CoffeeCups: oi.CoffeeCups(),
+		})
+	}
+	return Cart{Lines: lines, ReturnedCups: returnedCups, At: at}
+}
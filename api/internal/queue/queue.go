@@ -0,0 +1,132 @@
+// api/internal/queue/queue.go
+//
+// 提供待ちのオーダーの列（注文順）
+// 未提供のオーダーだけを部分インデックス（idx_orders_unserved）で読むので、営業が長くなっても重くならない
+package queue
+
+import (
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"gorm.io/gorm"
+
+	"cafeore-pos/api/internal/models"
+)
+
+// 提供待ち（未提供）のオーダー（sessionID が nil の場合はセッションで絞り込まない）
+func Unserved(db *gorm.DB, sessionID *uuid.UUID) *gorm.DB {
+	q := db.Model(&models.Order{}).Where("served_at IS NULL")
+	if sessionID != nil {
+		q = q.Where("session_id = ?", *sessionID)
+	}
+	return q
+}
+
+// 準備中（提供可能になっていない）のオーダー
+func Preparing(db *gorm.DB, sessionID *uuid.UUID) *gorm.DB {
+	return Unserved(db, sessionID).Where("ready_at IS NULL")
+}
+
+// q のオーダーのうち order より前に注文されたものの数
+func Ahead(q *gorm.DB, order *models.Order) (int, error) {
+	var count int64
+	if err := q.Where("(created_at, order_id) < (?, ?)", order.CreatedAt, order.OrderId).
+		Count(&count).Error; err != nil {
+		return 0, err
+	}
+	return int(count), nil
+}
+
+// 提供待ちの列での順番（1から）
+func Position(db *gorm.DB, order *models.Order) (int, error) {
+	n, err := Ahead(Unserved(db, order.SessionID), order)
+	if err != nil {
+		return 0, err
+	}
+	return n + 1, nil
+}
+
+// 提供待ちの列を作るのに使うデータ
+type Orders struct {
+	// 提供待ちのオーダー（注文順、アイテムと種別をロード済み）
+	Unserved []models.Order
+	// アイテム種別の名前と表示名（削除済みの種別を含む）
+	DisplayNames map[string]string
+}
+
+// 提供待ちの列を作るのに使うデータを読み込む（sessionID が nil の場合はセッションで絞り込まない）
+func Load(db *gorm.DB, sessionID *uuid.UUID) (Orders, error) {
+	var orders Orders
+	if err := Unserved(db, sessionID).
+		Preload("OrderItems.Item.ItemType").
+		Order("created_at, order_id").
+		Find(&orders.Unserved).Error; err != nil {
+		return Orders{}, err
+	}
+
+	var itemTypes []models.ItemType
+	if err := db.Unscoped().Find(&itemTypes).Error; err != nil {
+		return Orders{}, err
+	}
+	orders.DisplayNames = DisplayNames(itemTypes)
+	return orders, nil
+}
+
+// アイテム種別の名前と表示名の対応
+// 同じ名前の種別があれば削除されていないものを使う
+func DisplayNames(itemTypes []models.ItemType) map[string]string {
+	displayNames := map[string]string{}
+	for _, t := range itemTypes {
+		if _, ok := displayNames[t.Name]; !ok || !t.Deleted.Valid {
+			displayNames[t.Name] = t.DisplayName
+		}
+	}
+	return displayNames
+}
+
+// 提供待ちの列の内容
+func Build(orders Orders, now time.Time) models.QueueResponse {
+	resp := models.QueueResponse{
+		Orders:      make([]models.QueueEntry, len(orders.Unserved)),
+		WaitingCups: []models.QueueItemTypeCups{},
+		GeneratedAt: now,
+	}
+	cups := map[string]int{}
+	for i := range orders.Unserved {
+		o := &orders.Unserved[i]
+		prepItems := o.PrepItems()
+		resp.Orders[i] = models.QueueEntry{
+			Id:          openapi_types.UUID(o.ID),
+			OrderNumber: o.OrderId,
+			Position:    i + 1,
+			CreatedAt:   o.CreatedAt,
+			AgeSeconds:  int(now.Sub(o.CreatedAt) / time.Second),
+			Ready:       o.ReadyAt != nil,
+			Cups:        len(prepItems),
+		}
+		// 提供可能になったオーダーはもう作らないので数えない
+		if o.ReadyAt == nil {
+			for _, p := range prepItems {
+				cups[p.ItemTypeName]++
+			}
+		}
+	}
+	for name, n := range cups {
+		resp.WaitingCups = append(resp.WaitingCups, models.QueueItemTypeCups{
+			ItemType:    name,
+			DisplayName: orders.DisplayNames[name],
+			Cups:        n,
+		})
+	}
+	sort.Slice(resp.WaitingCups, func(i, j int) bool {
+		return resp.WaitingCups[i].ItemType < resp.WaitingCups[j].ItemType
+	})
+	if len(orders.Unserved) > 0 {
+		resp.OldestWaitSeconds = resp.Orders[0].AgeSeconds
+		oldest := orders.Unserved[0].CreatedAt
+		resp.OldestCreatedAt = &oldest
+	}
+	return resp
+}
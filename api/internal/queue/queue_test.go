@@ -0,0 +1,88 @@
+// api/internal/queue/queue_test.go
+package queue
+
+import (
+	"testing"
+	"time"
+
+	"gorm.io/gorm"
+
+	"cafeore-pos/api/internal/models"
+)
+
+var base = time.Date(2025, 11, 1, 10, 0, 0, 0, time.UTC)
+
+func order(number int, createdAt time.Time, itemTypes ...string) models.Order {
+	o := models.Order{OrderId: number, CreatedAt: createdAt}
+	for _, name := range itemTypes {
+		o.OrderItems = append(o.OrderItems, models.OrderItem{Item: models.Item{ItemType: models.ItemType{Name: name}}})
+	}
+	return o
+}
+
+func TestBuild(t *testing.T) {
+	now := base.Add(10 * time.Minute)
+	ready := order(2, base.Add(2*time.Minute), "hot")
+	readyAt := base.Add(5 * time.Minute)
+	ready.ReadyAt = &readyAt
+	// セット商品は構成アイテムごとに数える
+	bundle := order(3, base.Add(4*time.Minute))
+	bundle.OrderItems = []models.OrderItem{{Components: models.OrderItemComponents{
+		{ItemTypeName: "hot", Quantity: 2},
+		{ItemTypeName: "milk", Quantity: 1},
+	}}}
+	resp := Build(Orders{
+		Unserved:     []models.Order{order(1, base, "hot", "ice"), ready, bundle},
+		DisplayNames: map[string]string{"hot": "ホット", "ice": "アイス", "milk": "ミルク"},
+	}, now)
+
+	if len(resp.Orders) != 3 {
+		t.Fatalf("orders = %+v", resp.Orders)
+	}
+	for i, want := range []struct {
+		number, cups, age int
+		ready             bool
+	}{{1, 2, 600, false}, {2, 1, 480, true}, {3, 3, 360, false}} {
+		e := resp.Orders[i]
+		if e.Position != i+1 || e.OrderNumber != want.number || e.Cups != want.cups || e.AgeSeconds != want.age || e.Ready != want.ready {
+			t.Errorf("entry %d = %+v", i, e)
+		}
+	}
+
+	// 提供可能になったオーダーのカップは数えない
+	want := []models.QueueItemTypeCups{
+		{ItemType: "hot", DisplayName: "ホット", Cups: 3},
+		{ItemType: "ice", DisplayName: "アイス", Cups: 1},
+		{ItemType: "milk", DisplayName: "ミルク", Cups: 1},
+	}
+	if len(resp.WaitingCups) != len(want) {
+		t.Fatalf("waiting cups = %+v", resp.WaitingCups)
+	}
+	for i := range want {
+		if resp.WaitingCups[i] != want[i] {
+			t.Errorf("waiting cups %d = %+v, want %+v", i, resp.WaitingCups[i], want[i])
+		}
+	}
+	if resp.OldestWaitSeconds != 600 || resp.OldestCreatedAt == nil || !resp.OldestCreatedAt.Equal(base) {
+		t.Errorf("oldest = %d, %v", resp.OldestWaitSeconds, resp.OldestCreatedAt)
+	}
+
+	// 列が空でも一覧は nil にしない
+	empty := Build(Orders{}, now)
+	if empty.Orders == nil || empty.WaitingCups == nil || empty.OldestCreatedAt != nil {
+		t.Errorf("empty = %+v", empty)
+	}
+}
+
+func TestDisplayNames(t *testing.T) {
+	deleted := gorm.DeletedAt{Time: base, Valid: true}
+	names := DisplayNames([]models.ItemType{
+		{Name: "hot", DisplayName: "ホット"},
+		{Name: "hot", DisplayName: "旧ホット", Deleted: deleted},
+		{Name: "seasonal", DisplayName: "季節限定", Deleted: deleted},
+	})
+	// 同じ名前なら削除されていないものを使い、削除済みの種別も名前を引ける
+	if names["hot"] != "ホット" || names["seasonal"] != "季節限定" {
+		t.Errorf("display names = %v", names)
+	}
+}
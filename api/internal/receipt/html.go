@@ -0,0 +1,41 @@
+// api/internal/receipt/html.go
+package receipt
+
+import (
+	"embed"
+	"html/template"
+	"io"
+	"strings"
+	"time"
+
+	"cafeore-pos/api/internal/escpos"
+	"cafeore-pos/api/internal/jst"
+)
+
+//go:embed templates/*.html
+var templates embed.FS
+
+var htmlTemplate = template.Must(template.New("receipt.html").Funcs(template.FuncMap{
+	"yen":      yen,
+	"neg":      func(n int) int { return -n },
+	"date":     formatDate,
+	"datetime": formatDateTime,
+	"join":     strings.Join,
+}).ParseFS(templates, "templates/receipt.html"))
+
+func yen(n int) string {
+	return "￥" + escpos.Comma(n)
+}
+
+func formatDate(t time.Time) string {
+	return t.In(jst.Location).Format("2006年1月2日")
+}
+
+func formatDateTime(t time.Time) string {
+	return t.In(jst.Location).Format("2006/01/02 15:04")
+}
+
+// 領収書を印刷用の HTML で書き出す
+func RenderHTML(w io.Writer, doc Document) error {
+	return htmlTemplate.Execute(w, doc)
+}
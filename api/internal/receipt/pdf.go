@@ -0,0 +1,228 @@
+// api/internal/receipt/pdf.go
+package receipt
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf16"
+
+	"cafeore-pos/api/internal/escpos"
+)
+
+// A5 縦（pt）。明細が多い場合は縦に伸ばす
+const (
+	pageWidth     = 420.0
+	minPageHeight = 595.0
+	margin        = 36.0
+	contentWidth  = pageWidth - margin*2
+)
+
+// PDF にはフォントを埋め込まず、ビューアーが持つ日本語フォント（Adobe-Japan1）を使う
+const pdfFontName = "HeiseiKakuGo-W5"
+
+type pdfText struct {
+	x, y, size float64
+	s          string
+}
+
+type pdfLine struct {
+	x1, y1, x2, y2, width float64
+}
+
+// 上からの位置で描いて、最後にページの高さを決めて PDF の座標に直す
+type pdfPage struct {
+	y     float64
+	texts []pdfText
+	lines []pdfLine
+}
+
+// 文字列の幅（全角は 1em、半角は 0.5em）
+func textWidth(s string, size float64) float64 {
+	return float64(escpos.Width(s)) * size / 2
+}
+
+// width に収まるように切り詰める
+func fit(s string, size, width float64) string {
+	return escpos.Truncate(s, int(width/(size/2)))
+}
+
+func (p *pdfPage) text(x, size float64, s string) {
+	p.texts = append(p.texts, pdfText{x: x, y: p.y, size: size, s: s})
+}
+
+func (p *pdfPage) textRight(right, size float64, s string) {
+	p.text(right-textWidth(s, size), size, s)
+}
+
+func (p *pdfPage) textCenter(size float64, s string) {
+	p.text((pageWidth-textWidth(s, size))/2, size, s)
+}
+
+// 左右に分けて一行に書く
+func (p *pdfPage) row(size float64, left, right string) {
+	p.advance(size)
+	p.text(margin, size, fit(left, size, contentWidth-textWidth(right, size)-size))
+	p.textRight(pageWidth-margin, size, right)
+}
+
+func (p *pdfPage) advance(size float64) {
+	p.y += size * 1.6
+}
+
+func (p *pdfPage) rule(width float64) {
+	p.y += 4
+	p.lines = append(p.lines, pdfLine{x1: margin, y1: p.y, x2: pageWidth - margin, y2: p.y, width: width})
+}
+
+func (p *pdfPage) box(top, bottom float64) {
+	left, right := margin, pageWidth-margin
+	p.lines = append(p.lines,
+		pdfLine{left, top, right, top, 1.5},
+		pdfLine{right, top, right, bottom, 1.5},
+		pdfLine{right, bottom, left, bottom, 1.5},
+		pdfLine{left, bottom, left, top, 1.5},
+	)
+}
+
+// 領収書を PDF で書き出す
+func RenderPDF(w io.Writer, doc Document) error {
+	p := &pdfPage{y: margin}
+
+	p.advance(20)
+	p.textCenter(20, "領　収　書")
+	p.advance(10)
+	p.textRight(pageWidth-margin, 10, "No."+doc.Number)
+	p.advance(10)
+	p.textRight(pageWidth-margin, 10, "発行日 "+formatDate(doc.IssuedAt))
+
+	p.advance(14)
+	p.advance(14)
+	p.text(margin, 14, fit(doc.Addressee, 14, contentWidth))
+	p.rule(0.75)
+
+	p.y += 10
+	top := p.y
+	amount := yen(doc.Amount) + "-"
+	if doc.TaxNote != "" {
+		amount += "（" + doc.TaxNote + "）"
+	}
+	p.advance(18)
+	p.textCenter(18, amount)
+	p.y += 10
+	p.box(top, p.y)
+
+	p.advance(10)
+	p.text(margin, 10, fit("但し "+doc.Purpose+"　上記正に領収いたしました", 10, contentWidth))
+	if doc.TaxRate > 0 {
+		p.advance(10)
+		p.text(margin, 10, fmt.Sprintf("（内消費税等 %d%% %s）", doc.TaxRate, yen(doc.Tax)))
+	}
+
+	p.y += 10
+	p.row(10, fmt.Sprintf("注文番号 %d（%s）", doc.OrderNumber, formatDateTime(doc.OrderedAt)), "金額")
+	p.rule(0.75)
+	for _, line := range doc.Lines {
+		p.row(10, line.Name, yen(line.Price))
+		if len(line.Modifiers) > 0 {
+			p.advance(8)
+			p.text(margin+10, 8, fit(strings.Join(line.Modifiers, "・"), 8, contentWidth-10))
+		}
+	}
+	p.rule(0.75)
+	p.row(10, "小計", yen(doc.Subtotal))
+	for _, d := range doc.Discounts {
+		p.row(10, d.Name, yen(-d.Amount))
+	}
+	if doc.Refunded > 0 {
+		p.row(10, "返金", yen(-doc.Refunded))
+	}
+	p.rule(0.75)
+	p.row(12, "合計", yen(doc.Amount))
+	for _, pay := range doc.Payments {
+		p.row(10, pay.Label, yen(pay.Amount))
+	}
+	p.row(10, "お預かり", yen(doc.Received))
+	p.row(10, "お釣り", yen(doc.Change))
+
+	p.y += 20
+	p.advance(11)
+	p.textRight(pageWidth-margin, 11, doc.Issuer)
+	if doc.Issuer != doc.ShopName {
+		p.advance(10)
+		p.textRight(pageWidth-margin, 10, doc.ShopName)
+	}
+
+	return p.write(w, "領収書 No."+doc.Number, doc.IssuedAt)
+}
+
+// UniJIS-UCS2-H で使う2バイトの文字コード（BMP 外の文字は「?」にする）
+func pdfHex(s string) string {
+	var b strings.Builder
+	b.WriteByte('<')
+	for _, r := range s {
+		if r > 0xFFFF {
+			r = '?'
+		}
+		fmt.Fprintf(&b, "%04X", r)
+	}
+	b.WriteByte('>')
+	return b.String()
+}
+
+// 文書情報に使う UTF-16BE の文字列
+func pdfTextString(s string) string {
+	var b strings.Builder
+	b.WriteString("<FEFF")
+	for _, u := range utf16.Encode([]rune(s)) {
+		fmt.Fprintf(&b, "%04X", u)
+	}
+	b.WriteByte('>')
+	return b.String()
+}
+
+func (p *pdfPage) write(w io.Writer, title string, createdAt time.Time) error {
+	height := p.y + margin
+	if height < minPageHeight {
+		height = minPageHeight
+	}
+
+	var content bytes.Buffer
+	for _, l := range p.lines {
+		fmt.Fprintf(&content, "%.2f w %.2f %.2f m %.2f %.2f l S\n", l.width, l.x1, height-l.y1, l.x2, height-l.y2)
+	}
+	for _, t := range p.texts {
+		fmt.Fprintf(&content, "BT /F1 %.2f Tf %.2f %.2f Td %s Tj ET\n", t.size, t.x, height-t.y, pdfHex(t.s))
+	}
+
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.2f %.2f] /Resources << /Font << /F1 5 0 R >> >> /Contents 4 0 R >>", pageWidth, height),
+		fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", content.Len(), content.String()),
+		fmt.Sprintf("<< /Type /Font /Subtype /Type0 /BaseFont /%s /Encoding /UniJIS-UCS2-H /DescendantFonts [6 0 R] >>", pdfFontName),
+		// 半角の CID（1-95, 231-632）は幅 500、それ以外は 1000
+		fmt.Sprintf("<< /Type /Font /Subtype /CIDFontType0 /BaseFont /%s /CIDSystemInfo << /Registry (Adobe) /Ordering (Japan1) /Supplement 2 >> /FontDescriptor 7 0 R /DW 1000 /W [1 95 500 231 632 500] >>", pdfFontName),
+		fmt.Sprintf("<< /Type /FontDescriptor /FontName /%s /Flags 4 /FontBBox [-92 -250 1010 922] /ItalicAngle 0 /Ascent 752 /Descent -221 /CapHeight 737 /StemV 114 >>", pdfFontName),
+		fmt.Sprintf("<< /Title %s /Producer (cafeore-pos) /CreationDate (D:%s) >>", pdfTextString(title), createdAt.UTC().Format("20060102150405Z")),
+	}
+
+	var out bytes.Buffer
+	out.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+	offsets := make([]int, len(objects))
+	for i, obj := range objects {
+		offsets[i] = out.Len()
+		fmt.Fprintf(&out, "%d 0 obj\n%s\nendobj\n", i+1, obj)
+	}
+	xref := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R /Info 8 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+
+	_, err := w.Write(out.Bytes())
+	return err
+}
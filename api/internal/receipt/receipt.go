@@ -0,0 +1,216 @@
+// api/internal/receipt/receipt.go
+//
+// お客様に渡す領収書（HTML・PDF）
+// 店頭で印刷するお客様控えのレシートは escpos パッケージで作る
+package receipt
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"cafeore-pos/api/internal/models"
+	"cafeore-pos/api/internal/payments"
+)
+
+// 領収書の設定
+type Config struct {
+	ShopName string
+	// 発行者（省略時は店名）
+	Issuer string
+	// 宛名を指定しなかった場合の宛名
+	Addressee string
+	// 金額に添える税込の表記
+	TaxNote string
+	// 内消費税の税率（%）。0 の場合は内訳を出さない
+	TaxRate int
+	// 但し書き
+	Purpose string
+}
+
+// 環境変数から設定を読む
+//
+//	RECEIPT_SHOP_NAME, RECEIPT_ISSUER, RECEIPT_ADDRESSEE, RECEIPT_TAX_NOTE, RECEIPT_TAX_RATE, RECEIPT_PURPOSE
+func ConfigFromEnv() Config {
+	cfg := Config{
+		ShopName:  "珈琲・俺",
+		Addressee: "上様",
+		TaxNote:   "税込",
+		Purpose:   "飲食代として",
+	}
+	if v := os.Getenv("RECEIPT_SHOP_NAME"); v != "" {
+		cfg.ShopName = v
+	}
+	cfg.Issuer = cfg.ShopName
+	if v := os.Getenv("RECEIPT_ISSUER"); v != "" {
+		cfg.Issuer = v
+	}
+	if v := os.Getenv("RECEIPT_ADDRESSEE"); v != "" {
+		cfg.Addressee = v
+	}
+	if v, ok := os.LookupEnv("RECEIPT_TAX_NOTE"); ok {
+		cfg.TaxNote = v
+	}
+	var rate int
+	if _, err := fmt.Sscan(os.Getenv("RECEIPT_TAX_RATE"), &rate); err == nil && rate > 0 {
+		cfg.TaxRate = rate
+	}
+	if v := os.Getenv("RECEIPT_PURPOSE"); v != "" {
+		cfg.Purpose = v
+	}
+	return cfg
+}
+
+// 領収書の内容
+type Document struct {
+	Number      string
+	IssuedAt    time.Time
+	Addressee   string
+	Amount      int
+	TaxNote     string
+	TaxRate     int
+	Tax         int
+	Purpose     string
+	ShopName    string
+	Issuer      string
+	OrderNumber int
+	OrderedAt   time.Time
+	Lines       []Line
+	Subtotal    int
+	Discounts   []Discount
+	Refunded    int
+	Payments    []Payment
+	Received    int
+	Change      int
+}
+
+type Line struct {
+	Name      string
+	Modifiers []string
+	Price     int
+}
+
+type Discount struct {
+	Name   string
+	Amount int
+}
+
+type Payment struct {
+	Label  string
+	Amount int
+}
+
+// 領収書番号の表記
+func FormatNumber(n int) string {
+	return fmt.Sprintf("%06d", n)
+}
+
+// 宛名に敬称を付ける（「上様」や「様」付きで指定された場合はそのまま）
+func honorific(addressee string) string {
+	if addressee == "" || strings.HasSuffix(addressee, "様") {
+		return addressee
+	}
+	return addressee + " 様"
+}
+
+func paymentLabel(method string) string {
+	switch method {
+	case payments.MethodCash:
+		return "現金"
+	case payments.MethodTerminal:
+		return "キャッシュレス"
+	}
+	return method
+}
+
+// オーダーと発行記録から領収書の内容を作る
+// order.OrderItems・Payments・Refunds がロードされている必要がある
+func (c Config) NewDocument(order *models.Order, issued *models.IssuedReceipt) Document {
+	addressee := issued.Addressee
+	if addressee == "" {
+		addressee = c.Addressee
+	}
+	doc := Document{
+		Number:      FormatNumber(issued.Number),
+		IssuedAt:    issued.IssuedAt,
+		Addressee:   honorific(addressee),
+		TaxNote:     c.TaxNote,
+		TaxRate:     c.TaxRate,
+		Purpose:     c.Purpose,
+		ShopName:    c.ShopName,
+		Issuer:      c.Issuer,
+		OrderNumber: order.OrderId,
+		OrderedAt:   order.CreatedAt,
+		Refunded:    order.RefundedAmount(),
+		Received:    order.Received,
+		Change:      order.Change,
+	}
+	for _, oi := range order.OrderItems {
+		line := Line{Name: oi.Name, Price: oi.UnitPrice}
+		for _, m := range oi.Modifiers {
+			line.Modifiers = append(line.Modifiers, m.Name)
+		}
+		doc.Lines = append(doc.Lines, line)
+		doc.Subtotal += oi.UnitPrice
+	}
+	for _, p := range order.AppliedPromotions {
+		doc.Discounts = append(doc.Discounts, Discount{Name: p.Name, Amount: p.Amount})
+	}
+	for _, p := range order.Payments {
+		if p.Status != string(payments.StatusApproved) {
+			continue
+		}
+		doc.Payments = append(doc.Payments, Payment{Label: paymentLabel(p.Method), Amount: p.Amount})
+	}
+	// 返金した分は領収金額から除く
+	doc.Amount = order.BillingAmount - doc.Refunded
+	if c.TaxRate > 0 {
+		doc.Tax = doc.Amount * c.TaxRate / (100 + c.TaxRate)
+	}
+	return doc
+}
+
+// オーダーの領収書を発行する
+// 発行済みなら同じ番号のものを返す（addressee を指定した場合は宛名を更新する）
+// 番号はシーケンスから取るので、オーダーを消しても発行済みの番号は使い回さない
+func Issue(db *gorm.DB, orderID uuid.UUID, addressee string, now time.Time) (*models.IssuedReceipt, error) {
+	var issued models.IssuedReceipt
+	err := db.Transaction(func(tx *gorm.DB) error {
+		// 同じオーダーに同時に発行しても二つ目を作らないようにロックする
+		if err := tx.Exec("LOCK TABLE issued_receipts IN SHARE ROW EXCLUSIVE MODE").Error; err != nil {
+			return err
+		}
+		err := tx.First(&issued, "order_id = ?", orderID).Error
+		if err == nil {
+			if addressee != "" && addressee != issued.Addressee {
+				issued.Addressee = addressee
+				return tx.Model(&issued).Update("addressee", addressee).Error
+			}
+			return nil
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+
+		var number int
+		if err := tx.Raw("SELECT nextval('issued_receipts_number_seq')").Scan(&number).Error; err != nil {
+			return err
+		}
+		issued = models.IssuedReceipt{
+			OrderID:   &orderID,
+			Number:    number,
+			Addressee: addressee,
+			IssuedAt:  now,
+		}
+		return tx.Create(&issued).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &issued, nil
+}
@@ -0,0 +1,119 @@
+// api/internal/receipt/receipt_test.go
+package receipt
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"cafeore-pos/api/internal/models"
+	"cafeore-pos/api/internal/payments"
+)
+
+func testOrder() *models.Order {
+	return &models.Order{
+		OrderId:       12,
+		CreatedAt:     time.Date(2025, 11, 1, 1, 30, 0, 0, time.UTC),
+		BillingAmount: 1200,
+		Received:      1200,
+		OrderItems: []models.OrderItem{
+			{Name: "ブレンド", UnitPrice: 400, Modifiers: models.OrderItemModifiers{{Name: "アイス"}, {Name: "L"}}},
+			{Name: "カフェオレ", UnitPrice: 500},
+			{Name: "クッキー", UnitPrice: 400},
+		},
+		AppliedPromotions: models.OrderPromotions{{Name: "セット割", Amount: 100}},
+		Refunds: []models.Refund{
+			{Type: models.RefundTypeRefund, Amount: 100},
+			// 作り直しは金額に含めない
+			{Type: models.RefundTypeRemake, Amount: 0},
+		},
+		Payments: []models.Payment{
+			{Method: payments.MethodTerminal, Amount: 800, Status: string(payments.StatusApproved)},
+			{Method: payments.MethodCash, Amount: 400, Status: string(payments.StatusApproved)},
+			{Method: payments.MethodTerminal, Amount: 1200, Status: string(payments.StatusDeclined)},
+		},
+	}
+}
+
+func TestNewDocument(t *testing.T) {
+	cfg := Config{ShopName: "珈琲・俺", Issuer: "珈琲・俺 実行委員会", Addressee: "上様", TaxNote: "税込", TaxRate: 10, Purpose: "飲食代として"}
+	issuedAt := time.Date(2025, 11, 2, 3, 0, 0, 0, time.UTC)
+	doc := cfg.NewDocument(testOrder(), &models.IssuedReceipt{Number: 42, IssuedAt: issuedAt})
+
+	if doc.Number != "000042" || doc.Addressee != "上様" || !doc.IssuedAt.Equal(issuedAt) {
+		t.Errorf("doc = %+v", doc)
+	}
+	// 返金した分を除いた金額と、その内消費税（切り捨て）
+	if doc.Subtotal != 1300 || doc.Refunded != 100 || doc.Amount != 1100 || doc.Tax != 100 {
+		t.Errorf("subtotal %d, refunded %d, amount %d, tax %d, want 1300, 100, 1100, 100", doc.Subtotal, doc.Refunded, doc.Amount, doc.Tax)
+	}
+	if len(doc.Lines) != 3 || strings.Join(doc.Lines[0].Modifiers, ",") != "アイス,L" {
+		t.Errorf("lines = %+v", doc.Lines)
+	}
+	if len(doc.Discounts) != 1 || doc.Discounts[0].Amount != 100 {
+		t.Errorf("discounts = %+v", doc.Discounts)
+	}
+	if len(doc.Payments) != 2 || doc.Payments[0].Label != "キャッシュレス" || doc.Payments[1].Label != "現金" {
+		t.Errorf("payments = %+v, want only the approved ones", doc.Payments)
+	}
+
+	// 8% は 1100 * 8 / 108 = 81.48 を切り捨て
+	cfg.TaxRate = 8
+	if doc := cfg.NewDocument(testOrder(), &models.IssuedReceipt{Number: 1}); doc.Tax != 81 {
+		t.Errorf("tax = %d, want 81", doc.Tax)
+	}
+	// 税率がなければ内訳を出さない
+	cfg.TaxRate = 0
+	if doc := cfg.NewDocument(testOrder(), &models.IssuedReceipt{Number: 1}); doc.Tax != 0 {
+		t.Errorf("tax = %d, want 0", doc.Tax)
+	}
+}
+
+func TestHonorific(t *testing.T) {
+	cfg := Config{Addressee: "上様"}
+	for addressee, want := range map[string]string{
+		"":          "上様",
+		"珈琲研究会":     "珈琲研究会 様",
+		"珈琲研究会 御中様": "珈琲研究会 御中様",
+	} {
+		doc := cfg.NewDocument(testOrder(), &models.IssuedReceipt{Addressee: addressee})
+		if doc.Addressee != want {
+			t.Errorf("addressee %q = %q, want %q", addressee, doc.Addressee, want)
+		}
+	}
+}
+
+func TestRender(t *testing.T) {
+	cfg := Config{ShopName: "珈琲・俺", Issuer: "珈琲・俺", Addressee: "上様", TaxNote: "税込", TaxRate: 10, Purpose: "飲食代として"}
+	doc := cfg.NewDocument(testOrder(), &models.IssuedReceipt{Number: 42, IssuedAt: time.Date(2025, 11, 1, 15, 30, 0, 0, time.UTC)})
+
+	var html bytes.Buffer
+	if err := RenderHTML(&html, doc); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"No.000042",
+		// 日本時間の日付
+		"発行日 2025年11月2日",
+		"￥1,100-",
+		"（内消費税等 10% ￥100）",
+		"アイス・L",
+	} {
+		if !strings.Contains(html.String(), want) {
+			t.Errorf("html does not contain %q", want)
+		}
+	}
+
+	var pdf bytes.Buffer
+	if err := RenderPDF(&pdf, doc); err != nil {
+		t.Fatal(err)
+	}
+	out := pdf.String()
+	if !strings.HasPrefix(out, "%PDF-1.4\n") || !strings.HasSuffix(out, "%%EOF\n") {
+		t.Errorf("pdf is not a complete PDF document")
+	}
+	if !strings.Contains(out, pdfHex("￥1,100-（税込）")) {
+		t.Error("pdf does not contain the amount")
+	}
+}
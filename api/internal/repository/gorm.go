@@ -0,0 +1,623 @@
+// api/internal/repository/gorm.go
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"cafeore-pos/api/internal/callscreen"
+	"cafeore-pos/api/internal/models"
+	"cafeore-pos/api/internal/pricing"
+	"cafeore-pos/api/internal/promotions"
+	"cafeore-pos/api/internal/queue"
+	"cafeore-pos/api/internal/receipt"
+	"cafeore-pos/api/internal/tracking"
+	"cafeore-pos/api/internal/vouchers"
+)
+
+// Postgres（GORM）のリポジトリ
+func NewGorm(db *gorm.DB) *Repositories {
+	return &Repositories{
+		Items:         &gormItems{db: db},
+		ItemTypes:     &gormItemTypes{db: db},
+		Orders:        &gormOrders{db: db},
+		Comments:      &gormComments{db: db},
+		Refunds:       &gormRefunds{db: db},
+		MasterStates:  &gormMasterStates{db: db},
+		Sessions:      &gormSessions{db: db},
+		CashMovements: &gormCashMovements{db: db},
+		CashCloseouts: &gormCashCloseouts{db: db},
+		Modifiers:     &gormModifiers{db: db},
+		Promotions:    &gormPromotions{db: db},
+		Vouchers:      &gormVouchers{db: db},
+		Drawers:       &gormDrawers{db: db},
+		PrintJobs:     &gormPrintJobs{db: db},
+		Receipts:      &gormReceipts{db: db},
+		Tx:            &gormTx{db: db},
+	}
+}
+
+type gormTx struct {
+	db *gorm.DB
+}
+
+func (t *gormTx) Transaction(ctx context.Context, fn func(repos *Repositories) error) error {
+	return t.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(NewGorm(tx))
+	})
+}
+
+// 削除済みのレコードも読み込む（過去のオーダーのアイテムなど）
+func unscoped(db *gorm.DB) *gorm.DB {
+	return db.Unscoped()
+}
+
+// gorm.ErrRecordNotFound を ErrNotFound にする
+func notFound(err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ErrNotFound
+	}
+	return err
+}
+
+// 削除した行がなければ ErrNotFound
+func deleted(result *gorm.DB) error {
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func scopeSession(db *gorm.DB, sessionID *uuid.UUID) *gorm.DB {
+	if sessionID == nil {
+		return db
+	}
+	return db.Where("session_id = ?", *sessionID)
+}
+
+type gormItems struct {
+	db *gorm.DB
+}
+
+func (r *gormItems) preload(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx).Preload("ItemType").Preload("Components.ComponentItem.ItemType")
+}
+
+func (r *gormItems) List(ctx context.Context) ([]models.Item, error) {
+	var items []models.Item
+	if err := r.preload(ctx).Find(&items).Error; err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func (r *gormItems) ListByIDs(ctx context.Context, ids []uuid.UUID) ([]models.Item, error) {
+	var items []models.Item
+	if err := r.preload(ctx).Where("id IN ?", ids).Find(&items).Error; err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func (r *gormItems) Get(ctx context.Context, id uuid.UUID) (*models.Item, error) {
+	var item models.Item
+	if err := r.preload(ctx).First(&item, "id = ?", id).Error; err != nil {
+		return nil, notFound(err)
+	}
+	return &item, nil
+}
+
+func (r *gormItems) Create(ctx context.Context, item *models.Item, components []models.BundleComponent) error {
+	available := item.Available
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Create(item).Error; err != nil {
+			return err
+		}
+		// available はDBの既定値（true）で作られるので、false の場合は後から更新する
+		if !available {
+			if err := tx.Model(item).Update("available", false).Error; err != nil {
+				return err
+			}
+		}
+		return replaceComponents(tx, item.ID, components)
+	})
+	if err != nil {
+		return err
+	}
+	return r.reload(ctx, item)
+}
+
+func (r *gormItems) Update(ctx context.Context, item *models.Item, components *[]models.BundleComponent) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Save(item).Error; err != nil {
+			return err
+		}
+		if components != nil {
+			return replaceComponents(tx, item.ID, *components)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return r.reload(ctx, item)
+}
+
+// 関連データをロードし直す
+func (r *gormItems) reload(ctx context.Context, item *models.Item) error {
+	loaded, err := r.Get(ctx, item.ID)
+	if err != nil {
+		return err
+	}
+	*item = *loaded
+	return nil
+}
+
+// セット商品の構成アイテムを置き換える
+func replaceComponents(tx *gorm.DB, itemID uuid.UUID, components []models.BundleComponent) error {
+	if err := tx.Where("bundle_item_id = ?", itemID).Delete(&models.BundleComponent{}).Error; err != nil {
+		return err
+	}
+	if len(components) == 0 {
+		return nil
+	}
+	for i := range components {
+		components[i].BundleItemID = itemID
+	}
+	return tx.Omit(clause.Associations).Create(&components).Error
+}
+
+func (r *gormItems) Delete(ctx context.Context, id uuid.UUID) error {
+	return deleted(r.db.WithContext(ctx).Delete(&models.Item{}, "id = ?", id))
+}
+
+func (r *gormItems) IsComponent(ctx context.Context, id uuid.UUID) (bool, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&models.BundleComponent{}).
+		Where("component_item_id = ?", id).
+		Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (r *gormItems) EffectivePrices(ctx context.Context, ids []uuid.UUID, at time.Time) (map[uuid.UUID]int, error) {
+	return pricing.EffectivePrices(r.db.WithContext(ctx), ids, at)
+}
+
+func (r *gormItems) RecordPrice(ctx context.Context, itemID uuid.UUID, price int, effectiveFrom time.Time, reason string) (*models.ItemPrice, error) {
+	return pricing.Record(r.db.WithContext(ctx), itemID, price, effectiveFrom, reason)
+}
+
+func (r *gormItems) ListPrices(ctx context.Context, itemID uuid.UUID) ([]models.ItemPrice, error) {
+	var prices []models.ItemPrice
+	if err := r.db.WithContext(ctx).Where("item_id = ?", itemID).Order("effective_from, created_at").Find(&prices).Error; err != nil {
+		return nil, err
+	}
+	return prices, nil
+}
+
+func (r *gormItems) GetPrice(ctx context.Context, itemID, priceID uuid.UUID) (*models.ItemPrice, error) {
+	var price models.ItemPrice
+	if err := r.db.WithContext(ctx).First(&price, "id = ? AND item_id = ?", priceID, itemID).Error; err != nil {
+		return nil, notFound(err)
+	}
+	return &price, nil
+}
+
+func (r *gormItems) DeletePrice(ctx context.Context, priceID uuid.UUID) error {
+	return deleted(r.db.WithContext(ctx).Delete(&models.ItemPrice{}, "id = ?", priceID))
+}
+
+type gormItemTypes struct {
+	db *gorm.DB
+}
+
+func (r *gormItemTypes) List(ctx context.Context) ([]models.ItemType, error) {
+	var itemTypes []models.ItemType
+	if err := r.db.WithContext(ctx).Order("name").Find(&itemTypes).Error; err != nil {
+		return nil, err
+	}
+	return itemTypes, nil
+}
+
+func (r *gormItemTypes) Get(ctx context.Context, id uuid.UUID) (*models.ItemType, error) {
+	var itemType models.ItemType
+	if err := r.db.WithContext(ctx).First(&itemType, "id = ?", id).Error; err != nil {
+		return nil, notFound(err)
+	}
+	return &itemType, nil
+}
+
+func (r *gormItemTypes) Create(ctx context.Context, itemType *models.ItemType) error {
+	return r.db.WithContext(ctx).Create(itemType).Error
+}
+
+func (r *gormItemTypes) Update(ctx context.Context, itemType *models.ItemType) error {
+	return r.db.WithContext(ctx).Save(itemType).Error
+}
+
+func (r *gormItemTypes) Delete(ctx context.Context, id uuid.UUID) error {
+	return deleted(r.db.WithContext(ctx).Delete(&models.ItemType{}, "id = ?", id))
+}
+
+type gormOrders struct {
+	db *gorm.DB
+}
+
+func (r *gormOrders) preload(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx).
+		Preload("OrderItems.Item", unscoped).
+		Preload("OrderItems.Item.ItemType", unscoped).
+		Preload("Comments").
+		Preload("Refunds.Items").
+		Preload("Payments")
+}
+
+func (r *gormOrders) List(ctx context.Context, sessionID *uuid.UUID) ([]models.Order, error) {
+	var orders []models.Order
+	if err := scopeSession(r.preload(ctx), sessionID).Find(&orders).Error; err != nil {
+		return nil, err
+	}
+	return orders, nil
+}
+
+func (r *gormOrders) Get(ctx context.Context, id uuid.UUID) (*models.Order, error) {
+	var order models.Order
+	if err := r.preload(ctx).First(&order, "id = ?", id).Error; err != nil {
+		return nil, notFound(err)
+	}
+	return &order, nil
+}
+
+func (r *gormOrders) Lock(ctx context.Context, id uuid.UUID) (*models.Order, error) {
+	// ロックはオーダーの行だけにかけ、関連データはロックせずに読み込む
+	if err := r.db.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).First(&models.Order{}, "id = ?", id).Error; err != nil {
+		return nil, notFound(err)
+	}
+	return r.Get(ctx, id)
+}
+
+func (r *gormOrders) GetByNumber(ctx context.Context, sessionID uuid.UUID, number int) (*models.Order, error) {
+	var order models.Order
+	if err := r.preload(ctx).Where("session_id = ? AND order_id = ?", sessionID, number).First(&order).Error; err != nil {
+		return nil, notFound(err)
+	}
+	return &order, nil
+}
+
+func (r *gormOrders) FindByTracking(ctx context.Context, number int, token string) (*models.Order, error) {
+	var order models.Order
+	if err := r.preload(ctx).Where("order_id = ? AND tracking_token = ?", number, token).Order("created_at DESC").First(&order).Error; err != nil {
+		return nil, notFound(err)
+	}
+	return &order, nil
+}
+
+func (r *gormOrders) NextNumber(ctx context.Context, sessionID uuid.UUID) (int, error) {
+	var maxOrderID int
+	if err := r.db.WithContext(ctx).Model(&models.Order{}).
+		Where("session_id = ?", sessionID).
+		Select("COALESCE(MAX(order_id), 0)").
+		Scan(&maxOrderID).Error; err != nil {
+		return 0, err
+	}
+	return maxOrderID + 1, nil
+}
+
+func (r *gormOrders) DiscountUsed(ctx context.Context, sessionID uuid.UUID, discountOrderID int) (bool, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&models.Order{}).
+		Where("session_id = ? AND discount_order_id = ?", sessionID, discountOrderID).
+		Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (r *gormOrders) QueuePosition(ctx context.Context, order *models.Order) (int, error) {
+	return queue.Position(r.db.WithContext(ctx), order)
+}
+
+func (r *gormOrders) Queue(ctx context.Context, sessionID *uuid.UUID) (queue.Orders, error) {
+	return queue.Load(r.db.WithContext(ctx), sessionID)
+}
+
+func (r *gormOrders) Tracking(ctx context.Context, order *models.Order, cfg tracking.Config) (tracking.Progress, error) {
+	return cfg.Load(r.db.WithContext(ctx), order)
+}
+
+func (r *gormOrders) Callscreen(ctx context.Context, sessionID *uuid.UUID, cfg callscreen.Config) (callscreen.Orders, error) {
+	return callscreen.Load(r.db.WithContext(ctx), sessionID, cfg)
+}
+
+func (r *gormOrders) Create(ctx context.Context, order *models.Order) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// コメント・支払いはオーダーと一緒に作り、アイテムは注文時点の内容だけを保存する
+		orderItems := order.OrderItems
+		if err := tx.Omit("OrderItems").Create(order).Error; err != nil {
+			return err
+		}
+		for i := range orderItems {
+			orderItems[i].OrderID = order.ID
+		}
+		if len(orderItems) > 0 {
+			if err := tx.Omit(clause.Associations).Create(&orderItems).Error; err != nil {
+				return err
+			}
+		}
+		order.OrderItems = orderItems
+		return nil
+	})
+}
+
+func (r *gormOrders) SaveProgress(ctx context.Context, order *models.Order) error {
+	return r.db.WithContext(ctx).Model(order).
+		Omit(clause.Associations).
+		Select("ready_at", "served_at", "called_at", "last_called_at", "recall_count").
+		Updates(order).Error
+}
+
+func (r *gormOrders) Delete(ctx context.Context, id uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 消すオーダーに使われたクーポンは使われていないことにする
+		if err := tx.Model(&models.Voucher{}).Where("redeemed_order_id = ?", id).Updates(map[string]interface{}{
+			"redeemed_order_id": nil,
+			"redeemed_at":       nil,
+		}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("order_id = ?", id).Delete(&models.OrderItem{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("order_id = ?", id).Delete(&models.Comment{}).Error; err != nil {
+			return err
+		}
+		return deleted(tx.Delete(&models.Order{}, "id = ?", id))
+	})
+}
+
+type gormRefunds struct {
+	db *gorm.DB
+}
+
+func (r *gormRefunds) List(ctx context.Context, sessionID *uuid.UUID) ([]models.Refund, error) {
+	var refunds []models.Refund
+	if err := scopeSession(r.db.WithContext(ctx), sessionID).Preload("Items").Order("created_at").Find(&refunds).Error; err != nil {
+		return nil, err
+	}
+	return refunds, nil
+}
+
+func (r *gormRefunds) ListByOrder(ctx context.Context, orderID uuid.UUID) ([]models.Refund, error) {
+	var refunds []models.Refund
+	if err := r.db.WithContext(ctx).Preload("Items").Where("order_id = ?", orderID).Order("created_at").Find(&refunds).Error; err != nil {
+		return nil, err
+	}
+	return refunds, nil
+}
+
+func (r *gormRefunds) Create(ctx context.Context, refund *models.Refund) error {
+	return r.db.WithContext(ctx).Create(refund).Error
+}
+
+type gormComments struct {
+	db *gorm.DB
+}
+
+func (r *gormComments) ListByOrder(ctx context.Context, orderID uuid.UUID) ([]models.Comment, error) {
+	var comments []models.Comment
+	if err := r.db.WithContext(ctx).Where("order_id = ?", orderID).Order("created_at DESC").Find(&comments).Error; err != nil {
+		return nil, err
+	}
+	return comments, nil
+}
+
+func (r *gormComments) Create(ctx context.Context, comment *models.Comment) error {
+	return r.db.WithContext(ctx).Create(comment).Error
+}
+
+type gormMasterStates struct {
+	db *gorm.DB
+}
+
+func (r *gormMasterStates) List(ctx context.Context, sessionID *uuid.UUID) ([]models.MasterState, error) {
+	var states []models.MasterState
+	if err := scopeSession(r.db.WithContext(ctx), sessionID).Order("created_at").Find(&states).Error; err != nil {
+		return nil, err
+	}
+	return states, nil
+}
+
+func (r *gormMasterStates) Latest(ctx context.Context, sessionID *uuid.UUID) (*models.MasterState, error) {
+	var state models.MasterState
+	if err := scopeSession(r.db.WithContext(ctx), sessionID).Order("created_at DESC").First(&state).Error; err != nil {
+		return nil, notFound(err)
+	}
+	return &state, nil
+}
+
+func (r *gormMasterStates) Create(ctx context.Context, state *models.MasterState) error {
+	return r.db.WithContext(ctx).Create(state).Error
+}
+
+type gormSessions struct {
+	db *gorm.DB
+}
+
+func (r *gormSessions) List(ctx context.Context) ([]models.Session, error) {
+	var sessions []models.Session
+	if err := r.db.WithContext(ctx).Order("opened_at DESC").Find(&sessions).Error; err != nil {
+		return nil, err
+	}
+	return sessions, nil
+}
+
+func (r *gormSessions) Get(ctx context.Context, id uuid.UUID) (*models.Session, error) {
+	var session models.Session
+	if err := r.db.WithContext(ctx).First(&session, "id = ?", id).Error; err != nil {
+		return nil, notFound(err)
+	}
+	return &session, nil
+}
+
+func (r *gormSessions) Open(ctx context.Context) (*models.Session, error) {
+	var session models.Session
+	if err := r.db.WithContext(ctx).Where("closed_at IS NULL").Order("opened_at DESC").First(&session).Error; err != nil {
+		return nil, notFound(err)
+	}
+	return &session, nil
+}
+
+func (r *gormSessions) Latest(ctx context.Context) (*models.Session, error) {
+	var session models.Session
+	if err := r.db.WithContext(ctx).Order("closed_at IS NOT NULL").Order("opened_at DESC").First(&session).Error; err != nil {
+		return nil, notFound(err)
+	}
+	return &session, nil
+}
+
+func (r *gormSessions) Create(ctx context.Context, session *models.Session) error {
+	return r.db.WithContext(ctx).Create(session).Error
+}
+
+func (r *gormSessions) Update(ctx context.Context, session *models.Session) error {
+	return r.db.WithContext(ctx).Save(session).Error
+}
+
+func (r *gormSessions) Lock(ctx context.Context, id uuid.UUID) error {
+	return notFound(r.db.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).First(&models.Session{}, "id = ?", id).Error)
+}
+
+type gormCashMovements struct {
+	db *gorm.DB
+}
+
+func (r *gormCashMovements) Create(ctx context.Context, movement *models.CashMovement) error {
+	return r.db.WithContext(ctx).Create(movement).Error
+}
+
+type gormCashCloseouts struct {
+	db *gorm.DB
+}
+
+func (r *gormCashCloseouts) Exists(ctx context.Context, sessionID uuid.UUID, register string) (bool, error) {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&models.CashCloseout{}).
+		Where("session_id = ? AND register = ?", sessionID, register).
+		Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (r *gormCashCloseouts) Create(ctx context.Context, closeout *models.CashCloseout) error {
+	return r.db.WithContext(ctx).Create(closeout).Error
+}
+
+type gormModifiers struct {
+	db *gorm.DB
+}
+
+func (r *gormModifiers) GroupsFor(ctx context.Context, items []models.Item) (map[uuid.UUID][]models.ModifierGroup, error) {
+	result := map[uuid.UUID][]models.ModifierGroup{}
+	if len(items) == 0 {
+		return result, nil
+	}
+	itemIDs := make([]uuid.UUID, len(items))
+	itemTypeIDs := make([]uuid.UUID, len(items))
+	for i, item := range items {
+		itemIDs[i] = item.ID
+		itemTypeIDs[i] = item.ItemTypeID
+	}
+
+	var groups []models.ModifierGroup
+	if err := r.db.WithContext(ctx).
+		Preload("Modifiers", func(db *gorm.DB) *gorm.DB { return db.Order("name") }).
+		Where("item_id IN ? OR item_type_id IN ?", itemIDs, itemTypeIDs).
+		Order("name").
+		Find(&groups).Error; err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		for _, g := range groups {
+			if g.AppliesTo(&item) {
+				result[item.ID] = append(result[item.ID], g)
+			}
+		}
+	}
+	return result, nil
+}
+
+type gormPromotions struct {
+	db *gorm.DB
+}
+
+func (r *gormPromotions) ListActive(ctx context.Context) ([]models.Promotion, error) {
+	return promotions.Load(r.db.WithContext(ctx))
+}
+
+type gormVouchers struct {
+	db *gorm.DB
+}
+
+func (r *gormVouchers) FindByCode(ctx context.Context, code string) (*models.Voucher, error) {
+	return vouchers.Find(r.db.WithContext(ctx), code)
+}
+
+func (r *gormVouchers) Redeem(ctx context.Context, voucher *models.Voucher, order *models.Order) error {
+	return vouchers.Redeem(r.db.WithContext(ctx), voucher, order)
+}
+
+type gormDrawers struct {
+	db *gorm.DB
+}
+
+func (r *gormDrawers) query(db *gorm.DB, sessionID uuid.UUID, register string) ([]models.DrawerStock, error) {
+	var stocks []models.DrawerStock
+	if err := db.Where("session_id = ? AND register = ?", sessionID, register).
+		Order("denomination DESC").
+		Find(&stocks).Error; err != nil {
+		return nil, err
+	}
+	return stocks, nil
+}
+
+func (r *gormDrawers) List(ctx context.Context, sessionID uuid.UUID, register string) ([]models.DrawerStock, error) {
+	return r.query(r.db.WithContext(ctx), sessionID, register)
+}
+
+func (r *gormDrawers) Lock(ctx context.Context, sessionID uuid.UUID, register string) ([]models.DrawerStock, error) {
+	return r.query(r.db.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}), sessionID, register)
+}
+
+func (r *gormDrawers) Save(ctx context.Context, stocks []models.DrawerStock) error {
+	if len(stocks) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).Save(&stocks).Error
+}
+
+type gormReceipts struct {
+	db *gorm.DB
+}
+
+func (r *gormReceipts) Issue(ctx context.Context, orderID uuid.UUID, addressee string, now time.Time) (*models.IssuedReceipt, error) {
+	return receipt.Issue(r.db.WithContext(ctx), orderID, addressee, now)
+}
+
+type gormPrintJobs struct {
+	db *gorm.DB
+}
+
+func (r *gormPrintJobs) Create(ctx context.Context, job *models.PrintJob) error {
+	return r.db.WithContext(ctx).Create(job).Error
+}
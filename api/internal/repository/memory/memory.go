@@ -0,0 +1,1171 @@
+// api/internal/repository/memory/memory.go
+//
+// メモリ上のリポジトリ（テスト用、Postgres なしでサービス・ハンドラーを動かす）
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"cafeore-pos/api/internal/callscreen"
+	"cafeore-pos/api/internal/models"
+	"cafeore-pos/api/internal/pricing"
+	"cafeore-pos/api/internal/queue"
+	"cafeore-pos/api/internal/repository"
+	"cafeore-pos/api/internal/tracking"
+	"cafeore-pos/api/internal/vouchers"
+)
+
+// メモリ上のデータ
+// 関連データは持たずに保存し、読み込むときに Postgres の実装と同じようにロードする
+type Store struct {
+	mu sync.Mutex
+
+	itemTypes    []models.ItemType
+	items        []models.Item
+	components   []models.BundleComponent
+	prices       []models.ItemPrice
+	orders       []models.Order
+	comments     []models.Comment
+	masterStates []models.MasterState
+	sessions     []models.Session
+	movements    []models.CashMovement
+	closeouts    []models.CashCloseout
+	groups       []models.ModifierGroup
+	promotions   []models.Promotion
+	vouchers     []models.Voucher
+	drawer       []models.DrawerStock
+	printJobs    []models.PrintJob
+	receipts     []models.IssuedReceipt
+	// 最後に使った領収書番号（Postgres のシーケンスと同じくロールバックしても戻さない）
+	receiptNumber int
+}
+
+func New() *Store {
+	return &Store{}
+}
+
+// Store を使うリポジトリ一式
+func (s *Store) Repositories() *repository.Repositories {
+	return &repository.Repositories{
+		Items:         &items{s},
+		ItemTypes:     &itemTypes{s},
+		Orders:        &orders{s},
+		Comments:      &comments{s},
+		Refunds:       &refunds{s},
+		MasterStates:  &masterStates{s},
+		Sessions:      &sessions{s},
+		CashMovements: &cashMovements{s},
+		CashCloseouts: &cashCloseouts{s},
+		Modifiers:     &modifiers{s},
+		Promotions:    &promotionRules{s},
+		Vouchers:      &voucherCodes{s},
+		Drawers:       &drawers{s},
+		PrintJobs:     &printJobs{s},
+		Receipts:      &receipts{s},
+		Tx:            &transactor{s},
+	}
+}
+
+// 入出金の記録（テストの確認用）
+func (s *Store) CashMovements() []models.CashMovement {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]models.CashMovement(nil), s.movements...)
+}
+
+// オーダーを追加する（テストのデータ用、コメントは保存しない）
+func (s *Store) AddOrder(order models.Order) models.Order {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	order.Comments = nil
+	s.addOrder(&order)
+	return s.loadOrder(order)
+}
+
+// クーポンを追加する（テストのデータ用、Batch も一緒に保存する）
+func (s *Store) AddVoucher(voucher models.Voucher) models.Voucher {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if voucher.ID == uuid.Nil {
+		voucher.ID = uuid.New()
+	}
+	if voucher.Batch.ID == uuid.Nil {
+		voucher.Batch.ID = uuid.New()
+	}
+	voucher.BatchID = voucher.Batch.ID
+	s.vouchers = append(s.vouchers, voucher)
+	return voucher
+}
+
+// 印刷ジョブ（テストの確認用）
+func (s *Store) PrintJobs() []models.PrintJob {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]models.PrintJob(nil), s.printJobs...)
+}
+
+func deletedAt(t time.Time) gorm.DeletedAt {
+	return gorm.DeletedAt{Time: t, Valid: true}
+}
+
+// 以下は s.mu を取った状態で呼ぶ
+
+// オーダーを保存する（ID がなければ付ける）
+// OrderItems の Item は保存せず、読み込むときにアイテムからロードする
+func (s *Store) addOrder(order *models.Order) {
+	if order.ID == uuid.Nil {
+		order.ID = uuid.New()
+	}
+	for i := range order.OrderItems {
+		if order.OrderItems[i].ID == uuid.Nil {
+			order.OrderItems[i].ID = uuid.New()
+		}
+		order.OrderItems[i].OrderID = order.ID
+	}
+	for i := range order.Comments {
+		order.Comments[i].OrderID = order.ID
+		s.comments = append(s.comments, order.Comments[i])
+	}
+	for i := range order.Payments {
+		if order.Payments[i].ID == uuid.Nil {
+			order.Payments[i].ID = uuid.New()
+		}
+		order.Payments[i].OrderID = order.ID
+	}
+
+	stored := *order
+	stored.Comments = nil
+	stored.OrderItems = make([]models.OrderItem, len(order.OrderItems))
+	for i, oi := range order.OrderItems {
+		oi.Item = models.Item{}
+		stored.OrderItems[i] = oi
+	}
+	stored.Payments = append([]models.Payment(nil), order.Payments...)
+	s.orders = append(s.orders, stored)
+}
+
+func (s *Store) findItemType(id uuid.UUID) int {
+	for i, t := range s.itemTypes {
+		if t.ID == id && !t.Deleted.Valid {
+			return i
+		}
+	}
+	return -1
+}
+
+func (s *Store) findItem(id uuid.UUID) int {
+	for i, item := range s.items {
+		if item.ID == id && !item.Deleted.Valid {
+			return i
+		}
+	}
+	return -1
+}
+
+func (s *Store) findOrder(id uuid.UUID) int {
+	for i, o := range s.orders {
+		if o.ID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// アイテムの種別と構成アイテムをロードする
+func (s *Store) loadItem(item models.Item) models.Item {
+	item.ItemType = models.ItemType{}
+	if i := s.findItemType(item.ItemTypeID); i >= 0 {
+		item.ItemType = s.itemTypes[i]
+	}
+	item.Components = nil
+	for _, c := range s.components {
+		if c.BundleItemID != item.ID {
+			continue
+		}
+		c.ComponentItem = models.Item{}
+		if i := s.findItem(c.ComponentItemID); i >= 0 {
+			c.ComponentItem = s.items[i]
+			if t := s.findItemType(c.ComponentItem.ItemTypeID); t >= 0 {
+				c.ComponentItem.ItemType = s.itemTypes[t]
+			}
+		}
+		item.Components = append(item.Components, c)
+	}
+	return item
+}
+
+// オーダーのアイテム（削除済みのものも）とコメントをロードする（返金・支払いはオーダーに持たせたまま）
+func (s *Store) loadOrder(order models.Order) models.Order {
+	orderItems := make([]models.OrderItem, len(order.OrderItems))
+	for i, oi := range order.OrderItems {
+		for _, item := range s.items {
+			if item.ID != oi.ItemID {
+				continue
+			}
+			for _, t := range s.itemTypes {
+				if t.ID == item.ItemTypeID {
+					item.ItemType = t
+				}
+			}
+			oi.Item = item
+		}
+		orderItems[i] = oi
+	}
+	order.OrderItems = orderItems
+	order.Comments = nil
+	for _, c := range s.comments {
+		if c.OrderID == order.ID {
+			order.Comments = append(order.Comments, c)
+		}
+	}
+	return order
+}
+
+func inSession(sessionID *uuid.UUID, target *uuid.UUID) bool {
+	return sessionID == nil || (target != nil && *target == *sessionID)
+}
+
+type items struct {
+	s *Store
+}
+
+func (r *items) List(ctx context.Context) ([]models.Item, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	result := []models.Item{}
+	for _, item := range r.s.items {
+		if !item.Deleted.Valid {
+			result = append(result, r.s.loadItem(item))
+		}
+	}
+	return result, nil
+}
+
+func (r *items) ListByIDs(ctx context.Context, ids []uuid.UUID) ([]models.Item, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	wanted := map[uuid.UUID]bool{}
+	for _, id := range ids {
+		wanted[id] = true
+	}
+	result := []models.Item{}
+	for _, item := range r.s.items {
+		if wanted[item.ID] && !item.Deleted.Valid {
+			result = append(result, r.s.loadItem(item))
+		}
+	}
+	return result, nil
+}
+
+func (r *items) Get(ctx context.Context, id uuid.UUID) (*models.Item, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	i := r.s.findItem(id)
+	if i < 0 {
+		return nil, repository.ErrNotFound
+	}
+	item := r.s.loadItem(r.s.items[i])
+	return &item, nil
+}
+
+func (r *items) Create(ctx context.Context, item *models.Item, components []models.BundleComponent) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	if item.ID == uuid.Nil {
+		item.ID = uuid.New()
+	}
+	stored := *item
+	stored.ItemType = models.ItemType{}
+	stored.Components = nil
+	r.s.items = append(r.s.items, stored)
+	r.s.replaceComponents(item.ID, components)
+	*item = r.s.loadItem(stored)
+	return nil
+}
+
+func (r *items) Update(ctx context.Context, item *models.Item, components *[]models.BundleComponent) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	i := r.s.findItem(item.ID)
+	if i < 0 {
+		return repository.ErrNotFound
+	}
+	stored := *item
+	stored.ItemType = models.ItemType{}
+	stored.Components = nil
+	r.s.items[i] = stored
+	if components != nil {
+		r.s.replaceComponents(item.ID, *components)
+	}
+	*item = r.s.loadItem(stored)
+	return nil
+}
+
+func (s *Store) replaceComponents(itemID uuid.UUID, components []models.BundleComponent) {
+	kept := s.components[:0]
+	for _, c := range s.components {
+		if c.BundleItemID != itemID {
+			kept = append(kept, c)
+		}
+	}
+	s.components = kept
+	for _, c := range components {
+		if c.ID == uuid.Nil {
+			c.ID = uuid.New()
+		}
+		c.BundleItemID = itemID
+		c.ComponentItem = models.Item{}
+		s.components = append(s.components, c)
+	}
+}
+
+func (r *items) Delete(ctx context.Context, id uuid.UUID) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	i := r.s.findItem(id)
+	if i < 0 {
+		return repository.ErrNotFound
+	}
+	r.s.items[i].Deleted = deletedAt(time.Now())
+	return nil
+}
+
+func (r *items) IsComponent(ctx context.Context, id uuid.UUID) (bool, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	for _, c := range r.s.components {
+		if c.ComponentItemID == id {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *items) EffectivePrices(ctx context.Context, ids []uuid.UUID, at time.Time) (map[uuid.UUID]int, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	wanted := map[uuid.UUID]bool{}
+	for _, id := range ids {
+		wanted[id] = true
+	}
+	var history []models.ItemPrice
+	for _, p := range r.s.prices {
+		if wanted[p.ItemID] {
+			history = append(history, p)
+		}
+	}
+	return pricing.Select(history, at), nil
+}
+
+func (r *items) RecordPrice(ctx context.Context, itemID uuid.UUID, price int, effectiveFrom time.Time, reason string) (*models.ItemPrice, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	record := models.ItemPrice{
+		ID:            uuid.New(),
+		ItemID:        itemID,
+		Price:         price,
+		EffectiveFrom: effectiveFrom,
+		Reason:        reason,
+		CreatedAt:     time.Now(),
+	}
+	r.s.prices = append(r.s.prices, record)
+	return &record, nil
+}
+
+func (r *items) ListPrices(ctx context.Context, itemID uuid.UUID) ([]models.ItemPrice, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	result := []models.ItemPrice{}
+	for _, p := range r.s.prices {
+		if p.ItemID == itemID {
+			result = append(result, p)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		if !result[i].EffectiveFrom.Equal(result[j].EffectiveFrom) {
+			return result[i].EffectiveFrom.Before(result[j].EffectiveFrom)
+		}
+		return result[i].CreatedAt.Before(result[j].CreatedAt)
+	})
+	return result, nil
+}
+
+func (r *items) GetPrice(ctx context.Context, itemID, priceID uuid.UUID) (*models.ItemPrice, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	for _, p := range r.s.prices {
+		if p.ID == priceID && p.ItemID == itemID {
+			return &p, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (r *items) DeletePrice(ctx context.Context, priceID uuid.UUID) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	for i, p := range r.s.prices {
+		if p.ID == priceID {
+			r.s.prices = append(r.s.prices[:i], r.s.prices[i+1:]...)
+			return nil
+		}
+	}
+	return repository.ErrNotFound
+}
+
+type itemTypes struct {
+	s *Store
+}
+
+func (r *itemTypes) List(ctx context.Context) ([]models.ItemType, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	result := []models.ItemType{}
+	for _, t := range r.s.itemTypes {
+		if !t.Deleted.Valid {
+			result = append(result, t)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result, nil
+}
+
+func (r *itemTypes) Get(ctx context.Context, id uuid.UUID) (*models.ItemType, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	i := r.s.findItemType(id)
+	if i < 0 {
+		return nil, repository.ErrNotFound
+	}
+	itemType := r.s.itemTypes[i]
+	return &itemType, nil
+}
+
+func (r *itemTypes) Create(ctx context.Context, itemType *models.ItemType) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	if itemType.ID == uuid.Nil {
+		itemType.ID = uuid.New()
+	}
+	r.s.itemTypes = append(r.s.itemTypes, *itemType)
+	return nil
+}
+
+func (r *itemTypes) Update(ctx context.Context, itemType *models.ItemType) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	i := r.s.findItemType(itemType.ID)
+	if i < 0 {
+		return repository.ErrNotFound
+	}
+	r.s.itemTypes[i] = *itemType
+	return nil
+}
+
+func (r *itemTypes) Delete(ctx context.Context, id uuid.UUID) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	i := r.s.findItemType(id)
+	if i < 0 {
+		return repository.ErrNotFound
+	}
+	r.s.itemTypes[i].Deleted = deletedAt(time.Now())
+	return nil
+}
+
+type orders struct {
+	s *Store
+}
+
+func (r *orders) List(ctx context.Context, sessionID *uuid.UUID) ([]models.Order, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	result := []models.Order{}
+	for _, o := range r.s.orders {
+		if inSession(sessionID, o.SessionID) {
+			result = append(result, r.s.loadOrder(o))
+		}
+	}
+	return result, nil
+}
+
+func (r *orders) Get(ctx context.Context, id uuid.UUID) (*models.Order, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	i := r.s.findOrder(id)
+	if i < 0 {
+		return nil, repository.ErrNotFound
+	}
+	order := r.s.loadOrder(r.s.orders[i])
+	return &order, nil
+}
+
+// ロックはしない（テストでは同時に書き込まない）
+func (r *orders) Lock(ctx context.Context, id uuid.UUID) (*models.Order, error) {
+	return r.Get(ctx, id)
+}
+
+func (r *orders) GetByNumber(ctx context.Context, sessionID uuid.UUID, number int) (*models.Order, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	for _, o := range r.s.orders {
+		if inSession(&sessionID, o.SessionID) && o.OrderId == number {
+			order := r.s.loadOrder(o)
+			return &order, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (r *orders) FindByTracking(ctx context.Context, number int, token string) (*models.Order, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	var found *models.Order
+	for i, o := range r.s.orders {
+		if o.OrderId == number && o.TrackingToken == token && (found == nil || o.CreatedAt.After(found.CreatedAt)) {
+			found = &r.s.orders[i]
+		}
+	}
+	if found == nil {
+		return nil, repository.ErrNotFound
+	}
+	order := r.s.loadOrder(*found)
+	return &order, nil
+}
+
+func (r *orders) NextNumber(ctx context.Context, sessionID uuid.UUID) (int, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	last := 0
+	for _, o := range r.s.orders {
+		if inSession(&sessionID, o.SessionID) && o.OrderId > last {
+			last = o.OrderId
+		}
+	}
+	return last + 1, nil
+}
+
+func (r *orders) DiscountUsed(ctx context.Context, sessionID uuid.UUID, discountOrderID int) (bool, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	for _, o := range r.s.orders {
+		if inSession(&sessionID, o.SessionID) && o.DiscountOrderId == discountOrderID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *orders) QueuePosition(ctx context.Context, order *models.Order) (int, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	position := 1
+	for _, o := range r.s.orders {
+		if o.ServedAt != nil || !inSession(order.SessionID, o.SessionID) {
+			continue
+		}
+		if o.CreatedAt.Before(order.CreatedAt) || (o.CreatedAt.Equal(order.CreatedAt) && o.OrderId < order.OrderId) {
+			position++
+		}
+	}
+	return position, nil
+}
+
+func (r *orders) Queue(ctx context.Context, sessionID *uuid.UUID) (queue.Orders, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	result := queue.Orders{DisplayNames: queue.DisplayNames(r.s.itemTypes)}
+	for _, o := range r.s.orders {
+		if o.ServedAt == nil && inSession(sessionID, o.SessionID) {
+			result.Unserved = append(result.Unserved, r.s.loadOrder(o))
+		}
+	}
+	sort.Slice(result.Unserved, func(i, j int) bool {
+		a, b := result.Unserved[i], result.Unserved[j]
+		return a.CreatedAt.Before(b.CreatedAt) || (a.CreatedAt.Equal(b.CreatedAt) && a.OrderId < b.OrderId)
+	})
+	return result, nil
+}
+
+func (r *orders) Tracking(ctx context.Context, order *models.Order, cfg tracking.Config) (tracking.Progress, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	var progress tracking.Progress
+	for _, o := range r.s.orders {
+		if !inSession(order.SessionID, o.SessionID) {
+			continue
+		}
+		if o.ReadyAt != nil {
+			progress.RecentReadyAt = append(progress.RecentReadyAt, *o.ReadyAt)
+			continue
+		}
+		if o.ServedAt == nil && (o.CreatedAt.Before(order.CreatedAt) || (o.CreatedAt.Equal(order.CreatedAt) && o.OrderId < order.OrderId)) {
+			progress.Ahead++
+		}
+	}
+	sort.Slice(progress.RecentReadyAt, func(i, j int) bool { return progress.RecentReadyAt[i].After(progress.RecentReadyAt[j]) })
+	if len(progress.RecentReadyAt) > cfg.PaceSamples+1 {
+		progress.RecentReadyAt = progress.RecentReadyAt[:cfg.PaceSamples+1]
+	}
+	return progress, nil
+}
+
+func (r *orders) Callscreen(ctx context.Context, sessionID *uuid.UUID, cfg callscreen.Config) (callscreen.Orders, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	var result callscreen.Orders
+	for _, o := range r.s.orders {
+		if !inSession(sessionID, o.SessionID) {
+			continue
+		}
+		switch {
+		case o.ServedAt != nil:
+			result.Served = append(result.Served, o)
+		case o.CalledAt != nil:
+			result.Called = append(result.Called, o)
+		default:
+			result.NextUp = append(result.NextUp, o)
+		}
+	}
+	sort.Slice(result.Called, func(i, j int) bool {
+		a, b := result.Called[i], result.Called[j]
+		return a.CalledAt.Before(*b.CalledAt) || (a.CalledAt.Equal(*b.CalledAt) && a.OrderId < b.OrderId)
+	})
+	sort.Slice(result.Served, func(i, j int) bool { return result.Served[i].ServedAt.After(*result.Served[j].ServedAt) })
+	sort.Slice(result.NextUp, func(i, j int) bool {
+		a, b := result.NextUp[i], result.NextUp[j]
+		return a.CreatedAt.Before(b.CreatedAt) || (a.CreatedAt.Equal(b.CreatedAt) && a.OrderId < b.OrderId)
+	})
+	if len(result.Served) > cfg.RecentLimit {
+		result.Served = result.Served[:cfg.RecentLimit]
+	}
+	if len(result.NextUp) > cfg.NextUpLimit {
+		result.NextUp = result.NextUp[:cfg.NextUpLimit]
+	}
+	return result, nil
+}
+
+func (r *orders) Create(ctx context.Context, order *models.Order) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	r.s.addOrder(order)
+	return nil
+}
+
+func (r *orders) SaveProgress(ctx context.Context, order *models.Order) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	i := r.s.findOrder(order.ID)
+	if i < 0 {
+		return repository.ErrNotFound
+	}
+	stored := &r.s.orders[i]
+	stored.ReadyAt = order.ReadyAt
+	stored.ServedAt = order.ServedAt
+	stored.CalledAt = order.CalledAt
+	stored.LastCalledAt = order.LastCalledAt
+	stored.RecallCount = order.RecallCount
+	return nil
+}
+
+func (r *orders) Delete(ctx context.Context, id uuid.UUID) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	i := r.s.findOrder(id)
+	if i < 0 {
+		return repository.ErrNotFound
+	}
+	r.s.orders = append(r.s.orders[:i], r.s.orders[i+1:]...)
+	// 消すオーダーに使われたクーポンは使われていないことにする
+	for j := range r.s.vouchers {
+		if o := r.s.vouchers[j].RedeemedOrderID; o != nil && *o == id {
+			r.s.vouchers[j].RedeemedOrderID = nil
+			r.s.vouchers[j].RedeemedAt = nil
+		}
+	}
+	// 発行した領収書は番号を残すためにオーダーとの紐づけだけ外す
+	for j := range r.s.receipts {
+		if o := r.s.receipts[j].OrderID; o != nil && *o == id {
+			r.s.receipts[j].OrderID = nil
+		}
+	}
+	kept := r.s.comments[:0]
+	for _, c := range r.s.comments {
+		if c.OrderID != id {
+			kept = append(kept, c)
+		}
+	}
+	r.s.comments = kept
+	return nil
+}
+
+// 返金はオーダーに持たせて保存する
+type refunds struct {
+	s *Store
+}
+
+func (r *refunds) List(ctx context.Context, sessionID *uuid.UUID) ([]models.Refund, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	result := []models.Refund{}
+	for _, o := range r.s.orders {
+		for _, refund := range o.Refunds {
+			if inSession(sessionID, &refund.SessionID) {
+				result = append(result, refund)
+			}
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool { return result[i].CreatedAt.Before(result[j].CreatedAt) })
+	return result, nil
+}
+
+func (r *refunds) ListByOrder(ctx context.Context, orderID uuid.UUID) ([]models.Refund, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	i := r.s.findOrder(orderID)
+	if i < 0 {
+		return []models.Refund{}, nil
+	}
+	result := append([]models.Refund{}, r.s.orders[i].Refunds...)
+	sort.SliceStable(result, func(i, j int) bool { return result[i].CreatedAt.Before(result[j].CreatedAt) })
+	return result, nil
+}
+
+func (r *refunds) Create(ctx context.Context, refund *models.Refund) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	i := r.s.findOrder(refund.OrderID)
+	if i < 0 {
+		return repository.ErrNotFound
+	}
+	if refund.ID == uuid.Nil {
+		refund.ID = uuid.New()
+	}
+	for j := range refund.Items {
+		refund.Items[j].RefundID = refund.ID
+	}
+	stored := &r.s.orders[i]
+	stored.Refunds = append(append([]models.Refund(nil), stored.Refunds...), *refund)
+	return nil
+}
+
+type comments struct {
+	s *Store
+}
+
+func (r *comments) ListByOrder(ctx context.Context, orderID uuid.UUID) ([]models.Comment, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	result := []models.Comment{}
+	for _, c := range r.s.comments {
+		if c.OrderID == orderID {
+			result = append(result, c)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool { return result[i].CreatedAt.After(result[j].CreatedAt) })
+	return result, nil
+}
+
+func (r *comments) Create(ctx context.Context, comment *models.Comment) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	r.s.comments = append(r.s.comments, *comment)
+	return nil
+}
+
+type masterStates struct {
+	s *Store
+}
+
+func (r *masterStates) List(ctx context.Context, sessionID *uuid.UUID) ([]models.MasterState, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	result := []models.MasterState{}
+	for _, state := range r.s.masterStates {
+		if inSession(sessionID, state.SessionID) {
+			result = append(result, state)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool { return result[i].CreatedAt.Before(result[j].CreatedAt) })
+	return result, nil
+}
+
+func (r *masterStates) Latest(ctx context.Context, sessionID *uuid.UUID) (*models.MasterState, error) {
+	states, err := r.List(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	if len(states) == 0 {
+		return nil, repository.ErrNotFound
+	}
+	return &states[len(states)-1], nil
+}
+
+func (r *masterStates) Create(ctx context.Context, state *models.MasterState) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	r.s.masterStates = append(r.s.masterStates, *state)
+	return nil
+}
+
+type sessions struct {
+	s *Store
+}
+
+func (r *sessions) List(ctx context.Context) ([]models.Session, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	result := append([]models.Session{}, r.s.sessions...)
+	sort.SliceStable(result, func(i, j int) bool { return result[i].OpenedAt.After(result[j].OpenedAt) })
+	return result, nil
+}
+
+func (r *sessions) Get(ctx context.Context, id uuid.UUID) (*models.Session, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	for _, session := range r.s.sessions {
+		if session.ID == id {
+			return &session, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+// 営業中のもの、開始が新しいものを先に並べる
+func (r *sessions) sorted() []models.Session {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	result := append([]models.Session(nil), r.s.sessions...)
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].IsOpen() != result[j].IsOpen() {
+			return result[i].IsOpen()
+		}
+		return result[i].OpenedAt.After(result[j].OpenedAt)
+	})
+	return result
+}
+
+func (r *sessions) Open(ctx context.Context) (*models.Session, error) {
+	for _, session := range r.sorted() {
+		if session.IsOpen() {
+			return &session, nil
+		}
+	}
+	return nil, repository.ErrNotFound
+}
+
+func (r *sessions) Latest(ctx context.Context) (*models.Session, error) {
+	all := r.sorted()
+	if len(all) == 0 {
+		return nil, repository.ErrNotFound
+	}
+	return &all[0], nil
+}
+
+func (r *sessions) Create(ctx context.Context, session *models.Session) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	if session.ID == uuid.Nil {
+		session.ID = uuid.New()
+	}
+	r.s.sessions = append(r.s.sessions, *session)
+	return nil
+}
+
+func (r *sessions) Update(ctx context.Context, session *models.Session) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	for i := range r.s.sessions {
+		if r.s.sessions[i].ID == session.ID {
+			r.s.sessions[i] = *session
+			return nil
+		}
+	}
+	return repository.ErrNotFound
+}
+
+func (r *sessions) Lock(ctx context.Context, id uuid.UUID) error {
+	_, err := r.Get(ctx, id)
+	return err
+}
+
+type cashMovements struct {
+	s *Store
+}
+
+func (r *cashMovements) Create(ctx context.Context, movement *models.CashMovement) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	if movement.ID == uuid.Nil {
+		movement.ID = uuid.New()
+	}
+	r.s.movements = append(r.s.movements, *movement)
+	return nil
+}
+
+type cashCloseouts struct {
+	s *Store
+}
+
+func (r *cashCloseouts) Exists(ctx context.Context, sessionID uuid.UUID, register string) (bool, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	for _, co := range r.s.closeouts {
+		if co.SessionID == sessionID && co.Register == register {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *cashCloseouts) Create(ctx context.Context, closeout *models.CashCloseout) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	if closeout.ID == uuid.Nil {
+		closeout.ID = uuid.New()
+	}
+	r.s.closeouts = append(r.s.closeouts, *closeout)
+	return nil
+}
+
+type modifiers struct {
+	s *Store
+}
+
+func (r *modifiers) GroupsFor(ctx context.Context, items []models.Item) (map[uuid.UUID][]models.ModifierGroup, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	result := map[uuid.UUID][]models.ModifierGroup{}
+	for _, item := range items {
+		for _, g := range r.s.groups {
+			if !g.Deleted.Valid && g.AppliesTo(&item) {
+				result[item.ID] = append(result[item.ID], g)
+			}
+		}
+	}
+	return result, nil
+}
+
+type promotionRules struct {
+	s *Store
+}
+
+func (r *promotionRules) ListActive(ctx context.Context) ([]models.Promotion, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	result := []models.Promotion{}
+	for _, p := range r.s.promotions {
+		if p.Active && !p.Deleted.Valid {
+			result = append(result, p)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].Priority != result[j].Priority {
+			return result[i].Priority < result[j].Priority
+		}
+		return result[i].CreatedAt.Before(result[j].CreatedAt)
+	})
+	return result, nil
+}
+
+type voucherCodes struct {
+	s *Store
+}
+
+func (r *voucherCodes) FindByCode(ctx context.Context, code string) (*models.Voucher, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	for _, v := range r.s.vouchers {
+		if v.Code == vouchers.Normalize(code) {
+			return &v, nil
+		}
+	}
+	return nil, vouchers.ErrNotFound
+}
+
+func (r *voucherCodes) Redeem(ctx context.Context, voucher *models.Voucher, order *models.Order) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	for i := range r.s.vouchers {
+		stored := &r.s.vouchers[i]
+		if stored.ID != voucher.ID {
+			continue
+		}
+		if stored.Redeemed() {
+			return vouchers.ErrAlreadyRedeemed
+		}
+		stored.RedeemedOrderID = &order.ID
+		stored.RedeemedAt = &order.CreatedAt
+		voucher.RedeemedOrderID = stored.RedeemedOrderID
+		voucher.RedeemedAt = stored.RedeemedAt
+		return nil
+	}
+	return vouchers.ErrNotFound
+}
+
+type drawers struct {
+	s *Store
+}
+
+func (r *drawers) List(ctx context.Context, sessionID uuid.UUID, register string) ([]models.DrawerStock, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	result := []models.DrawerStock{}
+	for _, stock := range r.s.drawer {
+		if stock.SessionID == sessionID && stock.Register == register {
+			result = append(result, stock)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Denomination > result[j].Denomination })
+	return result, nil
+}
+
+func (r *drawers) Lock(ctx context.Context, sessionID uuid.UUID, register string) ([]models.DrawerStock, error) {
+	return r.List(ctx, sessionID, register)
+}
+
+func (r *drawers) Save(ctx context.Context, stocks []models.DrawerStock) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	for _, stock := range stocks {
+		saved := false
+		for i, existing := range r.s.drawer {
+			if existing.SessionID == stock.SessionID && existing.Register == stock.Register && existing.Denomination == stock.Denomination {
+				r.s.drawer[i] = stock
+				saved = true
+			}
+		}
+		if !saved {
+			r.s.drawer = append(r.s.drawer, stock)
+		}
+	}
+	return nil
+}
+
+type printJobs struct {
+	s *Store
+}
+
+func (r *printJobs) Create(ctx context.Context, job *models.PrintJob) error {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	if job.ID == uuid.Nil {
+		job.ID = uuid.New()
+	}
+	r.s.printJobs = append(r.s.printJobs, *job)
+	return nil
+}
+
+type receipts struct {
+	s *Store
+}
+
+func (r *receipts) Issue(ctx context.Context, orderID uuid.UUID, addressee string, now time.Time) (*models.IssuedReceipt, error) {
+	r.s.mu.Lock()
+	defer r.s.mu.Unlock()
+	for i, issued := range r.s.receipts {
+		if issued.OrderID != nil && *issued.OrderID == orderID {
+			if addressee != "" {
+				r.s.receipts[i].Addressee = addressee
+			}
+			result := r.s.receipts[i]
+			return &result, nil
+		}
+	}
+	r.s.receiptNumber++
+	issued := models.IssuedReceipt{
+		ID:        uuid.New(),
+		OrderID:   &orderID,
+		Number:    r.s.receiptNumber,
+		Addressee: addressee,
+		IssuedAt:  now,
+	}
+	r.s.receipts = append(r.s.receipts, issued)
+	return &issued, nil
+}
+
+// fn がエラーを返したらデータを元に戻す
+// 他のトランザクションとの分離はしない（テストでは同時に書き込まない）
+type transactor struct {
+	s *Store
+}
+
+func (t *transactor) Transaction(ctx context.Context, fn func(repos *repository.Repositories) error) error {
+	t.s.mu.Lock()
+	saved := t.s.snapshot()
+	t.s.mu.Unlock()
+
+	if err := fn(t.s.Repositories()); err != nil {
+		t.s.mu.Lock()
+		t.s.restore(saved)
+		t.s.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+// ロールバック用の写し（s.mu を取った状態で呼ぶ）
+type snapshot struct {
+	itemTypes    []models.ItemType
+	items        []models.Item
+	components   []models.BundleComponent
+	prices       []models.ItemPrice
+	orders       []models.Order
+	comments     []models.Comment
+	masterStates []models.MasterState
+	sessions     []models.Session
+	movements    []models.CashMovement
+	closeouts    []models.CashCloseout
+	groups       []models.ModifierGroup
+	promotions   []models.Promotion
+	vouchers     []models.Voucher
+	drawer       []models.DrawerStock
+	printJobs    []models.PrintJob
+	receipts     []models.IssuedReceipt
+}
+
+func (s *Store) snapshot() snapshot {
+	return snapshot{
+		itemTypes:    append([]models.ItemType(nil), s.itemTypes...),
+		items:        append([]models.Item(nil), s.items...),
+		components:   append([]models.BundleComponent(nil), s.components...),
+		prices:       append([]models.ItemPrice(nil), s.prices...),
+		orders:       append([]models.Order(nil), s.orders...),
+		comments:     append([]models.Comment(nil), s.comments...),
+		masterStates: append([]models.MasterState(nil), s.masterStates...),
+		sessions:     append([]models.Session(nil), s.sessions...),
+		movements:    append([]models.CashMovement(nil), s.movements...),
+		closeouts:    append([]models.CashCloseout(nil), s.closeouts...),
+		groups:       append([]models.ModifierGroup(nil), s.groups...),
+		promotions:   append([]models.Promotion(nil), s.promotions...),
+		vouchers:     append([]models.Voucher(nil), s.vouchers...),
+		drawer:       append([]models.DrawerStock(nil), s.drawer...),
+		printJobs:    append([]models.PrintJob(nil), s.printJobs...),
+		receipts:     append([]models.IssuedReceipt(nil), s.receipts...),
+	}
+}
+
+func (s *Store) restore(saved snapshot) {
+	s.itemTypes = saved.itemTypes
+	s.items = saved.items
+	s.components = saved.components
+	s.prices = saved.prices
+	s.orders = saved.orders
+	s.comments = saved.comments
+	s.masterStates = saved.masterStates
+	s.sessions = saved.sessions
+	s.movements = saved.movements
+	s.closeouts = saved.closeouts
+	s.groups = saved.groups
+	s.promotions = saved.promotions
+	s.vouchers = saved.vouchers
+	s.drawer = saved.drawer
+	s.printJobs = saved.printJobs
+	s.receipts = saved.receipts
+}
@@ -0,0 +1,205 @@
+// api/internal/repository/repository.go
+//
+// データの読み書き（サービスから使う）
+// Postgres（GORM）の実装と、テスト用のメモリ上の実装（repository/memory）がある
+// SQL で集計するレポート・レジ締め・エクスポートはここを通さずに GORM で読む
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"cafeore-pos/api/internal/callscreen"
+	"cafeore-pos/api/internal/models"
+	"cafeore-pos/api/internal/queue"
+	"cafeore-pos/api/internal/tracking"
+)
+
+// 対象のレコードがない
+var ErrNotFound = errors.New("Record not found")
+
+// アイテム
+// 読み込んだアイテムは種別とセット商品の構成アイテム（とその種別）をロード済み
+type ItemRepository interface {
+	List(ctx context.Context) ([]models.Item, error)
+	ListByIDs(ctx context.Context, ids []uuid.UUID) ([]models.Item, error)
+	Get(ctx context.Context, id uuid.UUID) (*models.Item, error)
+	// アイテムを作る（components はセット商品の構成アイテム）
+	Create(ctx context.Context, item *models.Item, components []models.BundleComponent) error
+	// アイテムを更新する（components が nil なら構成アイテムは変えない）
+	Update(ctx context.Context, item *models.Item, components *[]models.BundleComponent) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	// 他のセット商品の構成アイテムになっているか
+	IsComponent(ctx context.Context, id uuid.UUID) (bool, error)
+
+	// 指定した時刻に有効な価格（価格の履歴がないアイテムは含まない）
+	EffectivePrices(ctx context.Context, ids []uuid.UUID, at time.Time) (map[uuid.UUID]int, error)
+	// 価格の変更を履歴に記録する
+	RecordPrice(ctx context.Context, itemID uuid.UUID, price int, effectiveFrom time.Time, reason string) (*models.ItemPrice, error)
+	// 価格の履歴・予定（有効になる順）
+	ListPrices(ctx context.Context, itemID uuid.UUID) ([]models.ItemPrice, error)
+	GetPrice(ctx context.Context, itemID, priceID uuid.UUID) (*models.ItemPrice, error)
+	DeletePrice(ctx context.Context, priceID uuid.UUID) error
+}
+
+// アイテム種別（削除したものは読み込まない）
+type ItemTypeRepository interface {
+	List(ctx context.Context) ([]models.ItemType, error)
+	Get(ctx context.Context, id uuid.UUID) (*models.ItemType, error)
+	Create(ctx context.Context, itemType *models.ItemType) error
+	Update(ctx context.Context, itemType *models.ItemType) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// オーダー
+// 読み込んだオーダーはアイテム（削除済みのものも）・コメント・返金・支払いをロード済み
+type OrderRepository interface {
+	// sessionID が nil の場合はセッションで絞り込まない
+	List(ctx context.Context, sessionID *uuid.UUID) ([]models.Order, error)
+	Get(ctx context.Context, id uuid.UUID) (*models.Order, error)
+	// トランザクションが終わるまでオーダーをロックして読み込む（同じオーダーへの返金を一つずつにする）
+	Lock(ctx context.Context, id uuid.UUID) (*models.Order, error)
+	// セッション内のオーダー番号のオーダー
+	GetByNumber(ctx context.Context, sessionID uuid.UUID, number int) (*models.Order, error)
+	// オーダー番号と追跡コードのオーダー（番号はセッションごとに振り直すので、同じ番号があれば新しいもの）
+	FindByTracking(ctx context.Context, number int, token string) (*models.Order, error)
+	// セッション内で次に採番するオーダー番号
+	NextNumber(ctx context.Context, sessionID uuid.UUID) (int, error)
+	// セッション内でオーダー番号 discountOrderID が割引に使われているか
+	DiscountUsed(ctx context.Context, sessionID uuid.UUID, discountOrderID int) (bool, error)
+	// 提供待ちの列での順番（1から）
+	QueuePosition(ctx context.Context, order *models.Order) (int, error)
+	// 提供待ちの列のオーダー（sessionID が nil の場合はセッションで絞り込まない）
+	Queue(ctx context.Context, sessionID *uuid.UUID) (queue.Orders, error)
+	// 準備中のオーダーの待ち順と提供間隔の実績
+	Tracking(ctx context.Context, order *models.Order, cfg tracking.Config) (tracking.Progress, error)
+	// 呼び出し画面に出すオーダー（sessionID が nil の場合はセッションで絞り込まない）
+	Callscreen(ctx context.Context, sessionID *uuid.UUID, cfg callscreen.Config) (callscreen.Orders, error)
+	// オーダーをアイテム・コメント・支払いと一緒に作る
+	Create(ctx context.Context, order *models.Order) error
+	// 提供状況（準備完了・提供済み・呼び出し）だけを保存する
+	SaveProgress(ctx context.Context, order *models.Order) error
+	// オーダーをアイテム・コメントと一緒に削除する
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// 返金・作り直し（返金の行をロード済み、古い順）
+type RefundRepository interface {
+	// sessionID が nil の場合はセッションで絞り込まない
+	List(ctx context.Context, sessionID *uuid.UUID) ([]models.Refund, error)
+	ListByOrder(ctx context.Context, orderID uuid.UUID) ([]models.Refund, error)
+	// 返金を返金の行と一緒に作る
+	Create(ctx context.Context, refund *models.Refund) error
+}
+
+// オーダーへのコメント
+type CommentRepository interface {
+	// 新しい順
+	ListByOrder(ctx context.Context, orderID uuid.UUID) ([]models.Comment, error)
+	Create(ctx context.Context, comment *models.Comment) error
+}
+
+// マスターステート
+type MasterStateRepository interface {
+	// 古い順（sessionID が nil の場合はセッションで絞り込まない）
+	List(ctx context.Context, sessionID *uuid.UUID) ([]models.MasterState, error)
+	Latest(ctx context.Context, sessionID *uuid.UUID) (*models.MasterState, error)
+	Create(ctx context.Context, state *models.MasterState) error
+}
+
+// セッション（オーダーとマスターステートの絞り込みに使う）
+type SessionRepository interface {
+	// 開始が新しい順
+	List(ctx context.Context) ([]models.Session, error)
+	Get(ctx context.Context, id uuid.UUID) (*models.Session, error)
+	// 営業中のセッション
+	Open(ctx context.Context) (*models.Session, error)
+	// 営業中のセッション、なければ直近のセッション
+	Latest(ctx context.Context) (*models.Session, error)
+	Create(ctx context.Context, session *models.Session) error
+	Update(ctx context.Context, session *models.Session) error
+	// トランザクションが終わるまでセッションをロックする（同じセッションのオーダーの作成を一つずつにする）
+	Lock(ctx context.Context, id uuid.UUID) error
+}
+
+// アイテムで選べる選択肢
+type ModifierRepository interface {
+	// アイテムごとに選べる選択肢グループ（アイテムとアイテム種別に紐づくもの、選択肢をロード済み）
+	GroupsFor(ctx context.Context, items []models.Item) (map[uuid.UUID][]models.ModifierGroup, error)
+}
+
+// 割引ルール
+type PromotionRepository interface {
+	// 有効なルール（優先度順）
+	ListActive(ctx context.Context) ([]models.Promotion, error)
+}
+
+// クーポン
+type VoucherRepository interface {
+	// コードからクーポンを探す（バッチをロード済み、見つからなければ vouchers.ErrNotFound）
+	FindByCode(ctx context.Context, code string) (*models.Voucher, error)
+	// クーポンを使用済みにする（使用済みなら vouchers.ErrAlreadyRedeemed）
+	Redeem(ctx context.Context, voucher *models.Voucher, order *models.Order) error
+}
+
+// レジの金種別の在庫（金種の大きい順）
+type DrawerRepository interface {
+	List(ctx context.Context, sessionID uuid.UUID, register string) ([]models.DrawerStock, error)
+	// 更新するために読み込む（トランザクションが終わるまで他から更新されないようにする）
+	Lock(ctx context.Context, sessionID uuid.UUID, register string) ([]models.DrawerStock, error)
+	Save(ctx context.Context, stocks []models.DrawerStock) error
+}
+
+// 印刷ジョブ
+type PrintJobRepository interface {
+	Create(ctx context.Context, job *models.PrintJob) error
+}
+
+// 発行した領収書
+type ReceiptRepository interface {
+	// オーダーの領収書を発行する（発行済みなら同じ番号のもの、addressee を指定した場合は宛名を更新する）
+	// 番号は全体の通し番号で、オーダーを消しても使い回さない
+	Issue(ctx context.Context, orderID uuid.UUID, addressee string, now time.Time) (*models.IssuedReceipt, error)
+}
+
+// レジの手動の入出金
+type CashMovementRepository interface {
+	Create(ctx context.Context, movement *models.CashMovement) error
+}
+
+// レジ締めのレポート（集計は SQL で行うので保存だけ）
+type CashCloseoutRepository interface {
+	// セッションのレジが締め済みか
+	Exists(ctx context.Context, sessionID uuid.UUID, register string) (bool, error)
+	// 金種別の枚数と一緒に保存する
+	Create(ctx context.Context, closeout *models.CashCloseout) error
+}
+
+// トランザクション
+type Transactor interface {
+	// fn に渡したリポジトリの読み書きを一つのトランザクションで行う（fn がエラーを返したらロールバックする）
+	Transaction(ctx context.Context, fn func(repos *Repositories) error) error
+}
+
+// サービスが使うリポジトリ一式
+type Repositories struct {
+	Items         ItemRepository
+	ItemTypes     ItemTypeRepository
+	Orders        OrderRepository
+	Comments      CommentRepository
+	Refunds       RefundRepository
+	MasterStates  MasterStateRepository
+	Sessions      SessionRepository
+	CashMovements CashMovementRepository
+	CashCloseouts CashCloseoutRepository
+	Modifiers     ModifierRepository
+	Promotions    PromotionRepository
+	Vouchers      VoucherRepository
+	Drawers       DrawerRepository
+	PrintJobs     PrintJobRepository
+	Receipts      ReceiptRepository
+	Tx            Transactor
+}
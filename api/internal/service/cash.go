@@ -0,0 +1,49 @@
+// api/internal/service/cash.go
+package service
+
+import (
+	"context"
+	"time"
+
+	"cafeore-pos/api/internal/apierror"
+	"cafeore-pos/api/internal/models"
+	"cafeore-pos/api/internal/repository"
+)
+
+// レジの手動の入出金と締め処理
+type CashService struct {
+	movements repository.CashMovementRepository
+	sessions  repository.SessionRepository
+	tx        repository.Transactor
+	now       func() time.Time
+}
+
+func NewCashService(movements repository.CashMovementRepository, sessions repository.SessionRepository, tx repository.Transactor) *CashService {
+	return &CashService{movements: movements, sessions: sessions, tx: tx, now: time.Now}
+}
+
+// 営業中のセッションに入出金を記録する
+func (s *CashService) RecordMovement(ctx context.Context, movement *models.CashMovement) error {
+	session, err := openSession(ctx, s.sessions)
+	if err != nil {
+		return err
+	}
+	movement.SessionID = session.ID
+	movement.CreatedAt = s.now()
+	return s.movements.Create(ctx, movement)
+}
+
+// 締め処理のレポートを保存する（セッションのレジごとに一つまで）
+func (s *CashService) Close(ctx context.Context, closeout *models.CashCloseout) error {
+	return s.tx.Transaction(ctx, func(repos *repository.Repositories) error {
+		closed, err := repos.CashCloseouts.Exists(ctx, closeout.SessionID, closeout.Register)
+		if err != nil {
+			return err
+		}
+		if closed {
+			return apierror.New(models.ErrorCodeCloseoutExists, "This register is already closed out in the session")
+		}
+		closeout.CreatedAt = s.now()
+		return repos.CashCloseouts.Create(ctx, closeout)
+	})
+}
@@ -0,0 +1,66 @@
+// api/internal/service/cash_test.go
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"cafeore-pos/api/internal/apierror"
+	"cafeore-pos/api/internal/models"
+	"cafeore-pos/api/internal/repository/memory"
+)
+
+func TestCashClose(t *testing.T) {
+	ctx := context.Background()
+	repos := memory.New().Repositories()
+	now := time.Date(2025, 11, 1, 18, 0, 0, 0, time.Local)
+	s := NewCashService(repos.CashMovements, repos.Sessions, repos.Tx)
+	s.now = func() time.Time { return now }
+	sessionID := uuid.New()
+
+	closeout := models.CashCloseout{SessionID: sessionID, Register: "main", Expected: 12000, Counted: 11900, Variance: -100}
+	if err := s.Close(ctx, &closeout); err != nil {
+		t.Fatal(err)
+	}
+	if closeout.ID == uuid.Nil || !closeout.CreatedAt.Equal(now) {
+		t.Errorf("closeout = %+v, want saved at %v", closeout, now)
+	}
+
+	// 同じレジは一度だけ締められる（別のレジは締められる）
+	var e *apierror.Error
+	if err := s.Close(ctx, &models.CashCloseout{SessionID: sessionID, Register: "main"}); !errors.As(err, &e) || e.Code != models.ErrorCodeCloseoutExists {
+		t.Errorf("second close = %v, want CLOSEOUT_EXISTS", err)
+	}
+	if err := s.Close(ctx, &models.CashCloseout{SessionID: sessionID, Register: "sub"}); err != nil {
+		t.Errorf("close another register: %v", err)
+	}
+}
+
+func TestCashRecordMovement(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	repos := store.Repositories()
+	s := NewCashService(repos.CashMovements, repos.Sessions, repos.Tx)
+
+	// 営業中のセッションがなければ記録しない
+	var e *apierror.Error
+	if err := s.RecordMovement(ctx, &models.CashMovement{Type: models.CashMovementPayIn, Amount: 1000}); !errors.As(err, &e) || e.Code != models.ErrorCodeNoOpenSession {
+		t.Errorf("record without session = %v, want NO_OPEN_SESSION", err)
+	}
+
+	session := models.Session{Name: "day 1", OpenedAt: time.Now()}
+	if err := repos.Sessions.Create(ctx, &session); err != nil {
+		t.Fatal(err)
+	}
+	movement := models.CashMovement{Register: "main", Type: models.CashMovementPayOut, Amount: 500}
+	if err := s.RecordMovement(ctx, &movement); err != nil {
+		t.Fatal(err)
+	}
+	if got := store.CashMovements(); len(got) != 1 || got[0].SessionID != session.ID || got[0].CreatedAt.IsZero() {
+		t.Errorf("cash movements = %+v, want one in the open session", got)
+	}
+}
@@ -0,0 +1,52 @@
+// api/internal/service/comment.go
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"cafeore-pos/api/internal/models"
+	"cafeore-pos/api/internal/repository"
+)
+
+type CommentService struct {
+	comments repository.CommentRepository
+	orders   repository.OrderRepository
+	now      func() time.Time
+}
+
+func NewCommentService(comments repository.CommentRepository, orders repository.OrderRepository) *CommentService {
+	return &CommentService{comments: comments, orders: orders, now: time.Now}
+}
+
+// オーダーが存在するか確認する
+func (s *CommentService) checkOrder(ctx context.Context, orderID uuid.UUID) error {
+	_, err := s.orders.Get(ctx, orderID)
+	return notFound(err, models.ErrorCodeOrderNotFound, "Order not found")
+}
+
+// オーダーのコメント（新しい順）
+func (s *CommentService) List(ctx context.Context, orderID uuid.UUID) ([]models.Comment, error) {
+	if err := s.checkOrder(ctx, orderID); err != nil {
+		return nil, err
+	}
+	return s.comments.ListByOrder(ctx, orderID)
+}
+
+func (s *CommentService) Create(ctx context.Context, orderID uuid.UUID, author, text string) (*models.Comment, error) {
+	if err := s.checkOrder(ctx, orderID); err != nil {
+		return nil, err
+	}
+	comment := models.Comment{
+		OrderID:   orderID,
+		Author:    author,
+		Text:      text,
+		CreatedAt: s.now(),
+	}
+	if err := s.comments.Create(ctx, &comment); err != nil {
+		return nil, err
+	}
+	return &comment, nil
+}
@@ -0,0 +1,234 @@
+// api/internal/service/drawer.go
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+
+	"cafeore-pos/api/internal/apierror"
+	"cafeore-pos/api/internal/models"
+	"cafeore-pos/api/internal/repository"
+)
+
+// 日本円の金種（大きい順）
+var YenDenominations = []int{10000, 5000, 2000, 1000, 500, 100, 50, 10, 5, 1}
+
+// 金種ごとの釣り銭不足の既定のしきい値
+var defaultLowThresholds = map[int]int{
+	10000: 0,
+	5000:  2,
+	2000:  0,
+	1000:  10,
+	500:   5,
+	100:   20,
+	50:    10,
+	10:    20,
+	5:     0,
+	1:     0,
+}
+
+// レジの金種別の在庫
+type DrawerService struct {
+	drawers  repository.DrawerRepository
+	sessions repository.SessionRepository
+	tx       repository.Transactor
+}
+
+func NewDrawerService(drawers repository.DrawerRepository, sessions repository.SessionRepository, tx repository.Transactor) *DrawerService {
+	return &DrawerService{drawers: drawers, sessions: sessions, tx: tx}
+}
+
+// 金種の大きい順
+func (s *DrawerService) Stocks(ctx context.Context, sessionID uuid.UUID, register string) ([]models.DrawerStock, error) {
+	return s.drawers.List(ctx, sessionID, register)
+}
+
+// 営業中のセッションのレジの在庫を登録する
+// 指定した金種の枚数を上書きし、未登録の金種は0枚で作成する
+func (s *DrawerService) Update(ctx context.Context, register string, counts []models.DrawerStockCount) (*models.Session, []models.DrawerStock, error) {
+	session, err := openSession(ctx, s.sessions)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	denominations := make([]models.DenominationCount, len(counts))
+	for i, d := range counts {
+		denominations[i] = models.DenominationCount{Denomination: d.Denomination, Count: d.Count}
+	}
+	if _, err := CountDenominations("/denominations", denominations); err != nil {
+		return nil, nil, err
+	}
+
+	var stocks []models.DrawerStock
+	err = s.tx.Transaction(ctx, func(repos *repository.Repositories) error {
+		// 会計での在庫の更新（SettleCashDrawer）と上書きし合わないようにロックして読む
+		existing, err := repos.Drawers.Lock(ctx, session.ID, register)
+		if err != nil {
+			return err
+		}
+		byDenomination := map[int]models.DrawerStock{}
+		for _, stock := range existing {
+			byDenomination[stock.Denomination] = stock
+		}
+		for _, d := range YenDenominations {
+			if _, ok := byDenomination[d]; !ok {
+				byDenomination[d] = models.DrawerStock{
+					SessionID:    session.ID,
+					Register:     register,
+					Denomination: d,
+					LowThreshold: defaultLowThresholds[d],
+				}
+			}
+		}
+		for _, d := range counts {
+			stock := byDenomination[d.Denomination]
+			stock.Count = d.Count
+			if d.LowThreshold != nil {
+				stock.LowThreshold = *d.LowThreshold
+			}
+			byDenomination[d.Denomination] = stock
+		}
+
+		stocks = make([]models.DrawerStock, 0, len(byDenomination))
+		for _, stock := range byDenomination {
+			stocks = append(stocks, stock)
+		}
+		sort.Slice(stocks, func(i, j int) bool { return stocks[i].Denomination > stocks[j].Denomination })
+		return repos.Drawers.Save(ctx, stocks)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return session, stocks, nil
+}
+
+// 金種別の枚数を検証して合計金額を返す（field はリクエストボディでの位置）
+func CountDenominations(field string, counts []models.DenominationCount) (int, error) {
+	valid := map[int]bool{}
+	for _, d := range YenDenominations {
+		valid[d] = true
+	}
+	seen := map[int]bool{}
+	total := 0
+	for i, dc := range counts {
+		if !valid[dc.Denomination] {
+			return 0, apierror.Invalid(fmt.Sprintf("%s/%d/denomination", field, i), fmt.Sprintf("Invalid denomination: %d", dc.Denomination))
+		}
+		if seen[dc.Denomination] {
+			return 0, apierror.Invalid(fmt.Sprintf("%s/%d/denomination", field, i), fmt.Sprintf("Duplicate denomination: %d", dc.Denomination))
+		}
+		if dc.Count < 0 {
+			return 0, apierror.Invalid(fmt.Sprintf("%s/%d/count", field, i), fmt.Sprintf("Invalid count for denomination %d", dc.Denomination))
+		}
+		seen[dc.Denomination] = true
+		total += dc.Denomination * dc.Count
+	}
+	return total, nil
+}
+
+// 金額を金種に分解する（大きい金種から使う）
+// available が nil の場合は枚数の制限なしで分解する
+// 在庫の範囲で分解できない場合は false を返す
+func breakdownAmount(amount int, available map[int]int) ([]models.DenominationCount, bool) {
+	result := []models.DenominationCount{}
+	remaining := amount
+	for _, d := range YenDenominations {
+		count := remaining / d
+		if available != nil && count > available[d] {
+			count = available[d]
+		}
+		if count <= 0 {
+			continue
+		}
+		result = append(result, models.DenominationCount{Denomination: d, Count: count})
+		remaining -= d * count
+	}
+	return result, remaining == 0
+}
+
+// お預かりした金種の内訳を決める
+// 指定があれば合計を検証し、なければ金額から推定する
+func resolveReceivedDenominations(received int, counts *[]models.DenominationCount) ([]models.DenominationCount, error) {
+	if counts == nil {
+		breakdown, _ := breakdownAmount(received, nil)
+		return breakdown, nil
+	}
+	total, err := CountDenominations("/received_denominations", *counts)
+	if err != nil {
+		return nil, err
+	}
+	if total != received {
+		return nil, apierror.Invalid("/received_denominations", fmt.Sprintf("received_denominations total %d does not match received %d", total, received))
+	}
+	return *counts, nil
+}
+
+func denominationLabel(d int) string {
+	if d >= 1000 {
+		return fmt.Sprintf("%d円札", d)
+	}
+	return fmt.Sprintf("%d円玉", d)
+}
+
+// しきい値を下回った金種の警告
+func LowStockWarnings(stocks []models.DrawerStock) []models.DrawerWarning {
+	warnings := []models.DrawerWarning{}
+	for _, s := range stocks {
+		if s.Count < s.LowThreshold {
+			warnings = append(warnings, models.DrawerWarning{
+				Denomination: s.Denomination,
+				Count:        s.Count,
+				LowThreshold: s.LowThreshold,
+				Message:      fmt.Sprintf("%sが残り%d枚です", denominationLabel(s.Denomination), s.Count),
+			})
+		}
+	}
+	return warnings
+}
+
+// 現金の受け渡しをレジに反映し、お釣りの金種内訳と警告を返す
+// レジの在庫が登録されていない場合は枚数の制限なしで内訳を計算する
+// オーダー・返金の作成と一緒に戻せるように、それを作るトランザクションの drawers で呼ぶ
+func SettleCashDrawer(ctx context.Context, drawers repository.DrawerRepository, sessionID uuid.UUID, register string, received []models.DenominationCount, change int) ([]models.DenominationCount, []models.DrawerWarning, error) {
+	stocks, err := drawers.Lock(ctx, sessionID, register)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(stocks) == 0 {
+		breakdown, _ := breakdownAmount(change, nil)
+		return breakdown, nil, nil
+	}
+
+	// お預かりした金種は先にレジに入れてからお釣りを用意する
+	available := map[int]int{}
+	for _, s := range stocks {
+		available[s.Denomination] = s.Count
+	}
+	for _, dc := range received {
+		available[dc.Denomination] += dc.Count
+	}
+
+	var warnings []models.DrawerWarning
+	breakdown, ok := breakdownAmount(change, available)
+	if !ok {
+		breakdown, _ = breakdownAmount(change, nil)
+		warnings = append(warnings, models.DrawerWarning{
+			Message: fmt.Sprintf("レジの釣り銭でお釣り%d円を用意できません", change),
+		})
+	}
+	// 足りない場合もレジにある枚数までしか減らさない
+	for _, dc := range breakdown {
+		available[dc.Denomination] -= min(dc.Count, available[dc.Denomination])
+	}
+
+	for i := range stocks {
+		stocks[i].Count = available[stocks[i].Denomination]
+	}
+	if err := drawers.Save(ctx, stocks); err != nil {
+		return nil, nil, err
+	}
+	return breakdown, append(warnings, LowStockWarnings(stocks)...), nil
+}
@@ -0,0 +1,62 @@
+// api/internal/service/drawer_test.go
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"cafeore-pos/api/internal/models"
+	"cafeore-pos/api/internal/repository/memory"
+)
+
+func TestSettleCashDrawerShort(t *testing.T) {
+	ctx := context.Background()
+	repos := memory.New().Repositories()
+	sessionID := uuid.New()
+
+	stocks := []models.DrawerStock{}
+	for _, d := range YenDenominations {
+		stocks = append(stocks, models.DrawerStock{SessionID: sessionID, Register: "main", Denomination: d})
+	}
+	stocks[3].Count = 1 // 1000円札
+	stocks[5].Count = 1 // 100円玉
+	if err := repos.Drawers.Save(ctx, stocks); err != nil {
+		t.Fatal(err)
+	}
+
+	// 1000円札で300円の会計: お釣り700円のうち500円玉がない
+	received := []models.DenominationCount{{Denomination: 1000, Count: 1}}
+	breakdown, warnings, err := SettleCashDrawer(ctx, repos.Drawers, sessionID, "main", received, 700)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[int]int{500: 1, 100: 2}
+	if len(breakdown) != len(want) {
+		t.Fatalf("breakdown = %+v, want %v", breakdown, want)
+	}
+	for _, dc := range breakdown {
+		if want[dc.Denomination] != dc.Count {
+			t.Fatalf("breakdown = %+v, want %v", breakdown, want)
+		}
+	}
+	if len(warnings) == 0 || warnings[0].Denomination != 0 {
+		t.Fatalf("warnings = %+v, want a shortage warning first", warnings)
+	}
+
+	// 足りない金種は0枚で止める
+	got, err := repos.Drawers.List(ctx, sessionID, "main")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range got {
+		wantCount := 0
+		if s.Denomination == 1000 {
+			wantCount = 2
+		}
+		if s.Count != wantCount {
+			t.Errorf("%d yen count = %d, want %d", s.Denomination, s.Count, wantCount)
+		}
+	}
+}
@@ -0,0 +1,256 @@
+// api/internal/service/item.go
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"cafeore-pos/api/internal/apierror"
+	"cafeore-pos/api/internal/models"
+	"cafeore-pos/api/internal/pricing"
+	"cafeore-pos/api/internal/repository"
+)
+
+// アイテムの作成・更新の内容
+type ItemInput struct {
+	Name       string
+	Abbr       string
+	Price      int
+	Key        string
+	ItemTypeID uuid.UUID
+	// nil の場合は作成時は true、更新時は変更しない
+	Available *bool
+	// nil の場合は構成アイテムを変更しない、空でセットを解除
+	Components *[]models.BundleComponentRequest
+}
+
+type ItemService struct {
+	items repository.ItemRepository
+	tx    repository.Transactor
+	now   func() time.Time
+}
+
+func NewItemService(items repository.ItemRepository, tx repository.Transactor) *ItemService {
+	return &ItemService{items: items, tx: tx, now: time.Now}
+}
+
+// 今有効な価格を返す（予定された価格変更を反映するのに pricing.ApplyWith に渡す）
+func (s *ItemService) prices(ctx context.Context) pricing.Lookup {
+	return func(ids []uuid.UUID) (map[uuid.UUID]int, error) {
+		return s.items.EffectivePrices(ctx, ids, s.now())
+	}
+}
+
+func (s *ItemService) List(ctx context.Context) ([]models.Item, error) {
+	items, err := s.items.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := pricing.ApplyWith(items, s.prices(ctx)); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func (s *ItemService) find(ctx context.Context, id uuid.UUID) (*models.Item, error) {
+	item, err := s.items.Get(ctx, id)
+	if err != nil {
+		return nil, notFound(err, models.ErrorCodeItemNotFound, "Item not found")
+	}
+	return item, nil
+}
+
+func (s *ItemService) Get(ctx context.Context, id uuid.UUID) (*models.Item, error) {
+	item, err := s.find(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	items := []models.Item{*item}
+	if err := pricing.ApplyWith(items, s.prices(ctx)); err != nil {
+		return nil, err
+	}
+	return &items[0], nil
+}
+
+func (s *ItemService) Create(ctx context.Context, in ItemInput) (*models.Item, error) {
+	item := models.Item{
+		ID:         uuid.New(),
+		Name:       in.Name,
+		Abbr:       in.Abbr,
+		Price:      in.Price,
+		Key:        in.Key,
+		ItemTypeID: in.ItemTypeID,
+		Available:  in.Available == nil || *in.Available,
+	}
+
+	// セット商品の場合は構成アイテムも一緒に作る
+	var components []models.BundleComponent
+	if in.Components != nil {
+		var err error
+		if components, err = s.bundleComponents(ctx, item.ID, *in.Components); err != nil {
+			return nil, err
+		}
+	}
+	// アイテムと価格の履歴は一緒に作る
+	err := s.tx.Transaction(ctx, func(repos *repository.Repositories) error {
+		if err := repos.Items.Create(ctx, &item, components); err != nil {
+			return err
+		}
+		_, err := repos.Items.RecordPrice(ctx, item.ID, item.Price, s.now(), "created")
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
+
+func (s *ItemService) Update(ctx context.Context, id uuid.UUID, in ItemInput) (*models.Item, error) {
+	item, err := s.find(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// 現在有効な価格と違えば価格の履歴に残す
+	current := []models.Item{*item}
+	if err := pricing.ApplyWith(current, s.prices(ctx)); err != nil {
+		return nil, err
+	}
+	priceChanged := current[0].Price != in.Price
+
+	item.Name = in.Name
+	item.Abbr = in.Abbr
+	item.Price = in.Price
+	item.Key = in.Key
+	item.ItemTypeID = in.ItemTypeID
+	if in.Available != nil {
+		item.Available = *in.Available
+	}
+
+	var components *[]models.BundleComponent
+	if in.Components != nil {
+		replaced, err := s.bundleComponents(ctx, item.ID, *in.Components)
+		if err != nil {
+			return nil, err
+		}
+		components = &replaced
+	}
+	err = s.tx.Transaction(ctx, func(repos *repository.Repositories) error {
+		if err := repos.Items.Update(ctx, item, components); err != nil {
+			return err
+		}
+		if !priceChanged {
+			return nil
+		}
+		_, err := repos.Items.RecordPrice(ctx, item.ID, item.Price, s.now(), "updated")
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+func (s *ItemService) Delete(ctx context.Context, id uuid.UUID) error {
+	return notFound(s.items.Delete(ctx, id), models.ErrorCodeItemNotFound, "Item not found")
+}
+
+// セット商品の構成アイテムを検証する
+// 構成アイテムは存在するセット商品以外のアイテムで、自分自身は入れられない
+// セット商品の構成アイテムになっているアイテムはセット商品にできない
+func (s *ItemService) bundleComponents(ctx context.Context, itemID uuid.UUID, reqs []models.BundleComponentRequest) ([]models.BundleComponent, error) {
+	components := make([]models.BundleComponent, 0, len(reqs))
+	seen := map[uuid.UUID]bool{}
+	for i, r := range reqs {
+		componentID := uuid.UUID(r.ItemId)
+		quantity := 1
+		if r.Quantity != nil {
+			quantity = *r.Quantity
+		}
+		if quantity < 1 {
+			return nil, apierror.Invalid(fmt.Sprintf("/components/%d/quantity", i), "quantity must be at least 1")
+		}
+		if componentID == itemID {
+			return nil, apierror.Invalid(fmt.Sprintf("/components/%d/item_id", i), "a bundle cannot contain itself")
+		}
+		if seen[componentID] {
+			return nil, apierror.Invalid(fmt.Sprintf("/components/%d/item_id", i), fmt.Sprintf("component %s is listed more than once", componentID))
+		}
+		seen[componentID] = true
+		components = append(components, models.BundleComponent{
+			ComponentItemID: componentID,
+			Quantity:        quantity,
+		})
+	}
+	if len(components) == 0 {
+		return components, nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(seen))
+	for id := range seen {
+		ids = append(ids, id)
+	}
+	found, err := s.items.ListByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	if len(found) != len(ids) {
+		return nil, apierror.Invalid("/components", "some component items not found")
+	}
+	for _, item := range found {
+		if item.IsBundle() {
+			return nil, apierror.Invalid("/components", "a bundle cannot contain another bundle")
+		}
+	}
+	isComponent, err := s.items.IsComponent(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+	if isComponent {
+		return nil, apierror.Invalid("/components", "an item used as a bundle component cannot be a bundle")
+	}
+	return components, nil
+}
+
+// 価格の履歴・予定
+func (s *ItemService) Prices(ctx context.Context, itemID uuid.UUID) ([]models.ItemPrice, error) {
+	if _, err := s.find(ctx, itemID); err != nil {
+		return nil, err
+	}
+	return s.items.ListPrices(ctx, itemID)
+}
+
+// 価格変更を登録する（effectiveFrom が nil なら今から）
+// effectiveFrom を未来にすると、その時刻に自動で価格が切り替わる
+func (s *ItemService) SchedulePrice(ctx context.Context, itemID uuid.UUID, price int, effectiveFrom *time.Time, reason string) (*models.ItemPrice, error) {
+	if _, err := s.find(ctx, itemID); err != nil {
+		return nil, err
+	}
+	if price < 0 {
+		return nil, apierror.Invalid("/price", "price must not be negative")
+	}
+	from := s.now()
+	if effectiveFrom != nil {
+		from = *effectiveFrom
+	}
+	return s.items.RecordPrice(ctx, itemID, price, from, reason)
+}
+
+// 予定された価格変更を取り消す
+// 過去のオーダーの価格と食い違わないように、適用済みの価格は消さない
+func (s *ItemService) CancelPrice(ctx context.Context, itemID, priceID uuid.UUID) error {
+	if _, err := s.find(ctx, itemID); err != nil {
+		return err
+	}
+	price, err := s.items.GetPrice(ctx, itemID, priceID)
+	if err != nil {
+		return notFound(err, models.ErrorCodePriceNotFound, "Price not found")
+	}
+	if !price.EffectiveFrom.After(s.now()) {
+		return apierror.New(models.ErrorCodePriceAlreadyEffective, "Price is already effective")
+	}
+	return notFound(s.items.DeletePrice(ctx, price.ID), models.ErrorCodePriceNotFound, "Price not found")
+}
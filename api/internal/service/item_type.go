@@ -0,0 +1,61 @@
+// api/internal/service/item_type.go
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"cafeore-pos/api/internal/models"
+	"cafeore-pos/api/internal/repository"
+)
+
+type ItemTypeService struct {
+	itemTypes repository.ItemTypeRepository
+}
+
+func NewItemTypeService(itemTypes repository.ItemTypeRepository) *ItemTypeService {
+	return &ItemTypeService{itemTypes: itemTypes}
+}
+
+// 名前順
+func (s *ItemTypeService) List(ctx context.Context) ([]models.ItemType, error) {
+	return s.itemTypes.List(ctx)
+}
+
+func (s *ItemTypeService) Get(ctx context.Context, id uuid.UUID) (*models.ItemType, error) {
+	itemType, err := s.itemTypes.Get(ctx, id)
+	if err != nil {
+		return nil, notFound(err, models.ErrorCodeItemTypeNotFound, "ItemType not found")
+	}
+	return itemType, nil
+}
+
+func (s *ItemTypeService) Create(ctx context.Context, name, displayName string) (*models.ItemType, error) {
+	itemType := models.ItemType{
+		ID:          uuid.New(),
+		Name:        name,
+		DisplayName: displayName,
+	}
+	if err := s.itemTypes.Create(ctx, &itemType); err != nil {
+		return nil, err
+	}
+	return &itemType, nil
+}
+
+func (s *ItemTypeService) Update(ctx context.Context, id uuid.UUID, name, displayName string) (*models.ItemType, error) {
+	itemType, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	itemType.Name = name
+	itemType.DisplayName = displayName
+	if err := s.itemTypes.Update(ctx, itemType); err != nil {
+		return nil, err
+	}
+	return itemType, nil
+}
+
+func (s *ItemTypeService) Delete(ctx context.Context, id uuid.UUID) error {
+	return notFound(s.itemTypes.Delete(ctx, id), models.ErrorCodeItemTypeNotFound, "ItemType not found")
+}
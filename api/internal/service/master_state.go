@@ -0,0 +1,62 @@
+// api/internal/service/master_state.go
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"cafeore-pos/api/internal/models"
+	"cafeore-pos/api/internal/repository"
+)
+
+type MasterStateService struct {
+	states   repository.MasterStateRepository
+	sessions repository.SessionRepository
+	now      func() time.Time
+}
+
+func NewMasterStateService(states repository.MasterStateRepository, sessions repository.SessionRepository) *MasterStateService {
+	return &MasterStateService{states: states, sessions: sessions, now: time.Now}
+}
+
+// 対象セッションのマスターステート（古い順）
+func (s *MasterStateService) List(ctx context.Context, requested *uuid.UUID) ([]models.MasterState, error) {
+	sessionID, err := resolveSession(ctx, s.sessions, requested)
+	if err != nil {
+		return nil, err
+	}
+	return s.states.List(ctx, sessionID)
+}
+
+// ブロードキャストする、営業中 or 直近のセッションの最新のマスターステート（なければ nil）
+func (s *MasterStateService) Current(ctx context.Context) (*models.MasterState, error) {
+	sessionID, err := resolveSession(ctx, s.sessions, nil)
+	if err != nil {
+		return nil, err
+	}
+	state, err := s.states.Latest(ctx, sessionID)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, nil
+	}
+	return state, err
+}
+
+// マスターステートを記録する（営業中のセッションに紐づける）
+func (s *MasterStateService) Create(ctx context.Context, stateType string) (*models.MasterState, error) {
+	session, err := openSession(ctx, s.sessions)
+	if err != nil {
+		return nil, err
+	}
+	state := models.MasterState{
+		Type:      stateType,
+		CreatedAt: s.now(),
+		SessionID: &session.ID,
+	}
+	if err := s.states.Create(ctx, &state); err != nil {
+		return nil, err
+	}
+	return &state, nil
+}
@@ -0,0 +1,623 @@
+// api/internal/service/order.go
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+
+	"cafeore-pos/api/internal/apierror"
+	"cafeore-pos/api/internal/callscreen"
+	"cafeore-pos/api/internal/models"
+	"cafeore-pos/api/internal/payments"
+	"cafeore-pos/api/internal/pricing"
+	"cafeore-pos/api/internal/promotions"
+	"cafeore-pos/api/internal/queue"
+	"cafeore-pos/api/internal/repository"
+	"cafeore-pos/api/internal/tracking"
+	"cafeore-pos/api/internal/vouchers"
+)
+
+// オーダーを作ったときの印刷（printing.Spooler）
+type OrderPrinter interface {
+	// オーダー作成時に作る印刷ジョブ（保存はしない）
+	OrderJobs(orderID uuid.UUID) []models.PrintJob
+	// 新しい印刷ジョブを送り始める
+	Notify()
+}
+
+// オーダーの作成・参照・削除と提供状況の更新
+type OrderService struct {
+	orders     repository.OrderRepository
+	sessions   repository.SessionRepository
+	items      repository.ItemRepository
+	modifiers  repository.ModifierRepository
+	promotions repository.PromotionRepository
+	vouchers   repository.VoucherRepository
+	tx         repository.Transactor
+	payments   *payments.Registry
+	// nil の場合は印刷しない
+	printer OrderPrinter
+	now     func() time.Time
+}
+
+func NewOrderService(repos *repository.Repositories, registry *payments.Registry, printer OrderPrinter) *OrderService {
+	return &OrderService{
+		orders:     repos.Orders,
+		sessions:   repos.Sessions,
+		items:      repos.Items,
+		modifiers:  repos.Modifiers,
+		promotions: repos.Promotions,
+		vouchers:   repos.Vouchers,
+		tx:         repos.Tx,
+		payments:   registry,
+		printer:    printer,
+		now:        time.Now,
+	}
+}
+
+// 作ったオーダーとレジの釣り銭の状況
+type CreatedOrder struct {
+	Order           *models.Order
+	ChangeBreakdown []models.DenominationCount
+	DrawerWarnings  []models.DrawerWarning
+	// 提供待ちの列での順番
+	QueuePosition int
+}
+
+// 営業中のセッションにオーダーを作る
+// 割引を計算して請求額を確かめ、キャッシュレス決済の承認を受けてから、
+// オーダー・クーポンの使用・レジの在庫・印刷ジョブを一つのトランザクションで保存する
+func (s *OrderService) Create(ctx context.Context, req models.OrderCreateRequest) (*CreatedOrder, error) {
+	// 支払いの内訳を検証する
+	tenders, err := resolvePayments(s.payments, req.BillingAmount, req.Payments)
+	if err != nil {
+		return nil, err
+	}
+
+	// お釣りはサーバー側で計算する（お預かりは現金の支払い分に対するもの）
+	due := requestedCash(tenders)
+	if req.Received < due {
+		return nil, apierror.New(models.ErrorCodeInsufficientPayment, "received is less than the cash amount")
+	}
+	receivedDenominations, err := resolveReceivedDenominations(req.Received, req.ReceivedDenominations)
+	if err != nil {
+		return nil, err
+	}
+
+	// オーダーは営業中のセッションに紐づける
+	session, err := openSession(ctx, s.sessions)
+	if err != nil {
+		return nil, err
+	}
+
+	// オーダー番号はセッションごとに採番する
+	var orderNumber int
+	if req.OrderId != nil {
+		orderNumber = *req.OrderId
+		if err := checkOrderNumber(ctx, s.orders, session.ID, orderNumber); err != nil {
+			return nil, err
+		}
+	} else if orderNumber, err = s.orders.NextNumber(ctx, session.ID); err != nil {
+		return nil, err
+	}
+
+	order := models.Order{
+		ID:            uuid.New(),
+		SessionID:     &session.ID,
+		Register:      models.DefaultRegister,
+		OrderId:       orderNumber,
+		CreatedAt:     s.now(),
+		BillingAmount: req.BillingAmount,
+		Received:      req.Received,
+		Change:        req.Received - due,
+	}
+	if req.Register != nil && *req.Register != "" {
+		order.Register = *req.Register
+	}
+
+	// お客様が注文状況を確認するための追跡コード
+	if order.TrackingToken, err = tracking.NewToken(); err != nil {
+		return nil, err
+	}
+
+	if req.DiscountOrderId != nil {
+		order.DiscountOrderId = *req.DiscountOrderId
+	}
+	if err := checkDiscountUnused(ctx, s.orders, session.ID, order.DiscountOrderId); err != nil {
+		return nil, err
+	}
+	if req.DiscountOrderCups != nil {
+		order.DiscountOrderCups = *req.DiscountOrderCups
+	} else if order.DiscountOrderId != 0 {
+		// 指定がなければ割引に使うオーダーの杯数（セット商品の構成アイテムを含む）を数える
+		if order.DiscountOrderCups, err = discountOrderCups(ctx, s.orders, session.ID, order.DiscountOrderId); err != nil {
+			return nil, err
+		}
+	}
+
+	if req.Comments != nil {
+		for _, c := range *req.Comments {
+			order.Comments = append(order.Comments, models.Comment{
+				Author:    c.Author,
+				Text:      c.Text,
+				CreatedAt: s.now(),
+			})
+		}
+	}
+
+	// 注文時点の価格で割引を計算し、請求額と合っているか確かめる
+	orderItems, voucher, evaluation, err := s.evaluate(ctx, req.ItemIds, order.DiscountOrderCups, req.VoucherCode, order.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	if voucher != nil && !evaluation.AppliedTo(voucher.BatchID) {
+		return nil, apierror.New(models.ErrorCodeVoucherNotApplicable, "Voucher does not apply to this order")
+	}
+	if req.BillingAmount != evaluation.BillingAmount() {
+		return nil, apierror.Newf(models.ErrorCodeBillingAmountMismatch, "billing_amount %d does not match %d (subtotal %d, discount %d)", req.BillingAmount, evaluation.BillingAmount(), evaluation.Subtotal, evaluation.Discount)
+	}
+	order.OrderItems = orderItems
+	order.Discount = evaluation.Discount
+	order.AppliedPromotions = evaluation.Applied
+
+	// キャッシュレス決済の承認を受けてからオーダーを作る
+	if order.Payments, err = authorizePayments(ctx, s.payments, order.ID, tenders, s.now); err != nil {
+		return nil, err
+	}
+
+	created := &CreatedOrder{}
+	err = s.tx.Transaction(ctx, func(repos *repository.Repositories) error {
+		// 同じセッションのオーダーの作成を一つずつにして、番号と割引の確認をやり直す
+		// （同時に作成された場合は上の確認をすり抜ける）
+		if err := repos.Sessions.Lock(ctx, session.ID); err != nil {
+			return err
+		}
+		if req.OrderId == nil {
+			number, err := repos.Orders.NextNumber(ctx, session.ID)
+			if err != nil {
+				return err
+			}
+			order.OrderId = number
+		} else if err := checkOrderNumber(ctx, repos.Orders, session.ID, order.OrderId); err != nil {
+			return err
+		}
+		if err := checkDiscountUnused(ctx, repos.Orders, session.ID, order.DiscountOrderId); err != nil {
+			return err
+		}
+
+		if err := repos.Orders.Create(ctx, &order); err != nil {
+			return err
+		}
+		if voucher != nil {
+			if err := repos.Vouchers.Redeem(ctx, voucher, &order); err != nil {
+				return err
+			}
+		}
+
+		// レジの釣り銭在庫に反映する
+		var err error
+		if created.ChangeBreakdown, created.DrawerWarnings, err = SettleCashDrawer(ctx, repos.Drawers, session.ID, order.Register, receivedDenominations, order.Change); err != nil {
+			return err
+		}
+
+		// ラベル・レシートの印刷ジョブ
+		if s.printer == nil {
+			return nil
+		}
+		for _, job := range s.printer.OrderJobs(order.ID) {
+			if err := repos.PrintJobs.Create(ctx, &job); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		voidPayments(ctx, s.payments, order.Payments)
+		return nil, err
+	}
+	if s.printer != nil {
+		s.printer.Notify()
+	}
+
+	// 関連データをロードする
+	if created.Order, err = s.Get(ctx, order.ID); err != nil {
+		return nil, err
+	}
+	if created.QueuePosition, err = s.orders.QueuePosition(ctx, created.Order); err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// オーダーを作らずに割引を計算する
+func (s *OrderService) Evaluate(ctx context.Context, req models.PromotionEvaluateRequest) (promotions.Result, error) {
+	returnedCups := 0
+	if req.DiscountOrderCups != nil {
+		returnedCups = *req.DiscountOrderCups
+	} else if req.DiscountOrderId != nil {
+		session, err := openSession(ctx, s.sessions)
+		if err != nil {
+			return promotions.Result{}, err
+		}
+		if returnedCups, err = discountOrderCups(ctx, s.orders, session.ID, *req.DiscountOrderId); err != nil {
+			return promotions.Result{}, err
+		}
+	}
+	_, _, result, err := s.evaluate(ctx, req.ItemIds, returnedCups, req.VoucherCode, s.now())
+	return result, err
+}
+
+// 注文するアイテムを検証し、割引ルールとクーポンで割引を計算する
+// クーポンは通常の割引ルールの後に適用する
+func (s *OrderService) evaluate(ctx context.Context, infos []models.ItemInfoCreate, returnedCups int, voucherCode *string, at time.Time) ([]models.OrderItem, *models.Voucher, promotions.Result, error) {
+	orderItems, itemsByID, err := s.resolveOrderItems(ctx, infos, at)
+	if err != nil {
+		return nil, nil, promotions.Result{}, err
+	}
+	rules, err := s.promotions.ListActive(ctx)
+	if err != nil {
+		return nil, nil, promotions.Result{}, err
+	}
+	var voucher *models.Voucher
+	if voucherCode != nil && *voucherCode != "" {
+		if voucher, err = s.validateVoucher(ctx, *voucherCode, at); err != nil {
+			return nil, nil, promotions.Result{}, err
+		}
+		rules = append(rules, vouchers.Rule(voucher))
+	}
+	result := promotions.Evaluate(rules, promotions.NewCart(orderItems, itemsByID, returnedCups, at))
+	return orderItems, voucher, result, nil
+}
+
+// at の時点で使えるクーポンを探す
+// クーポンコードはリクエストの一部なので、見つからない場合も 404 ではなく 400 を返す
+func (s *OrderService) validateVoucher(ctx context.Context, code string, at time.Time) (*models.Voucher, error) {
+	voucher, err := s.vouchers.FindByCode(ctx, code)
+	if errors.Is(err, vouchers.ErrNotFound) {
+		return nil, apierror.Wrap(models.ErrorCodeVoucherNotFound, err).WithStatus(http.StatusBadRequest)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := vouchers.Check(voucher, at); err != nil {
+		return nil, err
+	}
+	return voucher, nil
+}
+
+// 注文するアイテムと選択肢を検証し、注文時点の内容を記録した OrderItem を作る（OrderID は未設定）
+// 返すマップは注文するアイテム（種別とセット商品の構成アイテムをロード済み）
+func (s *OrderService) resolveOrderItems(ctx context.Context, infos []models.ItemInfoCreate, at time.Time) ([]models.OrderItem, map[uuid.UUID]*models.Item, error) {
+	if len(infos) == 0 {
+		return nil, nil, apierror.Invalid("/item_ids", "item_ids is required")
+	}
+
+	// アイテムの存在確認（重複を除いてユニークなIDのみチェック）
+	uniqueItemIDs := make(map[uuid.UUID]bool)
+	itemIDs := make([]uuid.UUID, 0, len(infos))
+	for _, info := range infos {
+		id := uuid.UUID(info.ItemId)
+		if !uniqueItemIDs[id] {
+			uniqueItemIDs[id] = true
+			itemIDs = append(itemIDs, id)
+		}
+	}
+
+	items, err := s.items.ListByIDs(ctx, itemIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(items) != len(uniqueItemIDs) {
+		return nil, nil, apierror.Invalid("/item_ids", "Some item IDs not found")
+	}
+	for _, item := range items {
+		if !item.Available {
+			return nil, nil, apierror.Newf(models.ErrorCodeItemUnavailable, "Item %s is not available", item.Name)
+		}
+		// セット商品は構成アイテムがすべて注文できる場合のみ注文できる
+		for _, component := range item.Components {
+			if !component.ComponentItem.Available {
+				return nil, nil, apierror.Newf(models.ErrorCodeItemUnavailable, "Item %s is not available (%s is not available)", item.Name, component.ComponentItem.Name)
+			}
+		}
+	}
+
+	// 注文時点で有効な価格を記録する
+	err = pricing.ApplyWith(items, func(ids []uuid.UUID) (map[uuid.UUID]int, error) {
+		return s.items.EffectivePrices(ctx, ids, at)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	itemsByID := make(map[uuid.UUID]*models.Item, len(items))
+	for i := range items {
+		itemsByID[items[i].ID] = &items[i]
+	}
+
+	// 選択肢（温度・サイズ・ミルクなど）を検証する
+	groups, err := s.modifiers.GroupsFor(ctx, items)
+	if err != nil {
+		return nil, nil, err
+	}
+	orderItems := make([]models.OrderItem, 0, len(infos))
+	for i, info := range infos {
+		item := itemsByID[uuid.UUID(info.ItemId)]
+		var modifierIDs []uuid.UUID
+		if info.ModifierIds != nil {
+			for _, id := range *info.ModifierIds {
+				modifierIDs = append(modifierIDs, uuid.UUID(id))
+			}
+		}
+		selected, err := resolveModifiers(item, groups[item.ID], modifierIDs)
+		if err != nil {
+			return nil, nil, apierror.Invalid(fmt.Sprintf("/item_ids/%d/modifier_ids", i), err.Error())
+		}
+		orderItem := models.OrderItem{
+			ItemID:   item.ID,
+			Assignee: info.Assignee,
+		}
+		orderItem.Snapshot(item, selected)
+		orderItems = append(orderItems, orderItem)
+	}
+	return orderItems, itemsByID, nil
+}
+
+// 選んだ選択肢を検証し、注文時点の内容を返す
+// 選択肢はアイテムで選べるグループに含まれ、グループごとの数の上下限を満たす必要がある
+func resolveModifiers(item *models.Item, groups []models.ModifierGroup, ids []uuid.UUID) (models.OrderItemModifiers, error) {
+	type found struct {
+		group    *models.ModifierGroup
+		modifier *models.Modifier
+	}
+	byID := map[uuid.UUID]found{}
+	for gi := range groups {
+		for mi := range groups[gi].Modifiers {
+			byID[groups[gi].Modifiers[mi].ID] = found{&groups[gi], &groups[gi].Modifiers[mi]}
+		}
+	}
+
+	selected := models.OrderItemModifiers{}
+	counts := map[uuid.UUID]int{}
+	seen := map[uuid.UUID]bool{}
+	for _, id := range ids {
+		f, ok := byID[id]
+		if !ok {
+			return nil, fmt.Errorf("Modifier %s is not available for %s", id, item.Name)
+		}
+		if seen[id] {
+			return nil, fmt.Errorf("Modifier %s is selected more than once", f.modifier.Name)
+		}
+		seen[id] = true
+		counts[f.group.ID]++
+		selected = append(selected, models.OrderItemModifier{
+			ModifierID: f.modifier.ID,
+			GroupName:  f.group.DisplayName,
+			Name:       f.modifier.Name,
+			PriceDelta: f.modifier.PriceDelta,
+		})
+	}
+
+	for _, g := range groups {
+		n := counts[g.ID]
+		if n < g.MinSelect {
+			return nil, fmt.Errorf("%s of %s requires at least %d selection(s)", g.DisplayName, item.Name, g.MinSelect)
+		}
+		if g.MaxSelect > 0 && n > g.MaxSelect {
+			return nil, fmt.Errorf("%s of %s allows at most %d selection(s)", g.DisplayName, item.Name, g.MaxSelect)
+		}
+	}
+	return selected, nil
+}
+
+// セッション内でオーダー番号が使われていないか確認する
+func checkOrderNumber(ctx context.Context, orders repository.OrderRepository, sessionID uuid.UUID, number int) error {
+	_, err := orders.GetByNumber(ctx, sessionID, number)
+	if err == nil {
+		return apierror.New(models.ErrorCodeOrderNumberInUse, "order_id is already used in this session")
+	}
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil
+	}
+	return err
+}
+
+// 割引に使えるオーダー（カップ）は一度だけ（0 は割引なし）
+func checkDiscountUnused(ctx context.Context, orders repository.OrderRepository, sessionID uuid.UUID, discountOrderID int) error {
+	if discountOrderID == 0 {
+		return nil
+	}
+	used, err := orders.DiscountUsed(ctx, sessionID, discountOrderID)
+	if err != nil {
+		return err
+	}
+	if used {
+		return apierror.Newf(models.ErrorCodeDiscountAlreadyUsed, "Order %d is already used for a discount", discountOrderID)
+	}
+	return nil
+}
+
+// 割引に使うオーダー（カップを返却したオーダー）のコーヒーの杯数
+func discountOrderCups(ctx context.Context, orders repository.OrderRepository, sessionID uuid.UUID, number int) (int, error) {
+	order, err := orders.GetByNumber(ctx, sessionID, number)
+	if err != nil {
+		return 0, notFound(err, models.ErrorCodeDiscountOrderNotFound, "Discount order not found")
+	}
+	return order.CoffeeCups(), nil
+}
+
+// 対象セッションのオーダー（requested が nil なら営業中 or 直近のセッション）
+func (s *OrderService) List(ctx context.Context, requested *uuid.UUID) ([]models.Order, error) {
+	sessionID, err := resolveSession(ctx, s.sessions, requested)
+	if err != nil {
+		return nil, err
+	}
+	return s.orders.List(ctx, sessionID)
+}
+
+// ブロードキャストする、営業中 or 直近のセッションのオーダー
+func (s *OrderService) Current(ctx context.Context) ([]models.Order, error) {
+	return s.List(ctx, nil)
+}
+
+func (s *OrderService) Get(ctx context.Context, id uuid.UUID) (*models.Order, error) {
+	order, err := s.orders.Get(ctx, id)
+	if err != nil {
+		return nil, notFound(err, models.ErrorCodeOrderNotFound, "Order not found")
+	}
+	return order, nil
+}
+
+// オーダーをアイテム・コメントと一緒に削除する
+// 承認済みの支払いを取り消し、オーダーと返金で動いた現金をレジの在庫から戻す
+func (s *OrderService) Delete(ctx context.Context, id uuid.UUID) error {
+	return s.tx.Transaction(ctx, func(repos *repository.Repositories) error {
+		order, err := repos.Orders.Lock(ctx, id)
+		if err != nil {
+			return notFound(err, models.ErrorCodeOrderNotFound, "Order not found")
+		}
+
+		// 現金で受け取った分（お釣りを除く）をレジから出し、現金で返金した分はレジに戻す
+		if order.SessionID != nil {
+			if _, _, err := SettleCashDrawer(ctx, repos.Drawers, *order.SessionID, order.Register, nil, CashDue(order)); err != nil {
+				return err
+			}
+		}
+		for _, r := range order.Refunds {
+			if r.Type != models.RefundTypeRefund || r.Method != payments.MethodCash || r.Amount <= 0 {
+				continue
+			}
+			returned, _ := breakdownAmount(r.Amount, nil)
+			if _, _, err := SettleCashDrawer(ctx, repos.Drawers, r.SessionID, r.Register, returned, 0); err != nil {
+				return err
+			}
+		}
+
+		if err := repos.Orders.Delete(ctx, id); err != nil {
+			return notFound(err, models.ErrorCodeOrderNotFound, "Order not found")
+		}
+
+		// 決済端末での取り消しは戻せないので最後に行い、取り消せなければ削除もやめる
+		voidPayments(ctx, s.payments, order.Payments)
+		for _, p := range order.Payments {
+			if p.Status == string(payments.StatusApproved) {
+				return fmt.Errorf("void %s payment %s: %s", p.Method, p.ExternalRef, p.Message)
+			}
+		}
+		return nil
+	})
+}
+
+// 準備完了を切り替える
+// 準備完了にしたら呼び出し画面で呼び出し、戻したら呼び出しも取り消す
+func (s *OrderService) ToggleReady(ctx context.Context, id uuid.UUID) (*models.Order, error) {
+	order, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if order.ReadyAt == nil {
+		now := s.now()
+		order.ReadyAt = &now
+		if order.ServedAt == nil && order.CalledAt == nil {
+			order.CalledAt = &now
+			order.LastCalledAt = &now
+		}
+	} else {
+		order.ReadyAt = nil
+		callscreen.Clear(order)
+	}
+	if err := s.orders.SaveProgress(ctx, order); err != nil {
+		return nil, err
+	}
+	return order, nil
+}
+
+// 提供済みを切り替える（提供済みにしたら準備完了にもする）
+func (s *OrderService) ToggleServed(ctx context.Context, id uuid.UUID) (*models.Order, error) {
+	order, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if order.ServedAt == nil {
+		now := s.now()
+		order.ServedAt = &now
+		order.ReadyAt = &now
+	} else {
+		order.ServedAt = nil
+		order.ReadyAt = nil
+		callscreen.Clear(order)
+	}
+	if err := s.orders.SaveProgress(ctx, order); err != nil {
+		return nil, err
+	}
+	return order, nil
+}
+
+// 呼び出し画面でオーダーを呼び出す
+func (s *OrderService) Call(ctx context.Context, id uuid.UUID) (*models.Order, error) {
+	return s.updateCall(ctx, id, callscreen.Call)
+}
+
+// 呼び出し画面でオーダーをもう一度呼び出す
+func (s *OrderService) Recall(ctx context.Context, id uuid.UUID) (*models.Order, error) {
+	return s.updateCall(ctx, id, callscreen.Recall)
+}
+
+func (s *OrderService) updateCall(ctx context.Context, id uuid.UUID, action func(order *models.Order, now time.Time) error) (*models.Order, error) {
+	order, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := action(order, s.now()); err != nil {
+		return nil, err
+	}
+	if err := s.orders.SaveProgress(ctx, order); err != nil {
+		return nil, err
+	}
+	return order, nil
+}
+
+// セッションの呼び出し画面の内容（sessionID が nil の場合は絞り込まない）
+func (s *OrderService) Callscreen(ctx context.Context, sessionID *uuid.UUID, cfg callscreen.Config) (callscreen.Snapshot, error) {
+	orders, err := s.orders.Callscreen(ctx, sessionID, cfg)
+	if err != nil {
+		return callscreen.Snapshot{}, err
+	}
+	return callscreen.Build(orders, cfg, s.now()), nil
+}
+
+// オーダー番号と追跡コードのオーダー（お客様向け）
+// 追跡コードが違う場合もオーダーがない場合と同じエラーにして、番号があるかどうかを明かさない
+func (s *OrderService) FindByTracking(ctx context.Context, number int, token string) (*models.Order, error) {
+	token = vouchers.Normalize(token)
+	if token == "" {
+		return nil, apierror.New(models.ErrorCodeOrderNotFound, "Order not found")
+	}
+	order, err := s.orders.FindByTracking(ctx, number, token)
+	if err != nil {
+		return nil, notFound(err, models.ErrorCodeOrderNotFound, "Order not found")
+	}
+	return order, nil
+}
+
+// オーダーのお客様向けの注文状況
+func (s *OrderService) Tracking(ctx context.Context, order *models.Order, cfg tracking.Config, screen callscreen.Config) (models.TrackingResponse, error) {
+	progress, err := s.orders.Tracking(ctx, order, cfg)
+	if err != nil {
+		return models.TrackingResponse{}, err
+	}
+	return cfg.Status(order, progress, screen, s.now()), nil
+}
+
+// 提供待ちの列（sessionID が nil の場合は絞り込まない）
+func (s *OrderService) Queue(ctx context.Context, sessionID *uuid.UUID) (models.QueueResponse, error) {
+	orders, err := s.orders.Queue(ctx, sessionID)
+	if err != nil {
+		return models.QueueResponse{}, err
+	}
+	return queue.Build(orders, s.now()), nil
+}
@@ -0,0 +1,144 @@
+// api/internal/service/order_test.go
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"cafeore-pos/api/internal/apierror"
+	"cafeore-pos/api/internal/models"
+	"cafeore-pos/api/internal/payments"
+	"cafeore-pos/api/internal/repository/memory"
+)
+
+func TestToggleReady(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	repos := store.Repositories()
+	now := time.Date(2025, 11, 1, 10, 0, 0, 0, time.Local)
+	s := NewOrderService(repos, nil, nil)
+	s.now = func() time.Time { return now }
+
+	order := store.AddOrder(models.Order{OrderId: 1})
+
+	ready, err := s.ToggleReady(ctx, order.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ready.ReadyAt == nil || !ready.ReadyAt.Equal(now) || ready.CalledAt == nil || ready.LastCalledAt == nil {
+		t.Fatalf("ready order = %+v, want ready and called at %v", ready, now)
+	}
+
+	// 呼び出しの回数も含めて取り消す
+	ready.RecallCount = 2
+	if err := repos.Orders.SaveProgress(ctx, ready); err != nil {
+		t.Fatal(err)
+	}
+	unready, err := s.ToggleReady(ctx, order.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if unready.ReadyAt != nil || unready.CalledAt != nil || unready.RecallCount != 0 {
+		t.Fatalf("unready order = %+v, want not ready and not called", unready)
+	}
+}
+
+func TestToggleReadyServed(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	repos := store.Repositories()
+	s := NewOrderService(repos, nil, nil)
+
+	order := store.AddOrder(models.Order{OrderId: 1})
+	if _, err := s.ToggleServed(ctx, order.ID); err != nil {
+		t.Fatal(err)
+	}
+	// 提供済みのオーダーは準備完了に戻しても呼び出さない
+	if _, err := s.ToggleReady(ctx, order.ID); err != nil {
+		t.Fatal(err)
+	}
+	got, err := s.ToggleReady(ctx, order.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.ServedAt == nil || got.CalledAt != nil {
+		t.Fatalf("order = %+v, want served and not called", got)
+	}
+}
+
+func TestOrderNotFound(t *testing.T) {
+	repos := memory.New().Repositories()
+	s := NewOrderService(repos, nil, nil)
+
+	_, err := s.ToggleServed(context.Background(), uuid.New())
+	var e *apierror.Error
+	if !errors.As(err, &e) || e.Code != models.ErrorCodeOrderNotFound {
+		t.Fatalf("err = %v, want ORDER_NOT_FOUND", err)
+	}
+}
+
+func TestDeleteOrder(t *testing.T) {
+	ctx := context.Background()
+	store := memory.New()
+	repos := store.Repositories()
+	terminal := payments.NewMockTerminal(0, time.Second)
+	s := NewOrderService(repos, payments.NewRegistry(payments.NewCashProvider(), terminal), nil)
+
+	sessionID := uuid.New()
+	stocks := []models.DrawerStock{}
+	for _, d := range YenDenominations {
+		stocks = append(stocks, models.DrawerStock{SessionID: sessionID, Register: "main", Denomination: d, Count: 5})
+	}
+	if err := repos.Drawers.Save(ctx, stocks); err != nil {
+		t.Fatal(err)
+	}
+
+	// 700円のうち400円を端末、300円を現金で払い、あとで100円を現金で返金したオーダー
+	charge, err := terminal.Authorize(ctx, payments.Request{OrderID: uuid.New(), Amount: 400})
+	if err != nil {
+		t.Fatal(err)
+	}
+	order := store.AddOrder(models.Order{
+		SessionID:     &sessionID,
+		Register:      "main",
+		OrderId:       1,
+		BillingAmount: 700,
+		Received:      300,
+		Payments: []models.Payment{
+			{Method: payments.MethodTerminal, Amount: 400, Status: string(charge.Status), ExternalRef: charge.ExternalRef},
+			{Method: payments.MethodCash, Amount: 300, Status: string(payments.StatusApproved)},
+		},
+		Refunds: []models.Refund{
+			{SessionID: sessionID, Register: "main", Type: models.RefundTypeRefund, Method: payments.MethodCash, Amount: 100},
+		},
+	})
+
+	if err := s.Delete(ctx, order.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repos.Orders.Get(ctx, order.ID); err == nil {
+		t.Fatal("order was not deleted")
+	}
+	// 端末の決済は取り消し済み
+	if err := terminal.Void(ctx, charge.ExternalRef); err == nil {
+		t.Error("terminal payment was not voided")
+	}
+	// 現金の300円を出し、返金の100円を戻す
+	got, err := repos.Drawers.List(ctx, sessionID, "main")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, stock := range got {
+		wantCount := 5
+		if stock.Denomination == 100 {
+			wantCount = 5 - 3 + 1
+		}
+		if stock.Count != wantCount {
+			t.Errorf("%d yen count = %d, want %d", stock.Denomination, stock.Count, wantCount)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+// api/internal/service/payment.go
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"cafeore-pos/api/internal/apierror"
+	"cafeore-pos/api/internal/models"
+	"cafeore-pos/api/internal/payments"
+)
+
+// キャッシュレス決済が承認されなかった（オーダーは作らない）
+// Payments は承認されなかったものを含む各支払いの結果
+type PaymentDeclinedError struct {
+	Payments []models.Payment
+}
+
+func (e *PaymentDeclinedError) Error() string {
+	return "Payment was not approved"
+}
+
+// 支払いの内訳を決める
+// 指定がなければ請求額の全額を現金で支払う
+func resolvePayments(registry *payments.Registry, billingAmount int, reqs *[]models.PaymentCreate) ([]models.PaymentCreate, error) {
+	if reqs == nil || len(*reqs) == 0 {
+		return []models.PaymentCreate{{Method: payments.MethodCash, Amount: billingAmount}}, nil
+	}
+	total := 0
+	for i, p := range *reqs {
+		if _, err := registry.Get(p.Method); err != nil {
+			return nil, apierror.Invalid(fmt.Sprintf("/payments/%d/method", i), fmt.Sprintf("%s: %s", err.Error(), p.Method))
+		}
+		if p.Amount <= 0 {
+			return nil, apierror.Invalid(fmt.Sprintf("/payments/%d/amount", i), "payment amount must be positive")
+		}
+		total += p.Amount
+	}
+	if total != billingAmount {
+		return nil, apierror.Invalid("/payments", fmt.Sprintf("payments total %d does not match billing_amount %d", total, billingAmount))
+	}
+	return *reqs, nil
+}
+
+// 支払いを順に承認する
+// 一つでも承認されなければ、それまでに承認したものを取り消して PaymentDeclinedError を返す
+func authorizePayments(ctx context.Context, registry *payments.Registry, orderID uuid.UUID, reqs []models.PaymentCreate, now func() time.Time) ([]models.Payment, error) {
+	results := make([]models.Payment, 0, len(reqs))
+	for _, p := range reqs {
+		provider, err := registry.Get(p.Method)
+		if err != nil {
+			return nil, err
+		}
+		req := payments.Request{OrderID: orderID, Amount: p.Amount}
+		if p.Simulate != nil {
+			req.Simulate = string(*p.Simulate)
+		}
+		result, err := provider.Authorize(ctx, req)
+		if err != nil {
+			voidPayments(ctx, registry, results)
+			return nil, err
+		}
+		results = append(results, models.Payment{
+			OrderID:     orderID,
+			Method:      p.Method,
+			Amount:      p.Amount,
+			Status:      string(result.Status),
+			ExternalRef: result.ExternalRef,
+			Message:     result.Message,
+			CreatedAt:   now(),
+		})
+		if result.Status != payments.StatusApproved {
+			voidPayments(ctx, registry, results)
+			return nil, &PaymentDeclinedError{Payments: results}
+		}
+	}
+	return results, nil
+}
+
+// 承認済みの支払いを取り消す
+func voidPayments(ctx context.Context, registry *payments.Registry, results []models.Payment) {
+	for i := range results {
+		if results[i].Status != string(payments.StatusApproved) {
+			continue
+		}
+		provider, err := registry.Get(results[i].Method)
+		if err != nil {
+			continue
+		}
+		if err := provider.Void(ctx, results[i].ExternalRef); err != nil {
+			results[i].Message = err.Error()
+			continue
+		}
+		results[i].Status = string(payments.StatusVoided)
+	}
+}
+
+// 支払いの内訳のうち現金で支払う金額（お預かりはこの金額に対するもの）
+func requestedCash(reqs []models.PaymentCreate) int {
+	total := 0
+	for _, p := range reqs {
+		if p.Method == payments.MethodCash {
+			total += p.Amount
+		}
+	}
+	return total
+}
+
+// 現金で支払った金額（支払いの記録がないオーダーは全額現金）
+// 承認されなかった支払いは数えない
+func CashDue(order *models.Order) int {
+	if len(order.Payments) == 0 {
+		return order.BillingAmount
+	}
+	total := 0
+	for _, p := range order.Payments {
+		if p.Method == payments.MethodCash && p.Status == string(payments.StatusApproved) {
+			total += p.Amount
+		}
+	}
+	return total
+}
@@ -0,0 +1,37 @@
+// api/internal/service/receipt.go
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	"cafeore-pos/api/internal/models"
+	"cafeore-pos/api/internal/repository"
+)
+
+// 領収書の発行
+type ReceiptService struct {
+	orders   repository.OrderRepository
+	receipts repository.ReceiptRepository
+	now      func() time.Time
+}
+
+func NewReceiptService(orders repository.OrderRepository, receipts repository.ReceiptRepository) *ReceiptService {
+	return &ReceiptService{orders: orders, receipts: receipts, now: time.Now}
+}
+
+// オーダーの領収書を発行する
+// 初回は領収書番号を採番し、2回目以降は同じ番号で出し直す
+func (s *ReceiptService) Issue(ctx context.Context, orderID uuid.UUID, addressee string) (*models.Order, *models.IssuedReceipt, error) {
+	order, err := s.orders.Get(ctx, orderID)
+	if err != nil {
+		return nil, nil, notFound(err, models.ErrorCodeOrderNotFound, "Order not found")
+	}
+	issued, err := s.receipts.Issue(ctx, order.ID, addressee, s.now())
+	if err != nil {
+		return nil, nil, err
+	}
+	return order, issued, nil
+}
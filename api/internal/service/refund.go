@@ -0,0 +1,203 @@
+// api/internal/service/refund.go
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"cafeore-pos/api/internal/apierror"
+	"cafeore-pos/api/internal/models"
+	"cafeore-pos/api/internal/payments"
+	"cafeore-pos/api/internal/repository"
+)
+
+// 提供済みオーダーの返金・作り直し
+type RefundService struct {
+	refunds  repository.RefundRepository
+	orders   repository.OrderRepository
+	sessions repository.SessionRepository
+	tx       repository.Transactor
+	now      func() time.Time
+}
+
+func NewRefundService(repos *repository.Repositories) *RefundService {
+	return &RefundService{
+		refunds:  repos.Refunds,
+		orders:   repos.Orders,
+		sessions: repos.Sessions,
+		tx:       repos.Tx,
+		now:      time.Now,
+	}
+}
+
+// セッション内の返金（sessionID が nil の場合は絞り込まない、古い順）
+func (s *RefundService) List(ctx context.Context, sessionID *uuid.UUID) ([]models.Refund, error) {
+	return s.refunds.List(ctx, sessionID)
+}
+
+// オーダーの返金（古い順）
+func (s *RefundService) ListByOrder(ctx context.Context, orderID uuid.UUID) ([]models.Refund, error) {
+	if _, err := s.orders.Get(ctx, orderID); err != nil {
+		return nil, notFound(err, models.ErrorCodeOrderNotFound, "Order not found")
+	}
+	return s.refunds.ListByOrder(ctx, orderID)
+}
+
+// 営業中のセッションに返金・作り直しを記録する
+// 返金の現金は返金した日のセッションのレジから出す
+func (s *RefundService) Create(ctx context.Context, orderID uuid.UUID, req models.RefundCreateRequest) (*models.Refund, error) {
+	session, err := openSession(ctx, s.sessions)
+	if err != nil {
+		return nil, err
+	}
+
+	refund := models.Refund{
+		OrderID:   orderID,
+		SessionID: session.ID,
+		Type:      string(req.Type),
+		Method:    payments.MethodCash,
+		Reason:    req.Reason,
+		Author:    req.Author,
+		CreatedAt: s.now(),
+	}
+	if req.Items != nil {
+		for _, item := range *req.Items {
+			refund.Items = append(refund.Items, models.RefundItem{OrderItemID: uuid.UUID(item.OrderItemId)})
+		}
+	}
+
+	switch req.Type {
+	case models.RefundCreateRequestTypeRemake:
+		if len(refund.Items) == 0 {
+			return nil, apierror.Invalid("/items", "items is required for remake")
+		}
+		if req.Amount != nil && *req.Amount != 0 {
+			return nil, apierror.Invalid("/amount", "remake cannot have amount")
+		}
+	case models.RefundCreateRequestTypeRefund:
+	default:
+		return nil, apierror.Invalid("/type", "Invalid type")
+	}
+
+	err = s.tx.Transaction(ctx, func(repos *repository.Repositories) error {
+		// 同じオーダーへの返金が同時に来ても返金できる残りを超えないよう、オーダーをロックしてから読む
+		order, err := repos.Orders.Lock(ctx, orderID)
+		if err != nil {
+			return notFound(err, models.ErrorCodeOrderNotFound, "Order not found")
+		}
+		if order.ServedAt == nil {
+			return apierror.New(models.ErrorCodeOrderNotServed, "Order is not served yet")
+		}
+
+		refund.Register = order.Register
+		if req.Register != nil && *req.Register != "" {
+			refund.Register = *req.Register
+		}
+		itemsTotal, err := validateRefundItems(order, refund.Items)
+		if err != nil {
+			return err
+		}
+		if req.Type == models.RefundCreateRequestTypeRefund {
+			refund.Amount = itemsTotal
+			if req.Amount != nil {
+				refund.Amount = *req.Amount
+			}
+			if refund.Amount <= 0 {
+				return apierror.Invalid("/amount", "amount must be positive")
+			}
+			if refund.Amount > order.BillingAmount-order.RefundedAmount() {
+				return apierror.Invalid("/amount", "amount exceeds the refundable amount")
+			}
+			method, remaining, err := resolveRefundMethod(order, req.Method)
+			if err != nil {
+				return err
+			}
+			if refund.Amount > remaining {
+				return apierror.Invalid("/amount", fmt.Sprintf("amount exceeds the amount paid with %s", method))
+			}
+			refund.Method = method
+		}
+
+		if err := repos.Refunds.Create(ctx, &refund); err != nil {
+			return err
+		}
+		// 現金で返金した分だけレジの在庫から出す（キャッシュレスの返金は決済端末で行う）
+		if refund.Amount > 0 && refund.Method == payments.MethodCash {
+			if _, _, err := SettleCashDrawer(ctx, repos.Drawers, session.ID, refund.Register, nil, refund.Amount); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &refund, nil
+}
+
+// 返金対象のオーダー内の行を検証してアイテムを埋め、返金額の既定値（対象の行の注文時の単価の合計）を返す
+// オーダー内の同じ行は返金・作り直しを合わせて一度しか対象にできない
+func validateRefundItems(order *models.Order, items []models.RefundItem) (int, error) {
+	lines := map[uuid.UUID]*models.OrderItem{}
+	for i := range order.OrderItems {
+		lines[order.OrderItems[i].ID] = &order.OrderItems[i]
+	}
+	used := map[uuid.UUID]bool{}
+	for _, r := range order.Refunds {
+		for _, ri := range r.Items {
+			used[ri.OrderItemID] = true
+		}
+	}
+
+	total := 0
+	for i := range items {
+		field := fmt.Sprintf("/items/%d/order_item_id", i)
+		oi, ok := lines[items[i].OrderItemID]
+		if !ok {
+			return 0, apierror.Invalid(field, fmt.Sprintf("Order item %s is not in the order", items[i].OrderItemID))
+		}
+		if used[oi.ID] {
+			return 0, apierror.Invalid(field, fmt.Sprintf("Order item %s is already refunded or remade", oi.ID))
+		}
+		used[oi.ID] = true
+		items[i].ItemID = oi.ItemID
+		total += oi.UnitPrice
+	}
+	return total, nil
+}
+
+// 返金する決済手段を決め、その手段で返金できる残りの金額を返す
+// 省略時はオーダーの支払いの決済手段（支払いの記録がないオーダーは現金）
+func resolveRefundMethod(order *models.Order, method *string) (string, int, error) {
+	remaining := map[string]int{}
+	for _, p := range order.Payments {
+		if p.Status == string(payments.StatusApproved) {
+			remaining[p.Method] += p.Amount
+		}
+	}
+	if len(order.Payments) == 0 {
+		remaining[payments.MethodCash] = order.BillingAmount
+	}
+	for _, r := range order.Refunds {
+		if _, ok := remaining[r.Method]; ok && r.Type == models.RefundTypeRefund {
+			remaining[r.Method] -= r.Amount
+		}
+	}
+
+	if method == nil || *method == "" {
+		if len(remaining) != 1 {
+			return "", 0, apierror.Invalid("/method", "method is required for orders paid with several methods")
+		}
+		for m, amount := range remaining {
+			return m, amount, nil
+		}
+	}
+	amount, ok := remaining[*method]
+	if !ok {
+		return "", 0, apierror.Invalid("/method", fmt.Sprintf("Order was not paid with %s", *method))
+	}
+	return *method, amount, nil
+}
@@ -0,0 +1,161 @@
+// api/internal/service/session.go
+//
+// 業務ロジック（ハンドラーから使う）
+// データの読み書きは repository のインターフェースを通すので、メモリ上のリポジトリでテストできる
+// エラーは apierror で返す
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"cafeore-pos/api/internal/apierror"
+	"cafeore-pos/api/internal/models"
+	"cafeore-pos/api/internal/repository"
+)
+
+// 営業日のセッションの開始・終了
+type SessionService struct {
+	sessions repository.SessionRepository
+	orders   repository.OrderRepository
+	tx       repository.Transactor
+	now      func() time.Time
+}
+
+func NewSessionService(sessions repository.SessionRepository, orders repository.OrderRepository, tx repository.Transactor) *SessionService {
+	return &SessionService{sessions: sessions, orders: orders, tx: tx, now: time.Now}
+}
+
+// 開始が新しい順
+func (s *SessionService) List(ctx context.Context) ([]models.Session, error) {
+	return s.sessions.List(ctx)
+}
+
+func (s *SessionService) Get(ctx context.Context, id uuid.UUID) (*models.Session, error) {
+	session, err := s.sessions.Get(ctx, id)
+	if err != nil {
+		return nil, notFound(err, models.ErrorCodeSessionNotFound, "Session not found")
+	}
+	return session, nil
+}
+
+// 営業中のセッション（なければ NO_OPEN_SESSION）
+func (s *SessionService) Current(ctx context.Context) (*models.Session, error) {
+	return openSession(ctx, s.sessions)
+}
+
+// requested が指定されていればそのセッション、なければ営業中のセッション
+func (s *SessionService) GetOrCurrent(ctx context.Context, requested *uuid.UUID) (*models.Session, error) {
+	if requested != nil {
+		return s.Get(ctx, *requested)
+	}
+	return s.Current(ctx)
+}
+
+// 一覧系で対象にするセッション（nil の場合は絞り込まない）
+func (s *SessionService) Resolve(ctx context.Context, requested *uuid.UUID) (*uuid.UUID, error) {
+	return resolveSession(ctx, s.sessions, requested)
+}
+
+// セッション内で次に採番するオーダー番号
+func (s *SessionService) NextOrderID(ctx context.Context, sessionID uuid.UUID) (int, error) {
+	return s.orders.NextNumber(ctx, sessionID)
+}
+
+// セッションを開始する
+// 営業中のセッションは同時に一つまで（idx_sessions_open でも保証する）。開始時の釣り銭準備金はデフォルトのレジに入れる
+func (s *SessionService) Open(ctx context.Context, name string, openingFloat int) (*models.Session, error) {
+	session := models.Session{
+		Name:         name,
+		OpenedAt:     s.now(),
+		OpeningFloat: openingFloat,
+	}
+	err := s.tx.Transaction(ctx, func(repos *repository.Repositories) error {
+		open, err := repos.Sessions.Open(ctx)
+		if err == nil {
+			return apierror.Newf(models.ErrorCodeSessionAlreadyOpen, "Session %q is already open", open.Name)
+		}
+		if !errors.Is(err, repository.ErrNotFound) {
+			return err
+		}
+		if err := repos.Sessions.Create(ctx, &session); err != nil {
+			return err
+		}
+		if session.OpeningFloat > 0 {
+			return repos.CashMovements.Create(ctx, &models.CashMovement{
+				SessionID: session.ID,
+				Register:  models.DefaultRegister,
+				Type:      models.CashMovementFloat,
+				Amount:    session.OpeningFloat,
+				Reason:    "opening float",
+				Author:    "system",
+				CreatedAt: session.OpenedAt,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// セッションを終了する
+func (s *SessionService) Close(ctx context.Context, id uuid.UUID) (*models.Session, error) {
+	session, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !session.IsOpen() {
+		return nil, apierror.New(models.ErrorCodeSessionAlreadyClosed, "Session is already closed")
+	}
+	now := s.now()
+	session.ClosedAt = &now
+	if err := s.sessions.Update(ctx, session); err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+// 一覧系で対象にするセッションを決める
+// session_id が指定されていればそれを、なければ営業中のセッション、
+// 営業中のセッションがなければ直近のセッションを使う
+// セッションが一つもない場合は nil を返す（絞り込みなし）
+func resolveSession(ctx context.Context, sessions repository.SessionRepository, requested *uuid.UUID) (*uuid.UUID, error) {
+	if requested != nil {
+		session, err := sessions.Get(ctx, *requested)
+		if err != nil {
+			return nil, notFound(err, models.ErrorCodeSessionNotFound, "Session not found")
+		}
+		return &session.ID, nil
+	}
+
+	session, err := sessions.Latest(ctx)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &session.ID, nil
+}
+
+// 営業中のセッション（なければ NO_OPEN_SESSION）
+func openSession(ctx context.Context, sessions repository.SessionRepository) (*models.Session, error) {
+	session, err := sessions.Open(ctx)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, apierror.New(models.ErrorCodeNoOpenSession, "No open session")
+	}
+	return session, err
+}
+
+// 見つからない場合は指定した種類のエラーにする
+func notFound(err error, code apierror.Code, message string) error {
+	if errors.Is(err, repository.ErrNotFound) {
+		return apierror.New(code, message)
+	}
+	return err
+}
@@ -0,0 +1,119 @@
+// api/internal/tracking/tracking.go
+//
+// お客様向けの注文状況（オーダー番号と追跡コードで確認する）
+// 受け取った金額などは返さず、状態・待ち順・目安の時刻だけを返す
+package tracking
+
+import (
+	"os"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+
+	"cafeore-pos/api/internal/callscreen"
+	"cafeore-pos/api/internal/models"
+	"cafeore-pos/api/internal/queue"
+	"cafeore-pos/api/internal/vouchers"
+)
+
+// 追跡コードの長さ
+const TokenLength = 6
+
+// 注文状況の設定
+type Config struct {
+	// 直近の実績がないときの一つあたりの提供間隔の目安
+	DefaultPace time.Duration
+	// 提供間隔の実績に使う直近のオーダー数
+	PaceSamples int
+	// 一つの IP アドレスから1分間に受け付ける回数
+	RateLimit int
+	// 変化がなくても SSE で送り直す間隔（目安の時刻を更新するため）
+	RefreshInterval time.Duration
+}
+
+// 環境変数から設定を読む
+//
+//	TRACKING_DEFAULT_PACE, TRACKING_RATE_LIMIT
+func ConfigFromEnv() Config {
+	cfg := Config{
+		DefaultPace:     2 * time.Minute,
+		PaceSamples:     10,
+		RateLimit:       30,
+		RefreshInterval: 30 * time.Second,
+	}
+	if d, err := time.ParseDuration(os.Getenv("TRACKING_DEFAULT_PACE")); err == nil && d > 0 {
+		cfg.DefaultPace = d
+	}
+	if n, err := strconv.Atoi(os.Getenv("TRACKING_RATE_LIMIT")); err == nil && n > 0 {
+		cfg.RateLimit = n
+	}
+	return cfg
+}
+
+// オーダーの追跡コードを作る
+func NewToken() (string, error) {
+	return vouchers.RandomCode(TokenLength)
+}
+
+// 準備中のオーダーの待ち順と目安の時刻を出すのに使うデータ
+type Progress struct {
+	// 準備中のオーダーのうち先に注文されたものの数
+	Ahead int
+	// 直近に提供可能になった時刻（新しい順に PaceSamples+1 件まで）
+	RecentReadyAt []time.Time
+}
+
+// オーダーの待ち順と提供間隔の実績を読み込む
+func (c Config) Load(db *gorm.DB, order *models.Order) (Progress, error) {
+	var progress Progress
+	var err error
+	if progress.Ahead, err = queue.Ahead(queue.Preparing(db, order.SessionID), order); err != nil {
+		return Progress{}, err
+	}
+	q := db.Model(&models.Order{}).Where("ready_at IS NOT NULL")
+	if order.SessionID != nil {
+		q = q.Where("session_id = ?", *order.SessionID)
+	}
+	if err := q.Order("ready_at DESC").Limit(c.PaceSamples+1).Pluck("ready_at", &progress.RecentReadyAt).Error; err != nil {
+		return Progress{}, err
+	}
+	return progress, nil
+}
+
+// 直近に提供可能になったオーダーの間隔の平均
+// 実績が足りなければ DefaultPace を使う
+func (c Config) pace(readyAt []time.Time) time.Duration {
+	if len(readyAt) < 2 {
+		return c.DefaultPace
+	}
+	return readyAt[0].Sub(readyAt[len(readyAt)-1]) / time.Duration(len(readyAt)-1)
+}
+
+// オーダーの注文状況
+func (c Config) Status(order *models.Order, progress Progress, screen callscreen.Config, now time.Time) models.TrackingResponse {
+	resp := models.TrackingResponse{
+		OrderNumber: order.OrderId,
+		UpdatedAt:   now,
+	}
+	switch {
+	case order.ServedAt != nil:
+		resp.Status = models.Served
+	case screen.Calling(order, now):
+		resp.Status = models.Calling
+	case order.ReadyAt != nil:
+		resp.Status = models.Ready
+	default:
+		resp.Status = models.Preparing
+	}
+	if resp.Status != models.Preparing {
+		return resp
+	}
+
+	// 準備中のオーダーの中での順番
+	position := progress.Ahead + 1
+	eta := now.Add(c.pace(progress.RecentReadyAt) * time.Duration(position))
+	resp.QueuePosition = &position
+	resp.EstimatedReadyAt = &eta
+	return resp
+}
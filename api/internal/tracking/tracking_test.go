@@ -0,0 +1,62 @@
+// api/internal/tracking/tracking_test.go
+package tracking
+
+import (
+	"testing"
+	"time"
+
+	"cafeore-pos/api/internal/callscreen"
+	"cafeore-pos/api/internal/models"
+)
+
+var base = time.Date(2025, 11, 1, 10, 0, 0, 0, time.UTC)
+
+func TestPace(t *testing.T) {
+	cfg := Config{DefaultPace: 2 * time.Minute}
+	// 実績が一つ以下なら目安を使う
+	if got := cfg.pace([]time.Time{base}); got != 2*time.Minute {
+		t.Errorf("pace = %v, want default", got)
+	}
+	// 新しい順の時刻の間隔の平均
+	readyAt := []time.Time{base.Add(9 * time.Minute), base.Add(5 * time.Minute), base}
+	if got := cfg.pace(readyAt); got != 270*time.Second {
+		t.Errorf("pace = %v, want 4m30s", got)
+	}
+}
+
+func TestStatus(t *testing.T) {
+	cfg := Config{DefaultPace: 2 * time.Minute}
+	screen := callscreen.Config{Expiry: 10 * time.Minute}
+	now := base.Add(20 * time.Minute)
+
+	resp := cfg.Status(&models.Order{OrderId: 5}, Progress{Ahead: 2}, screen, now)
+	if resp.Status != models.Preparing || resp.OrderNumber != 5 || resp.QueuePosition == nil || *resp.QueuePosition != 3 {
+		t.Fatalf("status = %+v, want preparing at 3", resp)
+	}
+	// 3番目なので提供間隔の3つ分後
+	if want := now.Add(6 * time.Minute); !resp.EstimatedReadyAt.Equal(want) {
+		t.Errorf("estimated = %v, want %v", resp.EstimatedReadyAt, want)
+	}
+
+	readyAt := base.Add(5 * time.Minute)
+	calledAt := base.Add(15 * time.Minute)
+	expired := base.Add(5 * time.Minute)
+	servedAt := now
+	tests := []struct {
+		name  string
+		order models.Order
+		want  models.TrackingStatus
+	}{
+		{"ready", models.Order{ReadyAt: &readyAt}, models.Ready},
+		{"calling", models.Order{ReadyAt: &readyAt, CalledAt: &calledAt, LastCalledAt: &calledAt}, models.Calling},
+		// 呼び出しの期限が切れたら提供可能に戻す
+		{"call expired", models.Order{ReadyAt: &readyAt, CalledAt: &expired, LastCalledAt: &expired}, models.Ready},
+		{"served", models.Order{ReadyAt: &readyAt, ServedAt: &servedAt}, models.Served},
+	}
+	for _, tt := range tests {
+		resp := cfg.Status(&tt.order, Progress{}, screen, now)
+		if resp.Status != tt.want || resp.QueuePosition != nil || resp.EstimatedReadyAt != nil {
+			t.Errorf("%s: status = %+v, want %s without position", tt.name, resp, tt.want)
+		}
+	}
+}
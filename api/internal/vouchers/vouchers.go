@@ -0,0 +1,203 @@
+// api/internal/vouchers/vouchers.go
+package vouchers
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"cafeore-pos/api/internal/models"
+)
+
+// 読み間違えやすい文字（0, O, 1, I, L）を除いた英数字
+const alphabet = "23456789ABCDEFGHJKMNPQRSTUVWXYZ"
+
+// コードの長さ（表示するときは4文字ごとにハイフンを入れる）
+const CodeLength = 8
+
+// 一度に発行できる枚数の上限
+const MaxBatchSize = 10000
+
+var (
+	ErrNotFound        = errors.New("Voucher not found")
+	ErrAlreadyRedeemed = errors.New("Voucher is already redeemed")
+	ErrExpired         = errors.New("Voucher is expired")
+)
+
+// 入力されたコードを保存している形（ハイフン・空白なしの大文字）にする
+func Normalize(code string) string {
+	code = strings.ToUpper(code)
+	return strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' {
+			return -1
+		}
+		return r
+	}, code)
+}
+
+// 印刷用に4文字ごとにハイフンを入れる
+func Format(code string) string {
+	var b strings.Builder
+	for i, r := range code {
+		if i > 0 && i%4 == 0 {
+			b.WriteByte('-')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// 読み間違えにくい文字で length 文字のランダムなコードを作る
+func RandomCode(length int) (string, error) {
+	b := make([]byte, length)
+	max := big.NewInt(int64(len(alphabet)))
+	for i := range b {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		b[i] = alphabet[n.Int64()]
+	}
+	return string(b), nil
+}
+
+// 割引の内容を持つバッチを作り、count 枚のクーポンを発行する
+// 既存のコードと重ならないように作り直す
+func Issue(db *gorm.DB, batch *models.VoucherBatch, count int) error {
+	if count < 1 || count > MaxBatchSize {
+		return fmt.Errorf("count must be between 1 and %d", MaxBatchSize)
+	}
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(batch).Error; err != nil {
+			return err
+		}
+
+		codes := map[string]bool{}
+		for len(codes) < count {
+			pending := []string{}
+			for len(codes)+len(pending) < count {
+				code, err := RandomCode(CodeLength)
+				if err != nil {
+					return err
+				}
+				if !codes[code] {
+					pending = append(pending, code)
+				}
+			}
+			var taken []string
+			if err := tx.Model(&models.Voucher{}).Where("code IN ?", pending).Pluck("code", &taken).Error; err != nil {
+				return err
+			}
+			used := map[string]bool{}
+			for _, code := range taken {
+				used[code] = true
+			}
+			for _, code := range pending {
+				if !used[code] {
+					codes[code] = true
+				}
+			}
+		}
+
+		vouchers := make([]models.Voucher, 0, count)
+		for code := range codes {
+			vouchers = append(vouchers, models.Voucher{BatchID: batch.ID, Code: code})
+		}
+		return tx.CreateInBatches(vouchers, 500).Error
+	})
+}
+
+// コードからクーポンを探す（バッチもロードする）
+func Find(db *gorm.DB, code string) (*models.Voucher, error) {
+	var voucher models.Voucher
+	if err := db.Preload("Batch").Preload("RedeemedOrder").First(&voucher, "code = ?", Normalize(code)).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return &voucher, nil
+}
+
+// クーポンが at の時点で使えるか（バッチをロード済みのクーポン）
+func Check(voucher *models.Voucher, at time.Time) error {
+	if voucher.Redeemed() {
+		return ErrAlreadyRedeemed
+	}
+	if voucher.Batch.Expired(at) {
+		return ErrExpired
+	}
+	return nil
+}
+
+// クーポンを使用済みにする
+// 未使用の場合だけ更新するので、同時に使われても一つのオーダーだけが成功する
+func Redeem(tx *gorm.DB, voucher *models.Voucher, order *models.Order) error {
+	result := tx.Model(&models.Voucher{}).
+		Where("id = ? AND redeemed_order_id IS NULL", voucher.ID).
+		Updates(map[string]interface{}{
+			"redeemed_order_id": order.ID,
+			"redeemed_at":       order.CreatedAt,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrAlreadyRedeemed
+	}
+	voucher.RedeemedOrderID = &order.ID
+	voucher.RedeemedAt = &order.CreatedAt
+	return nil
+}
+
+// クーポンの割引を割引ルールとして返す
+// 通常の割引ルールの後に適用する
+func Rule(voucher *models.Voucher) models.Promotion {
+	b := &voucher.Batch
+	return models.Promotion{
+		ID:           b.ID,
+		Name:         fmt.Sprintf("クーポン %s (%s)", b.Name, Format(voucher.Code)),
+		Type:         b.Type,
+		Active:       true,
+		Priority:     math.MaxInt,
+		Amount:       b.Amount,
+		Percent:      b.Percent,
+		ItemTypeID:   b.ItemTypeID,
+		ItemID:       b.ItemID,
+		BuyQuantity:  b.BuyQuantity,
+		FreeQuantity: b.FreeQuantity,
+		MinSubtotal:  b.MinSubtotal,
+		CreatedAt:    b.CreatedAt,
+	}
+}
+
+// バッチごとの使用状況
+type Stats struct {
+	Issued   int
+	Redeemed int
+	// 使用したオーダーのクーポンによる割引額の合計
+	DiscountTotal int
+}
+
+func BatchStats(db *gorm.DB, batchID uuid.UUID) (Stats, error) {
+	var stats Stats
+	if err := db.Raw(`
+SELECT COUNT(*) AS issued, COUNT(redeemed_order_id) AS redeemed
+FROM vouchers WHERE batch_id = ?`, batchID).Scan(&stats).Error; err != nil {
+		return stats, err
+	}
+	if err := db.Raw(`
+SELECT COALESCE(SUM((p->>'amount')::int), 0)
+FROM orders, jsonb_array_elements(orders.applied_promotions) AS p
+WHERE p->>'promotion_id' = ?::text`, batchID).Scan(&stats.DiscountTotal).Error; err != nil {
+		return stats, err
+	}
+	return stats, nil
+}
@@ -0,0 +1,70 @@
+import type { components } from "../types/api";
+
+export type ErrorCode = components["schemas"]["ErrorCode"];
+export type ErrorResponse = components["schemas"]["ErrorResponse"];
+export type FieldError = components["schemas"]["FieldError"];
+
+/**
+ * エラーの種類ごとの表示用メッセージ
+ */
+export const errorMessages: Record<ErrorCode, string> = {
+  VALIDATION_FAILED: "入力内容に誤りがあります",
+  NOT_FOUND: "見つかりませんでした",
+  ORDER_NOT_FOUND: "オーダーが見つかりません",
+  ITEM_NOT_FOUND: "アイテムが見つかりません",
+  ITEM_TYPE_NOT_FOUND: "アイテムの種類が見つかりません",
+  MODIFIER_GROUP_NOT_FOUND: "選択肢グループが見つかりません",
+  PRICE_NOT_FOUND: "価格変更が見つかりません",
+  PROMOTION_NOT_FOUND: "割引ルールが見つかりません",
+  VOUCHER_NOT_FOUND: "クーポンが見つかりません",
+  VOUCHER_BATCH_NOT_FOUND: "クーポンのバッチが見つかりません",
+  SESSION_NOT_FOUND: "セッションが見つかりません",
+  CLOSEOUT_NOT_FOUND: "レジ締めが見つかりません",
+  PRINT_JOB_NOT_FOUND: "印刷ジョブが見つかりません",
+  NO_OPEN_SESSION: "営業中のセッションがありません",
+  SESSION_ALREADY_OPEN: "別のセッションが営業中です",
+  SESSION_ALREADY_CLOSED: "セッションは終了しています",
+  CLOSEOUT_EXISTS: "このレジはすでにレジ締めしています",
+  ORDER_NUMBER_IN_USE: "このオーダー番号はすでに使われています",
+  ORDER_NOT_SERVED: "オーダーがまだ提供されていません",
+  ORDER_ALREADY_SERVED: "オーダーはすでに提供されています",
+  ORDER_ALREADY_CALLED: "オーダーはすでに呼び出しています",
+  ORDER_NOT_CALLED: "オーダーはまだ呼び出していません",
+  PRICE_ALREADY_EFFECTIVE: "この価格変更はすでに有効になっています",
+  PRINT_JOB_NOT_FAILED: "この印刷ジョブは失敗していません",
+  ITEM_UNAVAILABLE: "注文できない商品が含まれています",
+  DISCOUNT_ORDER_NOT_FOUND: "割引に使うオーダーが見つかりません",
+  DISCOUNT_ALREADY_USED: "この割引はすでに使用されています",
+  VOUCHER_ALREADY_REDEEMED: "このクーポンはすでに使用されています",
+  VOUCHER_EXPIRED: "クーポンの有効期限が切れています",
+  VOUCHER_NOT_APPLICABLE: "このクーポンはこのオーダーに使えません",
+  BILLING_AMOUNT_MISMATCH: "請求額が一致しません",
+  INSUFFICIENT_PAYMENT: "お預かりが足りません",
+  RATE_LIMITED: "しばらく待ってから再度お試しください",
+  INTERNAL: "サーバーでエラーが発生しました",
+};
+
+/**
+ * API が返したエラー
+ * message は表示用の日本語、detail はサーバーのメッセージ（英語）
+ */
+export class ApiError extends Error {
+  constructor(
+    readonly status: number,
+    readonly code: ErrorCode,
+    readonly detail: string,
+    readonly fields: FieldError[] = [],
+  ) {
+    super(errorMessages[code] ?? detail);
+    this.name = "ApiError";
+  }
+}
+
+export function isErrorResponse(body: unknown): body is ErrorResponse {
+  return (
+    typeof body === "object" &&
+    body !== null &&
+    typeof (body as ErrorResponse).error === "string" &&
+    typeof (body as ErrorResponse).code === "string"
+  );
+}
@@ -1,3 +1,4 @@
+export * from "./api-error";
 export * from "./custom-zod";
 export * from "./discount-validation";
 export * from "./typeguard";
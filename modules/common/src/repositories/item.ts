@@ -4,6 +4,7 @@ import {
   itemToUpdateRequest,
   responseToItemEntity,
 } from "../firebase-utils";
+import { ApiError, isErrorResponse } from "../lib/api-error";
 import { type WithId, hasId } from "../lib/typeguard";
 import type { ItemEntity } from "../models/item";
 import type { paths } from "../types/api";
@@ -26,7 +27,7 @@ export const itemRepoFactory = (): ItemRepository => {
     });
 
     if (error || !response.ok) {
-      await throwApiError(response, "Failed to update item");
+      await throwApiError(response, "Failed to update item", error);
     }
 
     return responseToItemEntity(data);
@@ -38,7 +39,7 @@ export const itemRepoFactory = (): ItemRepository => {
     });
 
     if (error || !response.ok) {
-      await throwApiError(response, "Failed to create item");
+      await throwApiError(response, "Failed to create item", error);
     }
 
     return responseToItemEntity(data);
@@ -60,7 +61,7 @@ export const itemRepoFactory = (): ItemRepository => {
       });
 
       if (error || !response.ok) {
-        await throwApiError(response, "Failed to delete item");
+        await throwApiError(response, "Failed to delete item", error);
       }
     },
 
@@ -96,14 +97,22 @@ export const itemRepoFactory = (): ItemRepository => {
 
 export const itemRepository: ItemRepository = itemRepoFactory();
 
+/**
+ * API のエラーを投げる
+ * body には openapi-fetch が読み込んだエラーの本文を渡す（レスポンスの本文は読み込み済みのため）
+ */
 export async function throwApiError(
   response: Response,
   fallback: string,
+  body: unknown,
 ): Promise<never> {
-  try {
-    const body = (await response.json()) as { error?: string };
-    throw new Error(body.error ?? fallback);
-  } catch {
-    throw new Error(fallback);
+  if (isErrorResponse(body)) {
+    throw new ApiError(
+      response.status,
+      body.code,
+      body.error,
+      body.details ?? [],
+    );
   }
+  throw new Error(fallback);
 }
@@ -52,7 +52,7 @@ export const itemTypeRepoFactory = (): ItemTypeRepository => {
     });
 
     if (error || !response.ok) {
-      await throwApiError(response, "Failed to update item");
+      await throwApiError(response, "Failed to update item", error);
     }
 
     return responseToItemType(data);
@@ -64,7 +64,7 @@ export const itemTypeRepoFactory = (): ItemTypeRepository => {
     });
 
     if (error || !response.ok) {
-      await throwApiError(response, "Failed to create item");
+      await throwApiError(response, "Failed to create item", error);
     }
 
     return responseToItemType(data);
@@ -86,7 +86,7 @@ export const itemTypeRepoFactory = (): ItemTypeRepository => {
       });
 
       if (error || !response.ok) {
-        await throwApiError(response, "Failed to delete itemType");
+        await throwApiError(response, "Failed to delete itemType", error);
       }
     },
 
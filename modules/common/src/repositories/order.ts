@@ -28,7 +28,7 @@ export const orderRepoFactory = (): OrderRepository => {
     });
 
     if (error || !response.ok) {
-      await throwApiError(response, "Failed to update item");
+      await throwApiError(response, "Failed to update item", error);
     }
 
     return responseToOrderEntity(data);
@@ -40,7 +40,7 @@ export const orderRepoFactory = (): OrderRepository => {
     });
 
     if (error || !response.ok) {
-      await throwApiError(response, "Failed to create item");
+      await throwApiError(response, "Failed to create item", error);
     }
 
     const returnedOrder = responseToOrderEntity(data);
@@ -69,7 +69,7 @@ export const orderRepoFactory = (): OrderRepository => {
       );
 
       if (error || !response.ok) {
-        await throwApiError(response, "Failed to mark order as ready");
+        await throwApiError(response, "Failed to mark order as ready", error);
       }
     },
 
@@ -84,7 +84,7 @@ export const orderRepoFactory = (): OrderRepository => {
       );
 
       if (error || !response.ok) {
-        await throwApiError(response, "Failed to mark order as served");
+        await throwApiError(response, "Failed to mark order as served", error);
       }
     },
 
@@ -104,7 +104,7 @@ export const orderRepoFactory = (): OrderRepository => {
       );
 
       if (error || !response.ok) {
-        await throwApiError(response, "Failed to add comment");
+        await throwApiError(response, "Failed to add comment", error);
       }
     },
 
@@ -22,6 +22,34 @@ export interface paths {
     /** アイテム削除 */
     delete: operations["deleteItem"];
   };
+  "/api/items/{id}/prices": {
+    /** アイテムの価格履歴・予定取得 */
+    get: operations["getItemPrices"];
+    /** 価格変更の登録 */
+    post: operations["createItemPrice"];
+  };
+  "/api/items/{id}/prices/{price_id}": {
+    /** 予定された価格変更の取り消し */
+    delete: operations["deleteItemPrice"];
+  };
+  "/api/items/{id}/modifier-groups": {
+    /** アイテムで選べる選択肢グループ取得 */
+    get: operations["getItemModifierGroups"];
+  };
+  "/api/modifier-groups": {
+    /** 選択肢グループ一覧取得 */
+    get: operations["getModifierGroups"];
+    /** 選択肢グループ作成 */
+    post: operations["createModifierGroup"];
+  };
+  "/api/modifier-groups/{id}": {
+    /** 選択肢グループ取得 */
+    get: operations["getModifierGroup"];
+    /** 選択肢グループ更新 */
+    put: operations["updateModifierGroup"];
+    /** 選択肢グループ削除 */
+    delete: operations["deleteModifierGroup"];
+  };
   "/api/item-types": {
     /** アイテムタイプ一覧取得 */
     get: operations["getItemTypes"];
@@ -56,7 +84,7 @@ export interface paths {
   };
   "/api/orders/{id}/served": {
     /** オーダーを提供完了にする */
-    patch: operations["markOrderServe"];
+    patch: operations["markOrderServed"];
   };
   "/api/orders/{id}/comments": {
     /** 特定オーダーのコメント一覧取得 */
@@ -66,9 +94,181 @@ export interface paths {
   };
   "/api/master-status": {
     /** マスターステート取得 */
-    get: operations["getMasterState"];
+    get: operations["getMasterStatus"];
     /** マスターステート更新 */
-    post: operations["updateMasterState"];
+    post: operations["updateMasterStatus"];
+  };
+  "/api/sessions": {
+    /** セッション一覧取得 */
+    get: operations["getSessions"];
+    /** セッション開始 */
+    post: operations["openSession"];
+  };
+  "/api/sessions/current": {
+    /** 営業中のセッション取得 */
+    get: operations["getCurrentSession"];
+  };
+  "/api/sessions/{id}": {
+    /** idからセッション情報取得 */
+    get: operations["getSession"];
+  };
+  "/api/sessions/{id}/close": {
+    /** セッション終了 */
+    patch: operations["closeSession"];
+  };
+  "/api/cash/summary": {
+    /** レジごとの現金集計取得 */
+    get: operations["getCashSummary"];
+  };
+  "/api/cash/movements": {
+    /** 入出金一覧取得 */
+    get: operations["getCashMovements"];
+    /** 入出金登録 */
+    post: operations["createCashMovement"];
+  };
+  "/api/cash/closeouts": {
+    /** 締め処理レポート一覧取得 */
+    get: operations["getCashCloseouts"];
+    /** 締め処理（金種別の実査と差異の記録） */
+    post: operations["createCashCloseout"];
+  };
+  "/api/cash/closeouts/{id}": {
+    /** idから締め処理レポート取得 */
+    get: operations["getCashCloseout"];
+  };
+  "/api/cash/drawer": {
+    /** レジ内の金種別在庫取得 */
+    get: operations["getDrawerStock"];
+    /** レジ内の金種別在庫を登録（実査・補充） */
+    put: operations["updateDrawerStock"];
+  };
+  "/api/orders/{id}/refunds": {
+    /** 特定オーダーの返金・作り直し一覧取得 */
+    get: operations["getOrderRefunds"];
+    /** 返金・作り直しの記録 */
+    post: operations["createOrderRefund"];
+  };
+  "/api/refunds": {
+    /** 返金・作り直し一覧取得 */
+    get: operations["getRefunds"];
+  };
+  "/api/orders/{id}/payments": {
+    /** 特定オーダーの支払い一覧取得 */
+    get: operations["getOrderPayments"];
+  };
+  "/api/payment-methods": {
+    /** 利用できる決済手段の一覧取得 */
+    get: operations["getPaymentMethods"];
+  };
+  "/api/reports/summary": {
+    /** 売上サマリー取得 */
+    get: operations["getSalesSummaryReport"];
+  };
+  "/api/reports/items": {
+    /** アイテム別売上取得 */
+    get: operations["getItemSalesReport"];
+  };
+  "/api/reports/item-types": {
+    /** アイテム種別ごとの売上取得 */
+    get: operations["getItemTypeSalesReport"];
+  };
+  "/api/reports/timeline": {
+    /** 時間帯別売上取得 */
+    get: operations["getSalesTimelineReport"];
+  };
+  "/api/reports/throughput": {
+    /** 提供時間の統計取得 */
+    get: operations["getThroughputReport"];
+  };
+  "/api/export/{dataset}": {
+    /** オーダー・アイテム・コメント・マスターステート履歴のエクスポート */
+    get: operations["exportDataset"];
+  };
+  "/api/promotions": {
+    /** 割引ルール一覧取得 */
+    get: operations["getPromotions"];
+    /** 割引ルール作成 */
+    post: operations["createPromotion"];
+  };
+  "/api/promotions/evaluate": {
+    /** 割引の計算 */
+    post: operations["evaluatePromotions"];
+  };
+  "/api/promotions/{id}": {
+    /** 割引ルール取得 */
+    get: operations["getPromotion"];
+    /** 割引ルール更新 */
+    put: operations["updatePromotion"];
+    /** 割引ルール削除 */
+    delete: operations["deletePromotion"];
+  };
+  "/api/voucher-batches": {
+    /** クーポンのバッチ一覧取得 */
+    get: operations["getVoucherBatches"];
+    /** クーポンのバッチ作成 */
+    post: operations["createVoucherBatch"];
+  };
+  "/api/voucher-batches/{id}": {
+    /** クーポンのバッチ取得 */
+    get: operations["getVoucherBatch"];
+  };
+  "/api/voucher-batches/{id}/vouchers": {
+    /** バッチのクーポン一覧取得 */
+    get: operations["getBatchVouchers"];
+  };
+  "/api/voucher-batches/{id}/export": {
+    /** クーポンコードのエクスポート */
+    get: operations["exportVoucherBatch"];
+  };
+  "/api/vouchers/{code}": {
+    /** クーポンコードの確認 */
+    get: operations["getVoucher"];
+  };
+  "/api/orders/{id}/labels": {
+    /** ラベル・レシートの印刷データ取得 */
+    get: operations["getOrderLabels"];
+  };
+  "/api/queue": {
+    /** 提供待ちの列の取得 */
+    get: operations["getQueue"];
+  };
+  "/api/callscreen": {
+    /** 呼び出し画面の表示内容取得 */
+    get: operations["getCallscreen"];
+  };
+  "/api/orders/{id}/call": {
+    /** オーダーを呼び出す */
+    post: operations["callOrder"];
+  };
+  "/api/orders/{id}/recall": {
+    /** オーダーを再呼び出しする */
+    post: operations["recallOrder"];
+  };
+  "/api/track/{order_number}": {
+    /** お客様向けの注文状況取得 */
+    get: operations["trackOrder"];
+  };
+  "/api/track/{order_number}/events": {
+    /** お客様向けの注文状況の購読 */
+    get: operations["trackOrderEvents"];
+  };
+  "/api/orders/{id}/receipt": {
+    /** 領収書の発行 */
+    get: operations["getOrderReceipt"];
+  };
+  "/api/print-jobs": {
+    /** 印刷ジョブ一覧取得 */
+    get: operations["getPrintJobs"];
+    /** 再印刷 */
+    post: operations["createPrintJob"];
+  };
+  "/api/print-jobs/{id}": {
+    /** 印刷ジョブ取得 */
+    get: operations["getPrintJob"];
+  };
+  "/api/print-jobs/{id}/retry": {
+    /** 失敗した印刷ジョブの再試行 */
+    post: operations["retryPrintJob"];
   };
 }
 
@@ -94,6 +294,10 @@ export interface components {
       price: number;
       key: string;
       item_type: components["schemas"]["ItemTypeResponse"];
+      /** @description false の場合は売り切れなどで注文できない */
+      available: boolean;
+      /** @description セット商品の構成アイテム（セット商品でなければ空） */
+      components?: components["schemas"]["BundleComponentResponse"][];
     };
     ItemCreateRequest: {
       name: string;
@@ -102,6 +306,10 @@ export interface components {
       key: string;
       /** Format: uuid */
       item_type_id: string;
+      /** @default true */
+      available?: boolean;
+      /** @description セット商品の構成アイテム（省略時は変更しない、空配列でセットを解除） */
+      components?: components["schemas"]["BundleComponentRequest"][];
     };
     ItemUpdateRequest: {
       /** Format: uuid */
@@ -112,6 +320,10 @@ export interface components {
       key: string;
       /** Format: uuid */
       item_type_id: string;
+      /** @default true */
+      available?: boolean;
+      /** @description セット商品の構成アイテム（省略時は変更しない、空配列でセットを解除） */
+      components?: components["schemas"]["BundleComponentRequest"][];
     };
     ItemTypeResponse: {
       /** Format: uuid */
@@ -130,17 +342,31 @@ export interface components {
       display_name: string;
     };
     ItemInfo: {
+      /**
+       * @description オーダー内のアイテムのID（返金・作り直しの対象の指定に使う）
+       * Format: uuid
+       */
+      order_item_id: string;
+      /** @description 名前・略称・価格は注文時点のもの（価格は選択肢の価格差を含む） */
       item: components["schemas"]["ItemResponse"];
       assignee: string | null;
+      modifiers?: components["schemas"]["SelectedModifier"][];
+      /** @description 注文時点のセット商品の構成アイテム */
+      components?: components["schemas"]["BundleComponentResponse"][];
     };
     ItemInfoCreate: {
       /** Format: uuid */
       item_id: string;
       assignee: string | null;
+      /** @description 選んだ選択肢のID */
+      modifier_ids?: string[];
     };
     OrderResponse: {
       /** Format: uuid */
       id: string;
+      /** Format: uuid */
+      session_id?: string | null;
+      register: string;
       order_id: number;
       /** Format: date-time */
       created_at: string;
@@ -148,27 +374,82 @@ export interface components {
       ready_at?: string | null;
       /** Format: date-time */
       served_at?: string | null;
+      /**
+       * @description 呼び出し画面で最初に呼び出した時刻
+       * Format: date-time
+       */
+      called_at?: string | null;
+      /**
+       * @description 最後に呼び出した時刻（再呼び出しで更新される）
+       * Format: date-time
+       */
+      last_called_at?: string | null;
+      /** @description 再呼び出しの回数 */
+      recall_count?: number;
+      /** @description お客様が注文状況を確認するための追跡コード（ラベルに印刷する） */
+      tracking_token?: string;
+      /** @description 提供待ちの列での順番（1から。オーダー作成時のみ） */
+      queue_position?: number;
       billing_amount: number;
       received: number;
+      /** @description お釣り */
+      change: number;
+      /** @description お釣りの金種内訳（オーダー作成時のみ） */
+      change_breakdown?: components["schemas"]["DenominationCount"][];
+      /** @description レジの釣り銭不足の警告（オーダー作成時のみ） */
+      drawer_warnings?: components["schemas"]["DrawerWarning"][];
+      /** @description 返金済みの金額 */
+      refunded_amount: number;
+      /** @description 請求額から返金額を引いた金額 */
+      net_amount: number;
+      refunds?: components["schemas"]["RefundResponse"][];
+      payments?: components["schemas"]["PaymentResponse"][];
       discount_order_id?: number | null;
       discount_order_cups?: number;
+      /** @description 割引額の合計 */
+      discount?: number;
+      /** @description 適用した割引ルール */
+      applied_promotions?: components["schemas"]["AppliedPromotion"][];
       items: components["schemas"]["ItemInfo"][];
+      /** @description 作るもの一覧（セット商品は構成アイテムに展開する） */
+      prep_tasks?: components["schemas"]["PrepTask"][];
+      /** @description 割引の対象になるコーヒーの杯数（セット商品の構成アイテムを含む） */
+      coffee_cups?: number;
+      /** @description ドリッパーを3人以上確保する注文かどうか */
+      dripper_split?: boolean;
       comments?: components["schemas"]["CommentResponse"][];
     };
     OrderCreateRequest: {
-      /** @example 1 */
-      order_id: number;
-      /** @example 500 */
+      /**
+       * @description 省略時は営業中のセッションで次の番号を採番する
+       * @example 1
+       */
+      order_id?: number;
+      /**
+       * @description 割引後の請求額。サーバーで割引ルールを適用した金額と一致させる
+       * @example 500
+       */
       billing_amount: number;
       /**
        * @default 0
        * @example 500
        */
       received: number;
+      /** @description お預かりした金種の内訳（省略時は金額から推定する） */
+      received_denominations?: components["schemas"]["DenominationCount"][];
       discount_order_id?: number | null;
       /** @default 0 */
       discount_order_cups?: number;
+      /**
+       * @description 会計したレジ名
+       * @default main
+       */
+      register?: string;
+      /** @description 支払いの内訳（省略時は請求額の全額を現金で支払う）。金額の合計は請求額と一致させる */
+      payments?: components["schemas"]["PaymentCreate"][];
       item_ids: components["schemas"]["ItemInfoCreate"][];
+      /** @description 使用するクーポンコード（オーダーの作成と同時に使用済みにする） */
+      voucher_code?: string;
       comments?: components["schemas"]["CommentCreateRequest"][];
     };
     OrderUpdateRequest: {
@@ -215,225 +496,2609 @@ export interface components {
       /** Format: date-time */
       created_at: string;
       type: string;
+      /** Format: uuid */
+      session_id?: string | null;
     };
     MasterStateUpdateRequest: {
       type: string;
     };
-    ErrorResponse: {
-      /** @example Invalid order ID format */
-      error: string;
+    SessionResponse: {
+      /** Format: uuid */
+      id: string;
+      /** @example 1日目 */
+      name: string;
+      /** Format: date-time */
+      opened_at: string;
+      /** Format: date-time */
+      closed_at: string | null;
+      /**
+       * @description 開始時の釣り銭準備金
+       * @example 30000
+       */
+      opening_float: number;
+      /** @description セッション内で次に採番されるオーダー番号 */
+      next_order_id: number;
     };
-  };
-  responses: never;
-  parameters: never;
-  requestBodies: never;
-  headers: never;
-  pathItems: never;
-}
-
-export type $defs = Record<string, never>;
-
-export type external = Record<string, never>;
-
-export interface operations {
-  /** サーバーステータス取得 */
-  getStatus: {
-    responses: {
-      /** @description 成功 */
-      200: {
-        content: {
-          "application/json": components["schemas"]["StatusResponse"];
-        };
-      };
+    SessionCreateRequest: {
+      /** @example 1日目 */
+      name: string;
+      /** @default 0 */
+      opening_float?: number;
     };
-  };
-  /** アイテム一覧取得 */
-  getItems: {
-    responses: {
-      /** @description 成功 */
-      200: {
-        content: {
-          "application/json": components["schemas"]["ItemResponse"][];
-        };
-      };
+    CashMovementCreateRequest: {
+      /** @default main */
+      register?: string;
+      type: "float" | "pay_in" | "pay_out";
+      /** @example 5000 */
+      amount: number;
+      reason?: string;
+      author: string;
     };
-  };
-  /** アイテム作成 */
-  createItem: {
-    requestBody: {
-      content: {
-        "application/json": components["schemas"]["ItemCreateRequest"];
-      };
+    CashMovementResponse: {
+      /** Format: uuid */
+      id: string;
+      /** Format: uuid */
+      session_id: string;
+      register: string;
+      type: "float" | "pay_in" | "pay_out";
+      amount: number;
+      reason: string;
+      author: string;
+      /** Format: date-time */
+      created_at: string;
     };
-    responses: {
-      /** @description 成功 */
-      200: {
-        content: {
-          "application/json": components["schemas"]["ItemResponse"];
-        };
-      };
+    CashSummaryResponse: {
+      /** Format: uuid */
+      session_id: string;
+      register: string;
+      opening_float: number;
+      /** @description お預かり金額からお釣りを引いた現金売上 */
+      cash_sales: number;
+      pay_ins: number;
+      pay_outs: number;
+      /** @description 現金での返金 */
+      refunds: number;
+      /** @description レジにあるはずの現金 */
+      expected: number;
     };
-  };
-  /** idからアイテム情報取得 */
-  getItem: {
-    parameters: {
-      path: {
-        id: string;
-      };
+    DenominationCount: {
+      /** @example 1000 */
+      denomination: number;
+      /** @example 12 */
+      count: number;
     };
-    responses: {
-      /** @description 成功 */
-      200: {
-        content: {
-          "application/json": components["schemas"]["ItemResponse"];
-        };
-      };
+    CashCloseoutCreateRequest: {
+      /**
+       * @description 省略時は営業中のセッション
+       * Format: uuid
+       */
+      session_id?: string;
+      /** @default main */
+      register?: string;
+      counted_by: string;
+      denominations: components["schemas"]["DenominationCount"][];
     };
-  };
-  /** アイテム情報更新 */
-  updateItem: {
-    parameters: {
-      path: {
-        id: string;
-      };
+    CashCloseoutResponse: {
+      /** Format: uuid */
+      id: string;
+      /** Format: uuid */
+      session_id: string;
+      register: string;
+      opening_float: number;
+      cash_sales: number;
+      pay_ins: number;
+      pay_outs: number;
+      refunds: number;
+      expected: number;
+      counted: number;
+      /** @description 実査額 - 理論値（マイナスは不足） */
+      variance: number;
+      counted_by: string;
+      denominations: components["schemas"]["DenominationCount"][];
+      /** Format: date-time */
+      created_at: string;
     };
-    requestBody: {
-      content: {
-        "application/json": components["schemas"]["ItemUpdateRequest"];
-      };
+    DrawerStockEntry: {
+      /** @example 100 */
+      denomination: number;
+      /** @example 50 */
+      count: number;
+      /**
+       * @description この枚数を下回ると警告する
+       * @example 20
+       */
+      low_threshold: number;
     };
-    responses: {
-      /** @description 成功 */
-      200: {
-        content: {
-          "application/json": components["schemas"]["ItemResponse"];
-        };
-      };
+    DrawerWarning: {
+      denomination: number;
+      count: number;
+      low_threshold: number;
+      /** @example 100円玉が残り12枚です */
+      message: string;
     };
-  };
-  /** アイテム削除 */
-  deleteItem: {
-    parameters: {
-      path: {
-        id: string;
-      };
+    DrawerStockResponse: {
+      /** Format: uuid */
+      session_id: string;
+      register: string;
+      denominations: components["schemas"]["DrawerStockEntry"][];
+      total: number;
+      warnings: components["schemas"]["DrawerWarning"][];
     };
-    responses: {
-      /** @description 成功 */
-      204: {
-        content: never;
-      };
+    DrawerStockUpdateRequest: {
+      /** @default main */
+      register?: string;
+      denominations: components["schemas"]["DrawerStockCount"][];
     };
-  };
-  /** アイテムタイプ一覧取得 */
-  getItemTypes: {
-    responses: {
-      /** @description 成功 */
-      200: {
-        content: {
-          "application/json": components["schemas"]["ItemTypeResponse"][];
-        };
-      };
+    DrawerStockCount: {
+      denomination: number;
+      count: number;
+      /** @description 省略時は金種ごとの既定値 */
+      low_threshold?: number;
     };
-  };
-  /** アイテムタイプ作成 */
-  createItemType: {
-    requestBody: {
-      content: {
-        "application/json": components["schemas"]["ItemTypeCreateRequest"];
-      };
+    RefundItemRequest: {
+      /**
+       * @description オーダー内のアイテムのID（ItemInfo の order_item_id）
+       * Format: uuid
+       */
+      order_item_id: string;
     };
-    responses: {
-      /** @description 成功 */
-      200: {
-        content: {
-          "application/json": components["schemas"]["ItemTypeResponse"];
-        };
-      };
+    RefundItemResponse: {
+      /** Format: uuid */
+      order_item_id: string;
+      /** Format: uuid */
+      item_id: string;
     };
-  };
-  /** idからアイテムタイプ情報取得 */
-  getItemType: {
-    parameters: {
-      path: {
-        id: string;
-      };
+    RefundCreateRequest: {
+      /** @description refund は返金、remake は作り直し（返金なし） */
+      type: "refund" | "remake";
+      /** @example 提供時にこぼした */
+      reason: string;
+      author: string;
+      /** @description 返金額（省略時は対象アイテムの価格の合計） */
+      amount?: number;
+      /** @description 返金したレジ名（省略時はオーダーのレジ） */
+      register?: string;
+      /**
+       * @description 返金する決済手段（オーダーの支払いに使われたもの）
+省略時はオーダーの支払いの決済手段。複数の決済手段で支払ったオーダーの返金では必須。
+現金の返金だけがレジの在庫と現金集計から引かれる
+       * @example cash
+       */
+      method?: string;
+      items?: components["schemas"]["RefundItemRequest"][];
     };
-    responses: {
-      /** @description 成功 */
-      200: {
-        content: {
-          "application/json": components["schemas"]["ItemTypeResponse"];
-        };
-      };
+    RefundResponse: {
+      /** Format: uuid */
+      id: string;
+      /** Format: uuid */
+      order_id: string;
+      /** Format: uuid */
+      session_id: string;
+      register: string;
+      /** @description 返金した決済手段（作り直しは返金がないので cash） */
+      method: string;
+      type: "refund" | "remake";
+      reason: string;
+      amount: number;
+      author: string;
+      items: components["schemas"]["RefundItemResponse"][];
+      /** Format: date-time */
+      created_at: string;
     };
-  };
-  /** アイテム情報更新 */
-  updateItemType: {
-    parameters: {
-      path: {
-        id: string;
-      };
+    PaymentCreate: {
+      /**
+       * @description 決済手段（GET /api/payment-methods で取得できるもの）
+       * @example cash
+       */
+      method: string;
+      /** @example 500 */
+      amount: number;
+      /** @description モック決済端末の応答を指定する（開発・練習用） */
+      simulate?: "approve" | "decline" | "timeout";
     };
-    requestBody: {
-      content: {
-        "application/json": components["schemas"]["ItemTypeUpdateRequest"];
-      };
+    PaymentResponse: {
+      /** Format: uuid */
+      id: string;
+      /** Format: uuid */
+      order_id: string;
+      /** @example cash */
+      method: string;
+      amount: number;
+      status: "approved" | "declined" | "timeout" | "voided";
+      /** @description 決済端末の取引番号 */
+      external_reference?: string;
+      message?: string;
+      /** Format: date-time */
+      created_at: string;
     };
-    responses: {
-      /** @description 成功 */
-      200: {
-        content: {
+    PaymentResult: {
+      method: string;
+      amount: number;
+      status: "approved" | "declined" | "timeout" | "voided";
+      message?: string;
+    };
+    PaymentFailedResponse: {
+      /** @example Payment was not approved */
+      error: string;
+      /** @description 各支払いの結果（承認済みのものは取り消される） */
+      payments: components["schemas"]["PaymentResult"][];
+    };
+    SalesSummaryReport: {
+      order_count: number;
+      /** @description コーヒーの杯数（セット商品は構成アイテムを数え、milk と others は含まない） */
+      cup_count: number;
+      /** @description 割引前の売上（アイテム価格の合計） */
+      gross_sales: number;
+      /** @description 割引額の合計（割引前の売上 - 請求額の合計） */
+      discount_total: number;
+      discounted_order_count: number;
+      /** @description 請求額の合計 */
+      billing_total: number;
+      /** @description 対象オーダーの返金額の合計 */
+      refund_total: number;
+      /** @description 請求額の合計 - 返金額の合計 */
+      net_sales: number;
+      /** @description 1オーダーあたりの平均請求額 */
+      average_ticket: number;
+    };
+    ItemSalesReport: {
+      /** Format: uuid */
+      item_id: string;
+      name: string;
+      abbr: string;
+      /** Format: uuid */
+      item_type_id: string;
+      item_type_name: string;
+      quantity: number;
+      /** @description 割引前の売上 */
+      sales: number;
+    };
+    ItemTypeSalesReport: {
+      /** Format: uuid */
+      item_type_id: string;
+      name: string;
+      quantity: number;
+      /** @description 割引前の売上 */
+      sales: number;
+    };
+    SalesTimelineBucket: {
+      /** Format: date-time */
+      bucket_start: string;
+      order_count: number;
+      cup_count: number;
+      billing_total: number;
+    };
+    /** @description 所要時間（秒）のパーセンタイル。対象がない場合は null */
+    DurationPercentiles: {
+      count: number;
+      p50?: number | null;
+      p90?: number | null;
+      p99?: number | null;
+    };
+    ThroughputStats: {
+      created_to_ready: components["schemas"]["DurationPercentiles"];
+      ready_to_served: components["schemas"]["DurationPercentiles"];
+      created_to_served: components["schemas"]["DurationPercentiles"];
+    };
+    ThroughputBucket: {
+      /** Format: date-time */
+      bucket_start: string;
+      stats: components["schemas"]["ThroughputStats"];
+    };
+    ThroughputReport: {
+      overall: components["schemas"]["ThroughputStats"];
+      /** @description オーダー作成日時で区切った統計 */
+      buckets: components["schemas"]["ThroughputBucket"][];
+    };
+    ItemPriceResponse: {
+      /** Format: uuid */
+      id: string;
+      /** Format: uuid */
+      item_id: string;
+      price: number;
+      /** Format: date-time */
+      effective_from: string;
+      /** @description まだ適用されていない予定の価格かどうか */
+      scheduled: boolean;
+      reason?: string;
+      /** Format: date-time */
+      created_at: string;
+    };
+    ItemPriceCreateRequest: {
+      /** @example 400 */
+      price: number;
+      /**
+       * @description 適用開始日時（省略時は即時）
+       * Format: date-time
+       */
+      effective_from?: string;
+      /** @example ラスト1時間割引 */
+      reason?: string;
+    };
+    ModifierResponse: {
+      /** Format: uuid */
+      id: string;
+      /** @example アイス */
+      name: string;
+      /**
+       * @description 価格差（マイナスも可）
+       * @example 50
+       */
+      price_delta: number;
+    };
+    ModifierGroupResponse: {
+      /** Format: uuid */
+      id: string;
+      /** @example temperature */
+      name: string;
+      /** @example 温度 */
+      display_name: string;
+      min_select: number;
+      /** @description 選べる数の上限（0 の場合は制限なし） */
+      max_select: number;
+      /** Format: uuid */
+      item_id?: string | null;
+      /** Format: uuid */
+      item_type_id?: string | null;
+      modifiers: components["schemas"]["ModifierResponse"][];
+    };
+    ModifierRequest: {
+      name: string;
+      /** @default 0 */
+      price_delta?: number;
+    };
+    ModifierGroupRequest: {
+      name: string;
+      /** @description 省略時は name と同じ */
+      display_name?: string;
+      /** @default 0 */
+      min_select?: number;
+      /** @default 1 */
+      max_select?: number;
+      /**
+       * @description item_id と item_type_id のどちらか一方を指定する
+       * Format: uuid
+       */
+      item_id?: string | null;
+      /** Format: uuid */
+      item_type_id?: string | null;
+      modifiers: components["schemas"]["ModifierRequest"][];
+    };
+    SelectedModifier: {
+      /** Format: uuid */
+      modifier_id: string;
+      group_name: string;
+      name: string;
+      price_delta: number;
+    };
+    BundleComponentRequest: {
+      /** Format: uuid */
+      item_id: string;
+      /** @default 1 */
+      quantity?: number;
+    };
+    BundleComponentResponse: {
+      /** Format: uuid */
+      item_id: string;
+      name: string;
+      abbr: string;
+      item_type_name: string;
+      quantity: number;
+    };
+    PrepTask: {
+      /** Format: uuid */
+      item_id: string;
+      name: string;
+      abbr: string;
+      item_type_name: string;
+      assignee: string | null;
+      /**
+       * @description セット商品から展開した場合のセット商品のID
+       * Format: uuid
+       */
+      bundle_item_id?: string;
+    };
+    /** @description 割引ルールの種類
+- per_cup_return: カップ返却で一杯あたり amount 円引き（返却したオーダーの杯数まで）
+- fixed_off: 小計が min_subtotal 円以上なら amount 円引き
+- percent_off_item_type: item_type_id の種別のアイテムを percent % 引き
+- buy_n_get_m: 対象のアイテムを buy_quantity 個買うと free_quantity 個無料（安いものから）
+- happy_hour: 毎日 daily_start〜daily_end の間、対象のアイテムを percent % 引き、または一つあたり amount 円引き */
+    PromotionType: "per_cup_return" | "fixed_off" | "percent_off_item_type" | "buy_n_get_m" | "happy_hour";
+    PromotionResponse: {
+      /** Format: uuid */
+      id: string;
+      name: string;
+      type: components["schemas"]["PromotionType"];
+      active: boolean;
+      /** @description 小さいものから順に適用する */
+      priority: number;
+      amount: number;
+      percent: number;
+      /** Format: uuid */
+      item_type_id?: string | null;
+      /** Format: uuid */
+      item_id?: string | null;
+      buy_quantity: number;
+      free_quantity: number;
+      min_subtotal: number;
+      /** Format: date-time */
+      starts_at?: string | null;
+      /** Format: date-time */
+      ends_at?: string | null;
+      /** @example 15:00 */
+      daily_start?: string | null;
+      /** @example 16:00 */
+      daily_end?: string | null;
+    };
+    PromotionRequest: {
+      name: string;
+      type: components["schemas"]["PromotionType"];
+      /** @default true */
+      active?: boolean;
+      /** @default 0 */
+      priority?: number;
+      /** @default 0 */
+      amount?: number;
+      /** @default 0 */
+      percent?: number;
+      /** Format: uuid */
+      item_type_id?: string | null;
+      /** Format: uuid */
+      item_id?: string | null;
+      /** @default 0 */
+      buy_quantity?: number;
+      /** @default 0 */
+      free_quantity?: number;
+      /** @default 0 */
+      min_subtotal?: number;
+      /** Format: date-time */
+      starts_at?: string | null;
+      /** Format: date-time */
+      ends_at?: string | null;
+      /**
+       * @description HH:MM（日本時間）
+       * @example 15:00
+       */
+      daily_start?: string | null;
+      /**
+       * @description HH:MM（日本時間）
+       * @example 16:00
+       */
+      daily_end?: string | null;
+    };
+    AppliedPromotion: {
+      /** Format: uuid */
+      promotion_id: string;
+      name: string;
+      type: components["schemas"]["PromotionType"];
+      /** @description 割引額 */
+      amount: number;
+    };
+    PromotionEvaluateRequest: {
+      item_ids: components["schemas"]["ItemInfoCreate"][];
+      discount_order_id?: number | null;
+      discount_order_cups?: number;
+      voucher_code?: string;
+    };
+    PromotionEvaluation: {
+      /** @description 割引前の金額 */
+      subtotal: number;
+      discount: number;
+      /** @description 割引後の請求額 */
+      billing_amount: number;
+      applied_promotions: components["schemas"]["AppliedPromotion"][];
+    };
+    VoucherBatchCreateRequest: {
+      name: string;
+      /** @description 発行する枚数 */
+      count: number;
+      type: components["schemas"]["PromotionType"];
+      /** @default 0 */
+      amount?: number;
+      /** @default 0 */
+      percent?: number;
+      /** Format: uuid */
+      item_type_id?: string | null;
+      /** Format: uuid */
+      item_id?: string | null;
+      /** @default 0 */
+      buy_quantity?: number;
+      /** @default 0 */
+      free_quantity?: number;
+      /** @default 0 */
+      min_subtotal?: number;
+      /** Format: date-time */
+      expires_at?: string | null;
+    };
+    VoucherBatchResponse: {
+      /** Format: uuid */
+      id: string;
+      name: string;
+      type: components["schemas"]["PromotionType"];
+      amount: number;
+      percent: number;
+      /** Format: uuid */
+      item_type_id?: string | null;
+      /** Format: uuid */
+      item_id?: string | null;
+      buy_quantity: number;
+      free_quantity: number;
+      min_subtotal: number;
+      /** Format: date-time */
+      expires_at?: string | null;
+      /** Format: date-time */
+      created_at: string;
+      /** @description 発行した枚数 */
+      issued: number;
+      /** @description 使用済みの枚数 */
+      redeemed: number;
+      /** @description 使用したオーダーの割引額の合計 */
+      discount_total: number;
+    };
+    VoucherResponse: {
+      /** @example ABCD-EFGH */
+      code: string;
+      /** Format: uuid */
+      batch_id: string;
+      batch_name: string;
+      /** @description 未使用で期限内なら true */
+      usable: boolean;
+      /** Format: date-time */
+      expires_at?: string | null;
+      /** Format: date-time */
+      redeemed_at?: string | null;
+      /** Format: uuid */
+      redeemed_order_uuid?: string | null;
+      /** @description 使用したオーダーの番号 */
+      redeemed_order_id?: number | null;
+    };
+    /**
+     * @description 印刷物の種類
+- labels: コーヒー一杯ごとのラベルと明細
+- receipt: レシート
+     * @default labels
+     */
+    PrintKind: "labels" | "receipt";
+    /** @description 印刷ジョブの状態
+- queued: 送信待ち（再試行待ちを含む）
+- printing: 送信中
+- done: 送信済み
+- failed: 再試行しても送れなかった */
+    PrintJobStatus: "queued" | "printing" | "done" | "failed";
+    PrintJobResponse: {
+      /** Format: uuid */
+      id: string;
+      /** Format: uuid */
+      order_id: string;
+      /** @description オーダー番号 */
+      order_number: number;
+      kind: components["schemas"]["PrintKind"];
+      /**
+       * @description 送り先のプリンター（host:port）
+       * @example 192.168.0.50:9100
+       */
+      printer: string;
+      status: components["schemas"]["PrintJobStatus"];
+      /** @description 送信を試みた回数 */
+      attempts: number;
+      /** @description 最後に失敗したときのエラー */
+      last_error?: string;
+      /**
+       * @description 次に送信を試みる時刻（queued の場合）
+       * Format: date-time
+       */
+      next_attempt_at?: string;
+      /** Format: date-time */
+      printed_at?: string;
+      /** Format: date-time */
+      created_at: string;
+      /** Format: date-time */
+      updated_at: string;
+    };
+    PrintJobCreateRequest: {
+      /** Format: uuid */
+      order_id: string;
+      kind?: components["schemas"]["PrintKind"];
+      /** @description 送り先のプリンター（host:port、省略時は種類ごとの設定） */
+      printer?: string;
+    };
+    CallscreenResponse: {
+      /** @description 呼び出し中（最初に呼び出した順） */
+      calling: components["schemas"]["CallscreenCall"][];
+      /** @description 呼び出したが受け取られずに期限切れになったオーダー番号 */
+      expired: number[];
+      /** @description 最近提供したオーダー（新しい順） */
+      recently_served: components["schemas"]["CallscreenServed"][];
+      /** @description まだ呼び出していないオーダー番号（注文順） */
+      next_up: number[];
+      /** @description 呼び出しが期限切れになるまでの秒数 */
+      call_expiry_seconds: number;
+      /** Format: date-time */
+      generated_at: string;
+    };
+    CallscreenCall: {
+      order_number: number;
+      /** Format: date-time */
+      called_at: string;
+      /** Format: date-time */
+      last_called_at: string;
+      recall_count: number;
+      /** Format: date-time */
+      expires_at: string;
+    };
+    CallscreenServed: {
+      order_number: number;
+      /** Format: date-time */
+      served_at: string;
+    };
+    /** @description お客様向けの注文の状態
+- preparing: 準備中
+- calling: 呼び出し中
+- ready: 提供可能（呼び出しの期限切れを含む）
+- served: 提供済み */
+    TrackingStatus: "preparing" | "calling" | "ready" | "served";
+    TrackingResponse: {
+      order_number: number;
+      status: components["schemas"]["TrackingStatus"];
+      /** @description 準備中のオーダーの中での順番（自分を含めて1から。準備中の場合のみ） */
+      queue_position?: number;
+      /**
+       * @description 提供可能になる目安の時刻（準備中の場合のみ）
+       * Format: date-time
+       */
+      estimated_ready_at?: string;
+      /** Format: date-time */
+      updated_at: string;
+    };
+    QueueResponse: {
+      /** @description 未提供のオーダー（注文順） */
+      orders: components["schemas"]["QueueEntry"][];
+      /** @description 提供可能になっていないオーダーで作るものの数（アイテム種別ごと） */
+      waiting_cups: components["schemas"]["QueueItemTypeCups"][];
+      /** @description 一番長く待っているオーダーの経過秒数（列が空なら 0） */
+      oldest_wait_seconds: number;
+      /**
+       * @description 一番長く待っているオーダーの注文時刻
+       * Format: date-time
+       */
+      oldest_created_at?: string;
+      /** Format: date-time */
+      generated_at: string;
+    };
+    QueueEntry: {
+      /** Format: uuid */
+      id: string;
+      order_number: number;
+      /** @description 列での順番（1から） */
+      position: number;
+      /** Format: date-time */
+      created_at: string;
+      /** @description 注文からの経過秒数 */
+      age_seconds: number;
+      /** @description 提供可能になっているか */
+      ready: boolean;
+      /** @description 作るものの数（セット商品は構成アイテムに展開） */
+      cups: number;
+    };
+    QueueItemTypeCups: {
+      /** @description アイテム種別の名前 */
+      item_type: string;
+      display_name: string;
+      cups: number;
+    };
+    /** @description エラーの内容。クライアントは code で表示するメッセージを選ぶ */
+    ErrorResponse: {
+      /**
+       * @description 開発者向けのメッセージ（英語）
+       * @example Order not found
+       */
+      error: string;
+      code: components["schemas"]["ErrorCode"];
+      /** @description 項目ごとの理由（VALIDATION_FAILED の場合） */
+      details?: components["schemas"]["FieldError"][];
+    };
+    /**
+     * @description エラーの種類
+- VALIDATION_FAILED: リクエストの内容が正しくない（details に項目ごとの理由）
+- NOT_FOUND: 対象が見つからない
+- ORDER_NOT_FOUND: オーダーが見つからない
+- ITEM_NOT_FOUND: アイテムが見つからない
+- ITEM_TYPE_NOT_FOUND: アイテム種別が見つからない
+- MODIFIER_GROUP_NOT_FOUND: 選択肢グループが見つからない
+- PRICE_NOT_FOUND: 価格変更が見つからない
+- PROMOTION_NOT_FOUND: 割引ルールが見つからない
+- VOUCHER_NOT_FOUND: クーポンが見つからない
+- VOUCHER_BATCH_NOT_FOUND: クーポンのバッチが見つからない
+- SESSION_NOT_FOUND: セッションが見つからない
+- CLOSEOUT_NOT_FOUND: レジ締めが見つからない
+- PRINT_JOB_NOT_FOUND: 印刷ジョブが見つからない
+- NO_OPEN_SESSION: 営業中のセッションがない
+- SESSION_ALREADY_OPEN: 別のセッションが営業中
+- SESSION_ALREADY_CLOSED: セッションは終了済み
+- CLOSEOUT_EXISTS: このレジはセッション内でレジ締め済み
+- ORDER_NUMBER_IN_USE: オーダー番号がセッション内で使われている
+- ORDER_NOT_SERVED: オーダーがまだ提供されていない
+- ORDER_ALREADY_SERVED: オーダーは提供済み
+- ORDER_ALREADY_CALLED: オーダーは呼び出し済み
+- ORDER_NOT_CALLED: オーダーがまだ呼び出されていない
+- PRICE_ALREADY_EFFECTIVE: 価格変更はすでに有効になっている
+- PRINT_JOB_NOT_FAILED: 印刷ジョブは失敗していない
+- ITEM_UNAVAILABLE: 注文できないアイテムが含まれている
+- DISCOUNT_ORDER_NOT_FOUND: 割引に使うオーダーが見つからない
+- DISCOUNT_ALREADY_USED: 割引に使うオーダーは使用済み
+- VOUCHER_ALREADY_REDEEMED: クーポンは使用済み
+- VOUCHER_EXPIRED: クーポンの有効期限が切れている
+- VOUCHER_NOT_APPLICABLE: クーポンがこのオーダーに適用されない
+- BILLING_AMOUNT_MISMATCH: 請求額がサーバーで計算した金額と一致しない
+- INSUFFICIENT_PAYMENT: お預かりが現金の支払い分に足りない
+- RATE_LIMITED: リクエストが多すぎる
+- INTERNAL: サーバー内部のエラー
+     * @example ORDER_NOT_FOUND
+     */
+    ErrorCode: "VALIDATION_FAILED" | "NOT_FOUND" | "ORDER_NOT_FOUND" | "ITEM_NOT_FOUND" | "ITEM_TYPE_NOT_FOUND" | "MODIFIER_GROUP_NOT_FOUND" | "PRICE_NOT_FOUND" | "PROMOTION_NOT_FOUND" | "VOUCHER_NOT_FOUND" | "VOUCHER_BATCH_NOT_FOUND" | "SESSION_NOT_FOUND" | "CLOSEOUT_NOT_FOUND" | "PRINT_JOB_NOT_FOUND" | "NO_OPEN_SESSION" | "SESSION_ALREADY_OPEN" | "SESSION_ALREADY_CLOSED" | "CLOSEOUT_EXISTS" | "ORDER_NUMBER_IN_USE" | "ORDER_NOT_SERVED" | "ORDER_ALREADY_SERVED" | "ORDER_ALREADY_CALLED" | "ORDER_NOT_CALLED" | "PRICE_ALREADY_EFFECTIVE" | "PRINT_JOB_NOT_FAILED" | "ITEM_UNAVAILABLE" | "DISCOUNT_ORDER_NOT_FOUND" | "DISCOUNT_ALREADY_USED" | "VOUCHER_ALREADY_REDEEMED" | "VOUCHER_EXPIRED" | "VOUCHER_NOT_APPLICABLE" | "BILLING_AMOUNT_MISMATCH" | "INSUFFICIENT_PAYMENT" | "RATE_LIMITED" | "INTERNAL";
+    FieldError: {
+      /**
+       * @description 項目の名前（クエリ・パスのパラメーター名、またはリクエストボディの JSON Pointer）
+       * @example /billing_amount
+       */
+      field: string;
+      /** @example must be an integer */
+      message: string;
+    };
+  };
+  responses: never;
+  parameters: never;
+  requestBodies: never;
+  headers: never;
+  pathItems: never;
+}
+
+export type $defs = Record<string, never>;
+
+export type external = Record<string, never>;
+
+export interface operations {
+  /** サーバーステータス取得 */
+  getStatus: {
+    responses: {
+      /** @description 成功 */
+      200: {
+        content: {
+          "application/json": components["schemas"]["StatusResponse"];
+        };
+      };
+    };
+  };
+  /** アイテム一覧取得 */
+  getItems: {
+    responses: {
+      /** @description 成功 */
+      200: {
+        content: {
+          "application/json": components["schemas"]["ItemResponse"][];
+        };
+      };
+    };
+  };
+  /** アイテム作成 */
+  createItem: {
+    requestBody: {
+      content: {
+        "application/json": components["schemas"]["ItemCreateRequest"];
+      };
+    };
+    responses: {
+      /** @description 成功 */
+      201: {
+        content: {
+          "application/json": components["schemas"]["ItemResponse"];
+        };
+      };
+      /** @description リクエストが不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+    };
+  };
+  /** idからアイテム情報取得 */
+  getItem: {
+    parameters: {
+      path: {
+        id: string;
+      };
+    };
+    responses: {
+      /** @description 成功 */
+      200: {
+        content: {
+          "application/json": components["schemas"]["ItemResponse"];
+        };
+      };
+      /** @description リクエストが不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description アイテムが見つかりません */
+      404: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+    };
+  };
+  /** アイテム情報更新 */
+  updateItem: {
+    parameters: {
+      path: {
+        id: string;
+      };
+    };
+    requestBody: {
+      content: {
+        "application/json": components["schemas"]["ItemUpdateRequest"];
+      };
+    };
+    responses: {
+      /** @description 成功 */
+      200: {
+        content: {
+          "application/json": components["schemas"]["ItemResponse"];
+        };
+      };
+      /** @description リクエストが不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description アイテムが見つかりません */
+      404: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+    };
+  };
+  /** アイテム削除 */
+  deleteItem: {
+    parameters: {
+      path: {
+        id: string;
+      };
+    };
+    responses: {
+      /** @description 削除成功 */
+      200: {
+        content: never;
+      };
+      /** @description リクエストが不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description アイテムが見つかりません */
+      404: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+    };
+  };
+  /** アイテムの価格履歴・予定取得 */
+  getItemPrices: {
+    parameters: {
+      path: {
+        /** @description アイテムID */
+        id: string;
+      };
+    };
+    responses: {
+      /** @description 成功 */
+      200: {
+        content: {
+          "application/json": components["schemas"]["ItemPriceResponse"][];
+        };
+      };
+      /** @description リクエストが不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description アイテムが見つかりません */
+      404: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+    };
+  };
+  /** 価格変更の登録 */
+  createItemPrice: {
+    parameters: {
+      path: {
+        /** @description アイテムID */
+        id: string;
+      };
+    };
+    requestBody: {
+      content: {
+        "application/json": components["schemas"]["ItemPriceCreateRequest"];
+      };
+    };
+    responses: {
+      /** @description 作成成功 */
+      201: {
+        content: {
+          "application/json": components["schemas"]["ItemPriceResponse"];
+        };
+      };
+      /** @description 価格が不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description アイテムが見つかりません */
+      404: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+    };
+  };
+  /** 予定された価格変更の取り消し */
+  deleteItemPrice: {
+    parameters: {
+      path: {
+        /** @description アイテムID */
+        id: string;
+        /** @description 価格ID */
+        price_id: string;
+      };
+    };
+    responses: {
+      /** @description 削除成功 */
+      200: {
+        content: never;
+      };
+      /** @description リクエストが不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description 価格が見つかりません */
+      404: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description すでに適用済みの価格です */
+      409: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+    };
+  };
+  /** アイテムで選べる選択肢グループ取得 */
+  getItemModifierGroups: {
+    parameters: {
+      path: {
+        /** @description アイテムID */
+        id: string;
+      };
+    };
+    responses: {
+      /** @description 成功 */
+      200: {
+        content: {
+          "application/json": components["schemas"]["ModifierGroupResponse"][];
+        };
+      };
+      /** @description リクエストが不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description アイテムが見つかりません */
+      404: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+    };
+  };
+  /** 選択肢グループ一覧取得 */
+  getModifierGroups: {
+    responses: {
+      /** @description 成功 */
+      200: {
+        content: {
+          "application/json": components["schemas"]["ModifierGroupResponse"][];
+        };
+      };
+    };
+  };
+  /** 選択肢グループ作成 */
+  createModifierGroup: {
+    requestBody: {
+      content: {
+        "application/json": components["schemas"]["ModifierGroupRequest"];
+      };
+    };
+    responses: {
+      /** @description 作成成功 */
+      201: {
+        content: {
+          "application/json": components["schemas"]["ModifierGroupResponse"];
+        };
+      };
+      /** @description 内容が不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+    };
+  };
+  /** 選択肢グループ取得 */
+  getModifierGroup: {
+    parameters: {
+      path: {
+        /** @description 選択肢グループID */
+        id: string;
+      };
+    };
+    responses: {
+      /** @description 成功 */
+      200: {
+        content: {
+          "application/json": components["schemas"]["ModifierGroupResponse"];
+        };
+      };
+      /** @description リクエストが不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description 選択肢グループが見つかりません */
+      404: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+    };
+  };
+  /** 選択肢グループ更新 */
+  updateModifierGroup: {
+    parameters: {
+      path: {
+        /** @description 選択肢グループID */
+        id: string;
+      };
+    };
+    requestBody: {
+      content: {
+        "application/json": components["schemas"]["ModifierGroupRequest"];
+      };
+    };
+    responses: {
+      /** @description 更新成功 */
+      200: {
+        content: {
+          "application/json": components["schemas"]["ModifierGroupResponse"];
+        };
+      };
+      /** @description 内容が不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description 選択肢グループが見つかりません */
+      404: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+    };
+  };
+  /** 選択肢グループ削除 */
+  deleteModifierGroup: {
+    parameters: {
+      path: {
+        /** @description 選択肢グループID */
+        id: string;
+      };
+    };
+    responses: {
+      /** @description 削除成功 */
+      200: {
+        content: never;
+      };
+      /** @description リクエストが不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description 選択肢グループが見つかりません */
+      404: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+    };
+  };
+  /** アイテムタイプ一覧取得 */
+  getItemTypes: {
+    responses: {
+      /** @description 成功 */
+      200: {
+        content: {
+          "application/json": components["schemas"]["ItemTypeResponse"][];
+        };
+      };
+    };
+  };
+  /** アイテムタイプ作成 */
+  createItemType: {
+    requestBody: {
+      content: {
+        "application/json": components["schemas"]["ItemTypeCreateRequest"];
+      };
+    };
+    responses: {
+      /** @description 成功 */
+      201: {
+        content: {
+          "application/json": components["schemas"]["ItemTypeResponse"];
+        };
+      };
+      /** @description リクエストが不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+    };
+  };
+  /** idからアイテムタイプ情報取得 */
+  getItemType: {
+    parameters: {
+      path: {
+        id: string;
+      };
+    };
+    responses: {
+      /** @description 成功 */
+      200: {
+        content: {
+          "application/json": components["schemas"]["ItemTypeResponse"];
+        };
+      };
+      /** @description リクエストが不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description アイテムタイプが見つかりません */
+      404: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+    };
+  };
+  /** アイテム情報更新 */
+  updateItemType: {
+    parameters: {
+      path: {
+        id: string;
+      };
+    };
+    requestBody: {
+      content: {
+        "application/json": components["schemas"]["ItemTypeUpdateRequest"];
+      };
+    };
+    responses: {
+      /** @description 成功 */
+      200: {
+        content: {
           "application/json": components["schemas"]["ItemTypeResponse"];
         };
       };
+      /** @description リクエストが不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description アイテムタイプが見つかりません */
+      404: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+    };
+  };
+  /** アイテムタイプ削除 */
+  deleteItemType: {
+    parameters: {
+      path: {
+        id: string;
+      };
+    };
+    responses: {
+      /** @description 削除成功 */
+      200: {
+        content: never;
+      };
+      /** @description リクエストが不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description アイテムタイプが見つかりません */
+      404: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+    };
+  };
+  /** オーダー一覧取得 */
+  getOrders: {
+    parameters: {
+      query?: {
+        /** @description セッションID（省略時は営業中のセッション） */
+        session_id?: string;
+      };
+    };
+    responses: {
+      /** @description 成功 */
+      200: {
+        content: {
+          "application/json": components["schemas"]["OrderResponse"][];
+        };
+      };
+      /** @description リクエストが不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description セッションが見つかりません */
+      404: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+    };
+  };
+  /** オーダー作成 */
+  createOrder: {
+    requestBody: {
+      content: {
+        "application/json": components["schemas"]["OrderCreateRequest"];
+      };
+    };
+    responses: {
+      /** @description 成功 */
+      201: {
+        content: {
+          "application/json": components["schemas"]["OrderResponse"];
+        };
+      };
+      /** @description お預かり金額が請求額に足りません */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description キャッシュレス決済が承認されませんでした */
+      402: {
+        content: {
+          "application/json": components["schemas"]["PaymentFailedResponse"];
+        };
+      };
+      /** @description 営業中のセッションがない、またはオーダー番号・割引に使うオーダー・クーポンが使用済みです */
+      409: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+    };
+  };
+  /** idからオーダー情報取得 */
+  getOrder: {
+    parameters: {
+      path: {
+        id: string;
+      };
+    };
+    responses: {
+      /** @description 成功 */
+      200: {
+        content: {
+          "application/json": components["schemas"]["OrderResponse"];
+        };
+      };
+      /** @description リクエストが不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description オーダーが見つかりません */
+      404: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+    };
+  };
+  /** オーダー情報更新 */
+  updateOrder: {
+    parameters: {
+      path: {
+        id: string;
+      };
+    };
+    requestBody: {
+      content: {
+        "application/json": components["schemas"]["OrderUpdateRequest"];
+      };
+    };
+    responses: {
+      /** @description 成功 */
+      200: {
+        content: {
+          "application/json": components["schemas"]["OrderResponse"];
+        };
+      };
+      /** @description リクエストが不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description オーダーが見つかりません */
+      404: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+    };
+  };
+  /** オーダー削除 */
+  deleteOrder: {
+    parameters: {
+      path: {
+        id: string;
+      };
+    };
+    responses: {
+      /** @description 削除成功 */
+      200: {
+        content: never;
+      };
+      /** @description リクエストが不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description オーダーが見つかりません */
+      404: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+    };
+  };
+  /** オーダーを準備完了にする */
+  markOrderReady: {
+    parameters: {
+      path: {
+        id: string;
+      };
+    };
+    responses: {
+      /** @description 成功 */
+      200: {
+        content: {
+          "application/json": components["schemas"]["OrderResponse"];
+        };
+      };
+      /** @description リクエストが不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description オーダーが見つかりません */
+      404: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+    };
+  };
+  /** オーダーを提供完了にする */
+  markOrderServed: {
+    parameters: {
+      path: {
+        id: string;
+      };
+    };
+    responses: {
+      /** @description 成功 */
+      200: {
+        content: {
+          "application/json": components["schemas"]["OrderResponse"];
+        };
+      };
+      /** @description リクエストが不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description オーダーが見つかりません */
+      404: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+    };
+  };
+  /** 特定オーダーのコメント一覧取得 */
+  getOrderComments: {
+    parameters: {
+      path: {
+        /** @description オーダーID */
+        id: string;
+      };
+    };
+    responses: {
+      /** @description 成功 */
+      200: {
+        content: {
+          "application/json": components["schemas"]["CommentResponse"][];
+        };
+      };
+      /** @description リクエストが不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description オーダーが見つかりません */
+      404: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+    };
+  };
+  /** オーダーにコメント追加 */
+  createOrderComment: {
+    parameters: {
+      path: {
+        /** @description オーダーID */
+        id: string;
+      };
+    };
+    requestBody: {
+      content: {
+        "application/json": components["schemas"]["CommentCreateRequest"];
+      };
+    };
+    responses: {
+      /** @description 作成成功 */
+      201: {
+        content: {
+          "application/json": components["schemas"]["CommentResponse"];
+        };
+      };
+      /** @description リクエストが不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description オーダーが見つかりません */
+      404: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+    };
+  };
+  /** マスターステート取得 */
+  getMasterStatus: {
+    parameters: {
+      query?: {
+        /** @description セッションID（省略時は営業中のセッション） */
+        session_id?: string;
+      };
+    };
+    responses: {
+      /** @description 成功 */
+      200: {
+        content: {
+          "application/json": components["schemas"]["MasterStateResponse"][];
+        };
+      };
+      /** @description リクエストが不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description セッションが見つかりません */
+      404: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+    };
+  };
+  /** マスターステート更新 */
+  updateMasterStatus: {
+    requestBody: {
+      content: {
+        "application/json": components["schemas"]["MasterStateUpdateRequest"];
+      };
+    };
+    responses: {
+      /** @description 成功 */
+      201: {
+        content: {
+          "application/json": components["schemas"]["MasterStateResponse"];
+        };
+      };
+      /** @description リクエストが不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description 営業中のセッションがありません */
+      409: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+    };
+  };
+  /** セッション一覧取得 */
+  getSessions: {
+    responses: {
+      /** @description 成功 */
+      200: {
+        content: {
+          "application/json": components["schemas"]["SessionResponse"][];
+        };
+      };
+    };
+  };
+  /** セッション開始 */
+  openSession: {
+    requestBody: {
+      content: {
+        "application/json": components["schemas"]["SessionCreateRequest"];
+      };
+    };
+    responses: {
+      /** @description 作成成功 */
+      201: {
+        content: {
+          "application/json": components["schemas"]["SessionResponse"];
+        };
+      };
+      /** @description リクエストが不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description すでに営業中のセッションがあります */
+      409: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+    };
+  };
+  /** 営業中のセッション取得 */
+  getCurrentSession: {
+    responses: {
+      /** @description 成功 */
+      200: {
+        content: {
+          "application/json": components["schemas"]["SessionResponse"];
+        };
+      };
+      /** @description 営業中のセッションがありません */
+      404: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+    };
+  };
+  /** idからセッション情報取得 */
+  getSession: {
+    parameters: {
+      path: {
+        id: string;
+      };
+    };
+    responses: {
+      /** @description 成功 */
+      200: {
+        content: {
+          "application/json": components["schemas"]["SessionResponse"];
+        };
+      };
+      /** @description リクエストが不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description セッションが見つかりません */
+      404: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+    };
+  };
+  /** セッション終了 */
+  closeSession: {
+    parameters: {
+      path: {
+        id: string;
+      };
+    };
+    responses: {
+      /** @description 成功 */
+      200: {
+        content: {
+          "application/json": components["schemas"]["SessionResponse"];
+        };
+      };
+      /** @description リクエストが不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description セッションが見つかりません */
+      404: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description セッションはすでに終了しています */
+      409: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+    };
+  };
+  /** レジごとの現金集計取得 */
+  getCashSummary: {
+    parameters: {
+      query?: {
+        /** @description セッションID（省略時は営業中のセッション） */
+        session_id?: string;
+        /** @description レジ名（省略時は全レジ） */
+        register?: string;
+      };
+    };
+    responses: {
+      /** @description 成功 */
+      200: {
+        content: {
+          "application/json": components["schemas"]["CashSummaryResponse"][];
+        };
+      };
+      /** @description リクエストが不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description セッションが見つかりません */
+      404: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+    };
+  };
+  /** 入出金一覧取得 */
+  getCashMovements: {
+    parameters: {
+      query?: {
+        /** @description セッションID（省略時は営業中のセッション） */
+        session_id?: string;
+        /** @description レジ名（省略時は全レジ） */
+        register?: string;
+      };
+    };
+    responses: {
+      /** @description 成功 */
+      200: {
+        content: {
+          "application/json": components["schemas"]["CashMovementResponse"][];
+        };
+      };
+      /** @description リクエストが不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description セッションが見つかりません */
+      404: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+    };
+  };
+  /** 入出金登録 */
+  createCashMovement: {
+    requestBody: {
+      content: {
+        "application/json": components["schemas"]["CashMovementCreateRequest"];
+      };
+    };
+    responses: {
+      /** @description 作成成功 */
+      201: {
+        content: {
+          "application/json": components["schemas"]["CashMovementResponse"];
+        };
+      };
+      /** @description リクエストが不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description 営業中のセッションがありません */
+      409: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+    };
+  };
+  /** 締め処理レポート一覧取得 */
+  getCashCloseouts: {
+    parameters: {
+      query?: {
+        /** @description セッションID（省略時は営業中のセッション） */
+        session_id?: string;
+      };
+    };
+    responses: {
+      /** @description 成功 */
+      200: {
+        content: {
+          "application/json": components["schemas"]["CashCloseoutResponse"][];
+        };
+      };
+      /** @description リクエストが不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description セッションが見つかりません */
+      404: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+    };
+  };
+  /** 締め処理（金種別の実査と差異の記録） */
+  createCashCloseout: {
+    requestBody: {
+      content: {
+        "application/json": components["schemas"]["CashCloseoutCreateRequest"];
+      };
+    };
+    responses: {
+      /** @description 作成成功 */
+      201: {
+        content: {
+          "application/json": components["schemas"]["CashCloseoutResponse"];
+        };
+      };
+      /** @description リクエストが不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description セッションが見つかりません */
+      404: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description このレジはすでに締め処理済みです */
+      409: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+    };
+  };
+  /** idから締め処理レポート取得 */
+  getCashCloseout: {
+    parameters: {
+      path: {
+        id: string;
+      };
+    };
+    responses: {
+      /** @description 成功 */
+      200: {
+        content: {
+          "application/json": components["schemas"]["CashCloseoutResponse"];
+        };
+      };
+      /** @description リクエストが不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description レポートが見つかりません */
+      404: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+    };
+  };
+  /** レジ内の金種別在庫取得 */
+  getDrawerStock: {
+    parameters: {
+      query?: {
+        /** @description セッションID（省略時は営業中のセッション） */
+        session_id?: string;
+        /** @description レジ名（省略時は main） */
+        register?: string;
+      };
+    };
+    responses: {
+      /** @description 成功 */
+      200: {
+        content: {
+          "application/json": components["schemas"]["DrawerStockResponse"];
+        };
+      };
+      /** @description リクエストが不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description セッションが見つかりません */
+      404: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+    };
+  };
+  /** レジ内の金種別在庫を登録（実査・補充） */
+  updateDrawerStock: {
+    requestBody: {
+      content: {
+        "application/json": components["schemas"]["DrawerStockUpdateRequest"];
+      };
+    };
+    responses: {
+      /** @description 成功 */
+      200: {
+        content: {
+          "application/json": components["schemas"]["DrawerStockResponse"];
+        };
+      };
+      /** @description リクエストが不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description 営業中のセッションがありません */
+      409: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+    };
+  };
+  /** 特定オーダーの返金・作り直し一覧取得 */
+  getOrderRefunds: {
+    parameters: {
+      path: {
+        /** @description オーダーID */
+        id: string;
+      };
+    };
+    responses: {
+      /** @description 成功 */
+      200: {
+        content: {
+          "application/json": components["schemas"]["RefundResponse"][];
+        };
+      };
+      /** @description リクエストが不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description オーダーが見つかりません */
+      404: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+    };
+  };
+  /** 返金・作り直しの記録 */
+  createOrderRefund: {
+    parameters: {
+      path: {
+        /** @description オーダーID */
+        id: string;
+      };
+    };
+    requestBody: {
+      content: {
+        "application/json": components["schemas"]["RefundCreateRequest"];
+      };
+    };
+    responses: {
+      /** @description 作成成功 */
+      201: {
+        content: {
+          "application/json": components["schemas"]["RefundResponse"];
+        };
+      };
+      /** @description 返金内容が不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description オーダーが見つかりません */
+      404: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description 提供前のオーダー、または営業中のセッションがありません */
+      409: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+    };
+  };
+  /** 返金・作り直し一覧取得 */
+  getRefunds: {
+    parameters: {
+      query?: {
+        /** @description セッションID（省略時は営業中のセッション） */
+        session_id?: string;
+      };
+    };
+    responses: {
+      /** @description 成功 */
+      200: {
+        content: {
+          "application/json": components["schemas"]["RefundResponse"][];
+        };
+      };
+      /** @description リクエストが不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description セッションが見つかりません */
+      404: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+    };
+  };
+  /** 特定オーダーの支払い一覧取得 */
+  getOrderPayments: {
+    parameters: {
+      path: {
+        /** @description オーダーID */
+        id: string;
+      };
+    };
+    responses: {
+      /** @description 成功 */
+      200: {
+        content: {
+          "application/json": components["schemas"]["PaymentResponse"][];
+        };
+      };
+      /** @description リクエストが不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description オーダーが見つかりません */
+      404: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+    };
+  };
+  /** 利用できる決済手段の一覧取得 */
+  getPaymentMethods: {
+    responses: {
+      /** @description 成功 */
+      200: {
+        content: {
+          "application/json": string[];
+        };
+      };
+    };
+  };
+  /** 売上サマリー取得 */
+  getSalesSummaryReport: {
+    parameters: {
+      query?: {
+        /** @description セッションID（from/to も省略した場合は営業中のセッション） */
+        session_id?: string;
+        /** @description 集計開始日時（オーダー作成日時がこれ以降） */
+        from?: string;
+        /** @description 集計終了日時（オーダー作成日時がこれより前） */
+        to?: string;
+      };
+    };
+    responses: {
+      /** @description 成功 */
+      200: {
+        content: {
+          "application/json": components["schemas"]["SalesSummaryReport"];
+        };
+      };
+      /** @description パラメータが不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description セッションが見つかりません */
+      404: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+    };
+  };
+  /** アイテム別売上取得 */
+  getItemSalesReport: {
+    parameters: {
+      query?: {
+        /** @description セッションID（from/to も省略した場合は営業中のセッション） */
+        session_id?: string;
+        /** @description 集計開始日時（オーダー作成日時がこれ以降） */
+        from?: string;
+        /** @description 集計終了日時（オーダー作成日時がこれより前） */
+        to?: string;
+      };
+    };
+    responses: {
+      /** @description 成功 */
+      200: {
+        content: {
+          "application/json": components["schemas"]["ItemSalesReport"][];
+        };
+      };
+      /** @description パラメータが不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description セッションが見つかりません */
+      404: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+    };
+  };
+  /** アイテム種別ごとの売上取得 */
+  getItemTypeSalesReport: {
+    parameters: {
+      query?: {
+        /** @description セッションID（from/to も省略した場合は営業中のセッション） */
+        session_id?: string;
+        /** @description 集計開始日時（オーダー作成日時がこれ以降） */
+        from?: string;
+        /** @description 集計終了日時（オーダー作成日時がこれより前） */
+        to?: string;
+      };
+    };
+    responses: {
+      /** @description 成功 */
+      200: {
+        content: {
+          "application/json": components["schemas"]["ItemTypeSalesReport"][];
+        };
+      };
+      /** @description パラメータが不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description セッションが見つかりません */
+      404: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+    };
+  };
+  /** 時間帯別売上取得 */
+  getSalesTimelineReport: {
+    parameters: {
+      query?: {
+        /** @description セッションID（from/to も省略した場合は営業中のセッション） */
+        session_id?: string;
+        /** @description 集計開始日時（オーダー作成日時がこれ以降） */
+        from?: string;
+        /** @description 集計終了日時（オーダー作成日時がこれより前） */
+        to?: string;
+        /** @description 集計の間隔（Go の time.Duration 形式、1分以上。日本時間の 0 時を起点に区切る） */
+        interval?: string;
+      };
+    };
+    responses: {
+      /** @description 成功 */
+      200: {
+        content: {
+          "application/json": components["schemas"]["SalesTimelineBucket"][];
+        };
+      };
+      /** @description パラメータが不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description セッションが見つかりません */
+      404: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+    };
+  };
+  /** 提供時間の統計取得 */
+  getThroughputReport: {
+    parameters: {
+      query?: {
+        /** @description セッションID（from/to も省略した場合は営業中のセッション） */
+        session_id?: string;
+        /** @description 集計開始日時（オーダー作成日時がこれ以降） */
+        from?: string;
+        /** @description 集計終了日時（オーダー作成日時がこれより前） */
+        to?: string;
+        /** @description 集計の間隔（Go の time.Duration 形式、1分以上。日本時間の 0 時を起点に区切る） */
+        interval?: string;
+      };
+    };
+    responses: {
+      /** @description 成功 */
+      200: {
+        content: {
+          "application/json": components["schemas"]["ThroughputReport"];
+        };
+      };
+      /** @description パラメータが不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description セッションが見つかりません */
+      404: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+    };
+  };
+  /** オーダー・アイテム・コメント・マスターステート履歴のエクスポート */
+  exportDataset: {
+    parameters: {
+      query?: {
+        /** @description 出力形式 */
+        format?: "csv" | "ndjson" | "xlsx";
+        /** @description セッションID */
+        session_id?: string;
+        /** @description 出力開始日時（作成日時がこれ以降） */
+        from?: string;
+        /** @description 出力終了日時（作成日時がこれより前） */
+        to?: string;
+      };
+      path: {
+        /** @description 出力するデータ */
+        dataset: "orders" | "order-items" | "comments" | "master-states";
+      };
+    };
+    responses: {
+      /** @description 成功 */
+      200: {
+        content: {
+          "text/csv": string;
+          "application/x-ndjson": string;
+          "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet": string;
+        };
+      };
+      /** @description パラメータが不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+    };
+  };
+  /** 割引ルール一覧取得 */
+  getPromotions: {
+    responses: {
+      /** @description 成功 */
+      200: {
+        content: {
+          "application/json": components["schemas"]["PromotionResponse"][];
+        };
+      };
+    };
+  };
+  /** 割引ルール作成 */
+  createPromotion: {
+    requestBody: {
+      content: {
+        "application/json": components["schemas"]["PromotionRequest"];
+      };
+    };
+    responses: {
+      /** @description 作成成功 */
+      201: {
+        content: {
+          "application/json": components["schemas"]["PromotionResponse"];
+        };
+      };
+      /** @description 内容が不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+    };
+  };
+  /** 割引の計算 */
+  evaluatePromotions: {
+    requestBody: {
+      content: {
+        "application/json": components["schemas"]["PromotionEvaluateRequest"];
+      };
+    };
+    responses: {
+      /** @description 成功 */
+      200: {
+        content: {
+          "application/json": components["schemas"]["PromotionEvaluation"];
+        };
+      };
+      /** @description 内容が不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description 営業中のセッションがありません */
+      409: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+    };
+  };
+  /** 割引ルール取得 */
+  getPromotion: {
+    parameters: {
+      path: {
+        /** @description 割引ルールID */
+        id: string;
+      };
+    };
+    responses: {
+      /** @description 成功 */
+      200: {
+        content: {
+          "application/json": components["schemas"]["PromotionResponse"];
+        };
+      };
+      /** @description リクエストが不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description 割引ルールが見つかりません */
+      404: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+    };
+  };
+  /** 割引ルール更新 */
+  updatePromotion: {
+    parameters: {
+      path: {
+        /** @description 割引ルールID */
+        id: string;
+      };
+    };
+    requestBody: {
+      content: {
+        "application/json": components["schemas"]["PromotionRequest"];
+      };
+    };
+    responses: {
+      /** @description 更新成功 */
+      200: {
+        content: {
+          "application/json": components["schemas"]["PromotionResponse"];
+        };
+      };
+      /** @description 内容が不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description 割引ルールが見つかりません */
+      404: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+    };
+  };
+  /** 割引ルール削除 */
+  deletePromotion: {
+    parameters: {
+      path: {
+        /** @description 割引ルールID */
+        id: string;
+      };
+    };
+    responses: {
+      /** @description 削除成功 */
+      200: {
+        content: never;
+      };
+      /** @description リクエストが不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description 割引ルールが見つかりません */
+      404: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+    };
+  };
+  /** クーポンのバッチ一覧取得 */
+  getVoucherBatches: {
+    responses: {
+      /** @description 成功 */
+      200: {
+        content: {
+          "application/json": components["schemas"]["VoucherBatchResponse"][];
+        };
+      };
+    };
+  };
+  /** クーポンのバッチ作成 */
+  createVoucherBatch: {
+    requestBody: {
+      content: {
+        "application/json": components["schemas"]["VoucherBatchCreateRequest"];
+      };
+    };
+    responses: {
+      /** @description 作成成功 */
+      201: {
+        content: {
+          "application/json": components["schemas"]["VoucherBatchResponse"];
+        };
+      };
+      /** @description 内容が不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+    };
+  };
+  /** クーポンのバッチ取得 */
+  getVoucherBatch: {
+    parameters: {
+      path: {
+        /** @description クーポンのバッチID */
+        id: string;
+      };
+    };
+    responses: {
+      /** @description 成功 */
+      200: {
+        content: {
+          "application/json": components["schemas"]["VoucherBatchResponse"];
+        };
+      };
+      /** @description リクエストが不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description バッチが見つかりません */
+      404: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
     };
   };
-  /** アイテムタイプ削除 */
-  deleteItemType: {
+  /** バッチのクーポン一覧取得 */
+  getBatchVouchers: {
     parameters: {
       path: {
+        /** @description クーポンのバッチID */
         id: string;
       };
     };
     responses: {
       /** @description 成功 */
-      204: {
-        content: never;
+      200: {
+        content: {
+          "application/json": components["schemas"]["VoucherResponse"][];
+        };
+      };
+      /** @description リクエストが不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description バッチが見つかりません */
+      404: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
       };
     };
   };
-  /** オーダー一覧取得 */
-  getOrders: {
+  /** クーポンコードのエクスポート */
+  exportVoucherBatch: {
+    parameters: {
+      query?: {
+        format?: "csv" | "ndjson" | "xlsx";
+      };
+      path: {
+        /** @description クーポンのバッチID */
+        id: string;
+      };
+    };
     responses: {
       /** @description 成功 */
       200: {
         content: {
-          "application/json": components["schemas"]["OrderResponse"][];
+          "text/csv": string;
+          "application/x-ndjson": string;
+          "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet": string;
+        };
+      };
+      /** @description 形式が不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description バッチが見つかりません */
+      404: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
         };
       };
     };
   };
-  /** オーダー作成 */
-  createOrder: {
-    requestBody: {
-      content: {
-        "application/json": components["schemas"]["OrderCreateRequest"];
+  /** クーポンコードの確認 */
+  getVoucher: {
+    parameters: {
+      path: {
+        /** @description クーポンコード（ハイフンと大文字・小文字は区別しない） */
+        code: string;
       };
     };
     responses: {
       /** @description 成功 */
       200: {
         content: {
-          "application/json": components["schemas"]["OrderResponse"];
+          "application/json": components["schemas"]["VoucherResponse"];
+        };
+      };
+      /** @description リクエストが不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description クーポンが見つかりません */
+      404: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
         };
       };
     };
   };
-  /** idからオーダー情報取得 */
-  getOrder: {
+  /** ラベル・レシートの印刷データ取得 */
+  getOrderLabels: {
     parameters: {
+      query?: {
+        kind?: components["schemas"]["PrintKind"];
+      };
       path: {
+        /** @description オーダーID */
         id: string;
       };
     };
@@ -441,50 +3106,121 @@ export interface operations {
       /** @description 成功 */
       200: {
         content: {
-          "application/json": components["schemas"]["OrderResponse"];
+          "application/octet-stream": string;
+        };
+      };
+      /** @description 種類が不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description オーダーが見つかりません */
+      404: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
         };
       };
     };
   };
-  /** オーダー情報更新 */
-  updateOrder: {
+  /** 提供待ちの列の取得 */
+  getQueue: {
     parameters: {
-      path: {
-        id: string;
+      query?: {
+        /** @description セッションID（省略時は営業中または直近のセッション） */
+        session_id?: string;
       };
     };
-    requestBody: {
-      content: {
-        "application/json": components["schemas"]["OrderUpdateRequest"];
+    responses: {
+      /** @description 成功 */
+      200: {
+        content: {
+          "application/json": components["schemas"]["QueueResponse"];
+        };
+      };
+      /** @description パラメータが不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description セッションが見つかりません */
+      404: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+    };
+  };
+  /** 呼び出し画面の表示内容取得 */
+  getCallscreen: {
+    parameters: {
+      query?: {
+        /** @description セッションID（省略時は営業中または直近のセッション） */
+        session_id?: string;
       };
     };
     responses: {
       /** @description 成功 */
       200: {
         content: {
-          "application/json": components["schemas"]["OrderResponse"];
+          "application/json": components["schemas"]["CallscreenResponse"];
+        };
+      };
+      /** @description パラメータが不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description セッションが見つかりません */
+      404: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
         };
       };
     };
   };
-  /** オーダー削除 */
-  deleteOrder: {
+  /** オーダーを呼び出す */
+  callOrder: {
     parameters: {
       path: {
+        /** @description オーダーID */
         id: string;
       };
     };
     responses: {
       /** @description 成功 */
-      204: {
-        content: never;
+      200: {
+        content: {
+          "application/json": components["schemas"]["OrderResponse"];
+        };
+      };
+      /** @description リクエストが不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description オーダーが見つかりません */
+      404: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description 提供済み、または既に呼び出し済みです */
+      409: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
       };
     };
   };
-  /** オーダーを準備完了にする */
-  markOrderReady: {
+  /** オーダーを再呼び出しする */
+  recallOrder: {
     parameters: {
       path: {
+        /** @description オーダーID */
         id: string;
       };
     };
@@ -492,7 +3228,13 @@ export interface operations {
       /** @description 成功 */
       200: {
         content: {
-          "application/json": components["schemas"]["OrderResponse"][];
+          "application/json": components["schemas"]["OrderResponse"];
+        };
+      };
+      /** @description リクエストが不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
         };
       };
       /** @description オーダーが見つかりません */
@@ -501,33 +3243,100 @@ export interface operations {
           "application/json": components["schemas"]["ErrorResponse"];
         };
       };
+      /** @description 提供済み、またはまだ呼び出していません */
+      409: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
     };
   };
-  /** オーダーを提供完了にする */
-  markOrderServe: {
+  /** お客様向けの注文状況取得 */
+  trackOrder: {
     parameters: {
+      query: {
+        /** @description 追跡コード（大文字・小文字、ハイフンは区別しない） */
+        token: string;
+      };
       path: {
-        id: string;
+        /** @description オーダー番号 */
+        order_number: number;
       };
     };
     responses: {
       /** @description 成功 */
       200: {
         content: {
-          "application/json": components["schemas"]["OrderResponse"][];
+          "application/json": components["schemas"]["TrackingResponse"];
         };
       };
-      /** @description オーダーが見つかりません */
+      /** @description リクエストが不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description オーダーが見つからないか、追跡コードが違います */
+      404: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description リクエストが多すぎます（Retry-After 秒後に再試行する） */
+      429: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+    };
+  };
+  /** お客様向けの注文状況の購読 */
+  trackOrderEvents: {
+    parameters: {
+      query: {
+        /** @description 追跡コード（大文字・小文字、ハイフンは区別しない） */
+        token: string;
+      };
+      path: {
+        /** @description オーダー番号 */
+        order_number: number;
+      };
+    };
+    responses: {
+      /** @description 成功 */
+      200: {
+        content: {
+          "text/event-stream": string;
+        };
+      };
+      /** @description リクエストが不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description オーダーが見つからないか、追跡コードが違います */
       404: {
         content: {
           "application/json": components["schemas"]["ErrorResponse"];
         };
       };
+      /** @description リクエストが多すぎます（Retry-After 秒後に再試行する） */
+      429: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
     };
   };
-  /** 特定オーダーのコメント一覧取得 */
-  getOrderComments: {
+  /** 領収書の発行 */
+  getOrderReceipt: {
     parameters: {
+      query?: {
+        format?: "html" | "pdf";
+        /** @description 宛名（省略時は発行済みの宛名、なければ既定の宛名） */
+        addressee?: string;
+      };
       path: {
         /** @description オーダーID */
         id: string;
@@ -537,7 +3346,14 @@ export interface operations {
       /** @description 成功 */
       200: {
         content: {
-          "application/json": components["schemas"]["CommentResponse"][];
+          "text/html": string;
+          "application/pdf": string;
+        };
+      };
+      /** @description 形式が不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
         };
       };
       /** @description オーダーが見つかりません */
@@ -548,24 +3364,49 @@ export interface operations {
       };
     };
   };
-  /** オーダーにコメント追加 */
-  createOrderComment: {
+  /** 印刷ジョブ一覧取得 */
+  getPrintJobs: {
     parameters: {
-      path: {
+      query?: {
+        status?: components["schemas"]["PrintJobStatus"];
         /** @description オーダーID */
-        id: string;
+        order_id?: string;
+        limit?: number;
+      };
+    };
+    responses: {
+      /** @description 成功 */
+      200: {
+        content: {
+          "application/json": components["schemas"]["PrintJobResponse"][];
+        };
+      };
+      /** @description パラメータが不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
       };
     };
+  };
+  /** 再印刷 */
+  createPrintJob: {
     requestBody: {
       content: {
-        "application/json": components["schemas"]["CommentCreateRequest"];
+        "application/json": components["schemas"]["PrintJobCreateRequest"];
       };
     };
     responses: {
       /** @description 作成成功 */
       201: {
         content: {
-          "application/json": components["schemas"]["CommentResponse"];
+          "application/json": components["schemas"]["PrintJobResponse"];
+        };
+      };
+      /** @description リクエストが不正、またはプリンターが設定されていません */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
         };
       };
       /** @description オーダーが見つかりません */
@@ -576,29 +3417,66 @@ export interface operations {
       };
     };
   };
-  /** マスターステート取得 */
-  getMasterState: {
+  /** 印刷ジョブ取得 */
+  getPrintJob: {
+    parameters: {
+      path: {
+        /** @description 印刷ジョブID */
+        id: string;
+      };
+    };
     responses: {
       /** @description 成功 */
       200: {
         content: {
-          "application/json": components["schemas"]["MasterStateResponse"][];
+          "application/json": components["schemas"]["PrintJobResponse"];
+        };
+      };
+      /** @description リクエストが不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description 印刷ジョブが見つかりません */
+      404: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
         };
       };
     };
   };
-  /** マスターステート更新 */
-  updateMasterState: {
-    requestBody: {
-      content: {
-        "application/json": components["schemas"]["MasterStateUpdateRequest"];
+  /** 失敗した印刷ジョブの再試行 */
+  retryPrintJob: {
+    parameters: {
+      path: {
+        /** @description 印刷ジョブID */
+        id: string;
       };
     };
     responses: {
       /** @description 成功 */
       200: {
         content: {
-          "application/json": components["schemas"]["MasterStateResponse"];
+          "application/json": components["schemas"]["PrintJobResponse"];
+        };
+      };
+      /** @description リクエストが不正です */
+      400: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description 印刷ジョブが見つかりません */
+      404: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
+        };
+      };
+      /** @description 失敗したジョブではありません */
+      409: {
+        content: {
+          "application/json": components["schemas"]["ErrorResponse"];
         };
       };
     };
@@ -16,7 +16,7 @@ echo "Go型を生成中..."
 mkdir -p ../api/internal/models
 mkdir -p ../api/internal/handlers
 oapi-codegen -generate types -package models openapi.yaml > ../api/internal/models/api.go
-oapi-codegen -generate gin -package handlers openapi.yaml > ../api/internal/handlers/api_gin.go
+oapi-codegen -config gin.config.yaml openapi.yaml > ../api/internal/handlers/api_gin.go
 echo "Go型を生成しました"
 
 echo ""
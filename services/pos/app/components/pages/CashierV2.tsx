@@ -11,7 +11,6 @@ import { Switch } from "~/components/ui/switch";
 import { usePrinter } from "~/label/print-util";
 import { cn } from "~/lib/utils";
 import { goodsOnlyServed } from "../functional/goodsOnlyServed";
-import { transformToteSet } from "../functional/transformToteSet";
 import { useInputStatus } from "../functional/useInputStatus";
 import { useLatestOrderId } from "../functional/useLatestOrderId";
 import { useOrderState } from "../functional/useOrderState";
@@ -116,9 +115,9 @@ const CashierV2 = ({
     if (newOrder.items.length === 0) {
       return;
     }
-    const toteSetProcessedOrder = transformToteSet(newOrder, items ?? []);
     // 送信する直前に createdAt を更新する
-    const submitOne = toteSetProcessedOrder.clone();
+    // セット商品の構成アイテムへの展開はサーバーで行う
+    const submitOne = newOrder.clone();
     submitOne.nowCreated();
     goodsOnlyServed(submitOne);
     // 備考を追加
@@ -144,7 +143,6 @@ const CashierV2 = ({
     manualOrderId,
     setOrderIdOverride,
     wsStatus,
-    items,
   ]);
 
   const keyEventHandlers = useMemo(() => {